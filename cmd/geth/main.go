@@ -68,6 +68,7 @@ var (
 		utils.DirectBroadcastFlag,
 		utils.DisableSnapProtocolFlag,
 		utils.EnableTrustProtocolFlag,
+		utils.PrivateTxPeersFlag,
 		utils.PipeCommitFlag,
 		utils.RangeLimitFlag,
 		utils.USBFlag,
@@ -86,6 +87,9 @@ var (
 		utils.TxPoolNoLocalsFlag,
 		utils.TxPoolJournalFlag,
 		utils.TxPoolRejournalFlag,
+		utils.TxPoolRemoteJournalFlag,
+		utils.TxPoolRemoteJournalSizeFlag,
+		utils.TxPoolRemoteJournalAgeFlag,
 		utils.TxPoolPriceLimitFlag,
 		utils.TxPoolPriceBumpFlag,
 		utils.TxPoolAccountSlotsFlag,
@@ -94,6 +98,11 @@ var (
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
 		utils.TxPoolReannounceTimeFlag,
+		utils.TxPoolDeployerCheckFlag,
+		utils.TxPoolSenderSlotsFlag,
+		utils.TxPoolSenderWindowFlag,
+		utils.TxPoolContractSlotsFlag,
+		utils.TxPoolHistorySizeFlag,
 		utils.BlobPoolDataDirFlag,
 		utils.BlobPoolDataCapFlag,
 		utils.BlobPoolPriceBumpFlag,
@@ -106,6 +115,10 @@ var (
 		utils.TxLookupLimitFlag, // deprecated
 		utils.TransactionHistoryFlag,
 		utils.StateHistoryFlag,
+		utils.AccountChangeIndexFlag,
+		utils.AccountChangeHistoryFlag,
+		utils.AddressTxIndexFlag,
+		utils.LogIndexFlag,
 		utils.PathDBSyncFlag,
 		utils.JournalFileFlag,
 		utils.LightServeFlag,       // deprecated
@@ -147,6 +160,8 @@ var (
 		utils.MinerExtraDataFlag,
 		utils.MinerRecommitIntervalFlag,
 		utils.MinerDelayLeftoverFlag,
+		utils.MinerOrderingFlag,
+		utils.MinerOrderingSenderCapFlag,
 		// utils.MinerNewPayloadTimeout,
 		utils.NATFlag,
 		utils.NoDiscoverFlag,
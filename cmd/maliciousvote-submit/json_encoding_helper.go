@@ -4,10 +4,18 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/bsc"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// SlashIndicatorFinalityEvidence is the evidence of the slash indicator binding,
+// encoded to JSON with hex strings.
+type SlashIndicatorFinalityEvidence bsc.SlashIndicatorFinalityEvidence
+
+// SlashIndicatorVoteData is the vote data of the slash indicator binding.
+type SlashIndicatorVoteData bsc.SlashIndicatorVoteData
+
 func (s SlashIndicatorVoteData) ToWrapper() *types.SlashIndicatorVoteDataWrapper {
 	wrapper := &types.SlashIndicatorVoteDataWrapper{
 		SrcNum: s.SrcNum,
@@ -43,8 +51,8 @@ func (s *SlashIndicatorVoteData) FromWrapper(wrapper *types.SlashIndicatorVoteDa
 
 func (s SlashIndicatorFinalityEvidence) MarshalJSON() ([]byte, error) {
 	wrapper := &types.SlashIndicatorFinalityEvidenceWrapper{
-		VoteA: *s.VoteA.ToWrapper(),
-		VoteB: *s.VoteB.ToWrapper(),
+		VoteA: *SlashIndicatorVoteData(s.VoteA).ToWrapper(),
+		VoteB: *SlashIndicatorVoteData(s.VoteB).ToWrapper(),
 	}
 
 	if len(s.VoteAddr) != types.BLSPublicKeyLength {
@@ -61,8 +69,8 @@ func (s *SlashIndicatorFinalityEvidence) UnmarshalJSON(data []byte) error {
 		log.Crit("failed to Unmarshal", "error", err)
 	}
 
-	s.VoteA.FromWrapper(&wrapper.VoteA)
-	s.VoteB.FromWrapper(&wrapper.VoteB)
+	(*SlashIndicatorVoteData)(&s.VoteA).FromWrapper(&wrapper.VoteA)
+	(*SlashIndicatorVoteData)(&s.VoteB).FromWrapper(&wrapper.VoteB)
 	if len(wrapper.VoteAddr) != types.BLSPublicKeyLength*2 {
 		log.Crit("wrong length of VoteAddr", "wanted", types.BLSPublicKeyLength*2, "get", len(wrapper.VoteAddr))
 	}
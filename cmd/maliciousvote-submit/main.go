@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/bsc"
 	"github.com/ethereum/go-ethereum/core/systemcontracts"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -103,8 +104,8 @@ func submitMaliciousVotes(c *cli.Context) error {
 
 	ops, _ := bind.NewKeyedTransactorWithChainID(sender, big.NewInt(int64(chainId)))
 	//ops.GasLimit = 800000
-	slashIndicator, _ := NewSlashIndicator(common.HexToAddress(systemcontracts.SlashContract), client)
-	tx, err := slashIndicator.SubmitFinalityViolationEvidence(ops, evidence)
+	slashIndicator, _ := bsc.NewSlashIndicator(common.HexToAddress(systemcontracts.SlashContract), client)
+	tx, err := slashIndicator.SubmitFinalityViolationEvidence(ops, bsc.SlashIndicatorFinalityEvidence(evidence))
 	if err != nil {
 		log.Crit("submitMaliciousVotes:", "error", err)
 	}
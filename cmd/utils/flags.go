@@ -121,6 +121,11 @@ var (
 		Usage:    "Enable trust protocol",
 		Category: flags.FastNodeCategory,
 	}
+	PrivateTxPeersFlag = &cli.StringFlag{
+		Name:     "privatetx.peers",
+		Usage:    "Comma separated node ids or enode URLs of the trusted validator peers private transactions are exchanged with",
+		Category: flags.FastNodeCategory,
+	}
 	PipeCommitFlag = &cli.BoolFlag{
 		Name:     "pipecommit",
 		Usage:    "Enable MPT pipeline commit, it will improve syncing performance. It is an experimental feature(default is false)",
@@ -413,6 +418,27 @@ var (
 		Value:    ethconfig.Defaults.TransactionHistory,
 		Category: flags.StateCategory,
 	}
+	AccountChangeIndexFlag = &cli.BoolFlag{
+		Name:     "index.accountchanges",
+		Usage:    "Index the blocks changing the balance or nonce of accounts, starting from the current head",
+		Category: flags.StateCategory,
+	}
+	AccountChangeHistoryFlag = &cli.Uint64Flag{
+		Name:     "history.accountchanges",
+		Usage:    "Number of recent blocks to maintain account changes index for (default = 0, entire chain)",
+		Value:    ethconfig.Defaults.AccountChangeHistory,
+		Category: flags.StateCategory,
+	}
+	AddressTxIndexFlag = &cli.BoolFlag{
+		Name:     "index.addresses",
+		Usage:    "Index the transactions by sender and recipient address, within the transaction history range",
+		Category: flags.StateCategory,
+	}
+	LogIndexFlag = &cli.BoolFlag{
+		Name:     "index.logs",
+		Usage:    "Index the logs by address and topic, serving eth_getLogs over wide ranges faster than the bloombits",
+		Category: flags.StateCategory,
+	}
 	// Transaction pool settings
 	TxPoolLocalsFlag = &cli.StringFlag{
 		Name:     "txpool.locals",
@@ -436,6 +462,23 @@ var (
 		Value:    ethconfig.Defaults.TxPool.Rejournal,
 		Category: flags.TxPoolCategory,
 	}
+	TxPoolRemoteJournalFlag = &cli.StringFlag{
+		Name:     "txpool.remotejournal",
+		Usage:    "Disk journal for remote transactions to survive node restarts (disabled if empty)",
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolRemoteJournalSizeFlag = &cli.Uint64Flag{
+		Name:     "txpool.remotejournalsize",
+		Usage:    "Maximum number of remote transactions stored in the journal",
+		Value:    ethconfig.Defaults.TxPool.RemoteJournalSize,
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolRemoteJournalAgeFlag = &cli.DurationFlag{
+		Name:     "txpool.remotejournalage",
+		Usage:    "Maximum age of remote transactions stored in or reloaded from the journal",
+		Value:    ethconfig.Defaults.TxPool.RemoteJournalAge,
+		Category: flags.TxPoolCategory,
+	}
 	TxPoolPriceLimitFlag = &cli.Uint64Flag{
 		Name:     "txpool.pricelimit",
 		Usage:    "Minimum gas price tip to enforce for acceptance into the pool",
@@ -484,6 +527,35 @@ var (
 		Value:    ethconfig.Defaults.TxPool.ReannounceTime,
 		Category: flags.TxPoolCategory,
 	}
+	TxPoolDeployerCheckFlag = &cli.BoolFlag{
+		Name:     "txpool.deployercheck",
+		Usage:    "Reject contract creations from senders the deployer proxy does not allow to deploy",
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolSenderSlotsFlag = &cli.Uint64Flag{
+		Name:     "txpool.senderslots",
+		Usage:    "Maximum number of remote transactions accepted per sender within the sender window (0 = unlimited)",
+		Value:    ethconfig.Defaults.TxPool.SenderSlots,
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolSenderWindowFlag = &cli.DurationFlag{
+		Name:     "txpool.senderwindow",
+		Usage:    "Time window over which the per-sender transaction quota is enforced",
+		Value:    ethconfig.Defaults.TxPool.SenderWindow,
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolContractSlotsFlag = &cli.Uint64Flag{
+		Name:     "txpool.contractslots",
+		Usage:    "Maximum number of remote executable transactions per destination contract (0 = unlimited)",
+		Value:    ethconfig.Defaults.TxPool.ContractSlots,
+		Category: flags.TxPoolCategory,
+	}
+	TxPoolHistorySizeFlag = &cli.Uint64Flag{
+		Name:     "txpool.historysize",
+		Usage:    "Number of recently removed transactions to remember the fate of (0 = disabled)",
+		Value:    ethconfig.Defaults.TxPool.HistorySize,
+		Category: flags.TxPoolCategory,
+	}
 	// Blob transaction pool settings
 	BlobPoolDataDirFlag = &cli.StringFlag{
 		Name:     "blobpool.datadir",
@@ -618,6 +690,18 @@ var (
 		Value:    ethconfig.Defaults.Miner.DelayLeftOver,
 		Category: flags.MinerCategory,
 	}
+	MinerOrderingFlag = &cli.StringFlag{
+		Name:     "miner.ordering",
+		Usage:    "Transaction ordering policy of mined blocks (price, fcfs or fair)",
+		Value:    ethconfig.Defaults.Miner.Ordering,
+		Category: flags.MinerCategory,
+	}
+	MinerOrderingSenderCapFlag = &cli.IntFlag{
+		Name:     "miner.ordering.sendercap",
+		Usage:    "Transactions of a sender included per round by the fair ordering policy",
+		Value:    ethconfig.Defaults.Miner.OrderingSenderCap,
+		Category: flags.MinerCategory,
+	}
 	MinerNewPayloadTimeout = &cli.DurationFlag{
 		Name:     "miner.newpayload-timeout",
 		Usage:    "Specify the maximum time allowance for creating a new payload",
@@ -1777,6 +1861,15 @@ func setTxPool(ctx *cli.Context, cfg *legacypool.Config) {
 	if ctx.IsSet(TxPoolRejournalFlag.Name) {
 		cfg.Rejournal = ctx.Duration(TxPoolRejournalFlag.Name)
 	}
+	if ctx.IsSet(TxPoolRemoteJournalFlag.Name) {
+		cfg.RemoteJournal = ctx.String(TxPoolRemoteJournalFlag.Name)
+	}
+	if ctx.IsSet(TxPoolRemoteJournalSizeFlag.Name) {
+		cfg.RemoteJournalSize = ctx.Uint64(TxPoolRemoteJournalSizeFlag.Name)
+	}
+	if ctx.IsSet(TxPoolRemoteJournalAgeFlag.Name) {
+		cfg.RemoteJournalAge = ctx.Duration(TxPoolRemoteJournalAgeFlag.Name)
+	}
 	if ctx.IsSet(TxPoolPriceLimitFlag.Name) {
 		cfg.PriceLimit = ctx.Uint64(TxPoolPriceLimitFlag.Name)
 	}
@@ -1801,6 +1894,21 @@ func setTxPool(ctx *cli.Context, cfg *legacypool.Config) {
 	if ctx.IsSet(TxPoolReannounceTimeFlag.Name) {
 		cfg.ReannounceTime = ctx.Duration(TxPoolReannounceTimeFlag.Name)
 	}
+	if ctx.IsSet(TxPoolDeployerCheckFlag.Name) {
+		cfg.DeployerCheck = ctx.Bool(TxPoolDeployerCheckFlag.Name)
+	}
+	if ctx.IsSet(TxPoolSenderSlotsFlag.Name) {
+		cfg.SenderSlots = ctx.Uint64(TxPoolSenderSlotsFlag.Name)
+	}
+	if ctx.IsSet(TxPoolSenderWindowFlag.Name) {
+		cfg.SenderWindow = ctx.Duration(TxPoolSenderWindowFlag.Name)
+	}
+	if ctx.IsSet(TxPoolContractSlotsFlag.Name) {
+		cfg.ContractSlots = ctx.Uint64(TxPoolContractSlotsFlag.Name)
+	}
+	if ctx.IsSet(TxPoolHistorySizeFlag.Name) {
+		cfg.HistorySize = ctx.Uint64(TxPoolHistorySizeFlag.Name)
+	}
 }
 
 func setMiner(ctx *cli.Context, cfg *miner.Config) {
@@ -1819,6 +1927,12 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.IsSet(MinerDelayLeftoverFlag.Name) {
 		cfg.DelayLeftOver = ctx.Duration(MinerDelayLeftoverFlag.Name)
 	}
+	if ctx.IsSet(MinerOrderingFlag.Name) {
+		cfg.Ordering = ctx.String(MinerOrderingFlag.Name)
+	}
+	if ctx.IsSet(MinerOrderingSenderCapFlag.Name) {
+		cfg.OrderingSenderCap = ctx.Int(MinerOrderingSenderCapFlag.Name)
+	}
 	if ctx.Bool(VotingEnabledFlag.Name) {
 		cfg.VoteEnable = true
 	}
@@ -1979,6 +2093,19 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.IsSet(EnableTrustProtocolFlag.Name) {
 		cfg.EnableTrustProtocol = ctx.IsSet(EnableTrustProtocolFlag.Name)
 	}
+	if ctx.IsSet(PrivateTxPeersFlag.Name) {
+		for _, peer := range SplitAndTrim(ctx.String(PrivateTxPeersFlag.Name)) {
+			id, err := enode.ParseID(peer)
+			if err != nil {
+				node, perr := enode.Parse(enode.ValidSchemes, peer)
+				if perr != nil {
+					Fatalf("Invalid private transaction peer %q: %v", peer, err)
+				}
+				id = node.ID()
+			}
+			cfg.PrivateTxPeers = append(cfg.PrivateTxPeers, id)
+		}
+	}
 	if ctx.IsSet(PipeCommitFlag.Name) {
 		log.Warn("The --pipecommit flag is deprecated and could be removed in the future!")
 	}
@@ -2014,6 +2141,18 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 		log.Warn("The flag --txlookuplimit is deprecated and will be removed, please use --history.transactions")
 		cfg.TransactionHistory = ctx.Uint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.IsSet(AccountChangeIndexFlag.Name) {
+		cfg.AccountChangeIndex = ctx.Bool(AccountChangeIndexFlag.Name)
+	}
+	if ctx.IsSet(AccountChangeHistoryFlag.Name) {
+		cfg.AccountChangeHistory = ctx.Uint64(AccountChangeHistoryFlag.Name)
+	}
+	if ctx.IsSet(AddressTxIndexFlag.Name) {
+		cfg.AddressTxIndex = ctx.Bool(AddressTxIndexFlag.Name)
+	}
+	if ctx.IsSet(LogIndexFlag.Name) {
+		cfg.LogIndex = ctx.Bool(LogIndexFlag.Name)
+	}
 	if ctx.IsSet(PathDBSyncFlag.Name) {
 		cfg.PathSyncFlush = true
 	}
@@ -32,6 +32,19 @@ var (
 	SystemAddress = common.HexToAddress("0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE")
 )
 
+// SystemTxKind classifies the system transactions injected into a block by a
+// PoSA engine.
+type SystemTxKind string
+
+const (
+	SystemTxNone               SystemTxKind = ""                   // Regular user transaction
+	SystemTxRewardDistribution SystemTxKind = "rewardDistribution" // Block reward paid to the validator or system reward contracts
+	SystemTxTokenomicsDeposit  SystemTxKind = "tokenomicsDeposit"  // Inflation reward deposited into the tokenomics contract
+	SystemTxSlash              SystemTxKind = "slash"              // Slashing of a validator that missed its turn
+	SystemTxPepper8Mint        SystemTxKind = "pepper8Mint"        // One-off Pepper8 fork mint
+	SystemTxOther              SystemTxKind = "other"              // Any other system call, e.g. contract initialisation
+)
+
 // ChainHeaderReader defines a small collection of methods needed to access the local
 // blockchain during header verification.
 type ChainHeaderReader interface {
@@ -159,14 +172,31 @@ type PoSA interface {
 	Engine
 
 	IsSystemTransaction(tx *types.Transaction, header *types.Header) (bool, error)
+	SystemTransactionKind(tx *types.Transaction, header *types.Header) (SystemTxKind, error)
 	IsSystemContract(to *common.Address) bool
 	EnoughDistance(chain ChainReader, header *types.Header) bool
 	IsLocalBlock(header *types.Header) bool
 	GetJustifiedNumberAndHash(chain ChainHeaderReader, headers []*types.Header) (uint64, common.Hash, error)
 	GetFinalizedHeader(chain ChainHeaderReader, header *types.Header) *types.Header
+	GetSigner(header *types.Header) (common.Address, error)
+	GetVoteAttestation(header *types.Header) (*types.VoteAttestation, error)
+	GetEpochValidators(header *types.Header) ([]common.Address, error)
 	VerifyVote(chain ChainHeaderReader, vote *types.VoteEnvelope) error
 	IsActiveValidatorAt(chain ChainHeaderReader, header *types.Header, checkVoteKeyFn func(bLSPublicKey *types.BLSPublicKey) bool) bool
 	IsTokenomicsDeposit(to *common.Address, data []byte) bool
 	IsPepper8Block(currentBlockTime uint64, parentBlockTime uint64) bool
 	GetPepper8MintAmount() *big.Int
 }
+
+// SystemTxSimulator is a consensus engine able to apply the system transactions
+// it injects at the end of a block to simulated blocks, which are not sealed.
+type SystemTxSimulator interface {
+	// PrepareSimulated sets the header fields of the given simulated block the
+	// engine derives from its parent, such as the validator expected to seal it.
+	PrepareSimulated(chain ChainHeaderReader, header *types.Header) error
+
+	// FinalizeSimulated applies the system transactions of the given simulated
+	// block, appending them unsigned along with their receipts.
+	FinalizeSimulated(chain ChainHeaderReader, header *types.Header, state *state.StateDB, txs *[]*types.Transaction,
+		receipts *[]*types.Receipt, usedGas *uint64) error
+}
@@ -17,6 +17,10 @@
 package parlia
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -114,6 +118,93 @@ func (api *API) GetFinalizedNumber(number *rpc.BlockNumber) (uint64, error) {
 	return snap.Attestation.SourceNumber, nil
 }
 
+// GetChainConfigParams retrieves the governance controlled parameters stored in
+// the ChainConfig contract, as carried by the last epoch block and captured in the
+// snapshot at the given block.
+func (api *API) GetChainConfigParams(number *rpc.BlockNumber) (*ChainConfigParams, error) {
+	header := api.getHeader(number)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	snap, err := api.parlia.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil, api.parlia.chainConfig.IsSnake8(header.Time), header)
+	if err != nil {
+		return nil, err
+	}
+	if snap.ChainConfig == nil {
+		return nil, errChainConfigParamsUnknown
+	}
+	return snap.ChainConfig, nil
+}
+
+// GetBlockRewards retrieves the breakdown of the rewards paid out by the system
+// transactions of the given block.
+func (api *API) GetBlockRewards(number *rpc.BlockNumber) (*BlockRewards, error) {
+	header := api.getHeader(number)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return api.blockRewards(header)
+}
+
+// GetValidatorRewards aggregates the rewards of the validators over the blocks
+// they produced in the given inclusive range, optionally restricted to a single
+// validator. The validators are sorted by address.
+func (api *API) GetValidatorRewards(from rpc.BlockNumber, to rpc.BlockNumber, validator *common.Address) ([]*ValidatorRewards, error) {
+	first, last := api.getHeader(&from), api.getHeader(&to)
+	if first == nil || last == nil {
+		return nil, errUnknownBlock
+	}
+	start, end := first.Number.Uint64(), last.Number.Uint64()
+	if start > end {
+		return nil, fmt.Errorf("invalid block range %d-%d", start, end)
+	}
+	if end-start >= maxRewardsRange {
+		return nil, fmt.Errorf("block range too large, at most %d blocks", maxRewardsRange)
+	}
+	validators := make(map[common.Address]*ValidatorRewards)
+	for number := start; number <= end; number++ {
+		header := api.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+		if validator != nil && header.Coinbase != *validator {
+			continue
+		}
+		rewards, err := api.blockRewards(header)
+		if err != nil {
+			return nil, err
+		}
+		aggregate, ok := validators[header.Coinbase]
+		if !ok {
+			aggregate = &ValidatorRewards{Validator: header.Coinbase, Rewards: newRewards()}
+			validators[header.Coinbase] = aggregate
+		}
+		aggregate.Blocks++
+		aggregate.add(&rewards.Rewards)
+	}
+	result := make([]*ValidatorRewards, 0, len(validators))
+	for _, rewards := range validators {
+		result = append(result, rewards)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].Validator[:], result[j].Validator[:]) < 0
+	})
+	return result, nil
+}
+
+func (api *API) blockRewards(header *types.Header) (*BlockRewards, error) {
+	chain, ok := api.chain.(blockReader)
+	if !ok {
+		return nil, errBodiesUnavailable
+	}
+	hash := header.Hash()
+	block := chain.GetBlock(hash, header.Number.Uint64())
+	if block == nil {
+		return nil, errUnknownBlock
+	}
+	return api.parlia.BlockRewards(block, chain.GetReceiptsByHash(hash))
+}
+
 func (api *API) getHeader(number *rpc.BlockNumber) (header *types.Header) {
 	currentHeader := api.chain.CurrentHeader()
 
@@ -0,0 +1,186 @@
+package parlia
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// chainConfigParamsLength is the size of the ChainConfig parameters carried in
+// the extra-data of the epoch blocks: six uint32 followed by two uint256.
+const chainConfigParamsLength = 6*4 + 2*32
+
+var (
+	// errInvalidChainConfigParams is returned if an epoch block doesn't carry
+	// the ChainConfig parameters once they are captured.
+	errInvalidChainConfigParams = errors.New("invalid chain config parameters on epoch block")
+
+	// errMismatchingEpochChainConfigParams is returned if an epoch block carries
+	// ChainConfig parameters different than the ones the local node read.
+	errMismatchingEpochChainConfigParams = errors.New("mismatching chain config parameters on epoch block")
+
+	// errChainConfigParamsUnknown is returned if the ChainConfig parameters are
+	// requested before any epoch block carried them.
+	errChainConfigParamsUnknown = errors.New("chain config parameters not captured yet")
+)
+
+// ChainConfigParams is the set of governance controlled network parameters stored
+// in the ChainConfig system contract.
+//
+// Once the ChainConfigParams fork is active, the validator proposing an epoch
+// block reads them from the state of its parent and appends them to the header
+// extra-data, right ahead of the seal. The other validators check them against
+// their own state in Finalize, and the snapshot takes them over together with the
+// validator set of the epoch, so that they are known from the headers only. The
+// staking contract counts its epochs with the captured epoch block interval.
+type ChainConfigParams struct {
+	Number uint64      `json:"number"` // Epoch block carrying the parameters
+	Hash   common.Hash `json:"hash"`   // Hash of the epoch block carrying the parameters
+
+	ActiveValidatorsLength   uint32   `json:"active_validators_length"`
+	EpochBlockInterval       uint32   `json:"epoch_block_interval"`
+	MisdemeanorThreshold     uint32   `json:"misdemeanor_threshold"`
+	FelonyThreshold          uint32   `json:"felony_threshold"`
+	ValidatorJailEpochLength uint32   `json:"validator_jail_epoch_length"`
+	UndelegatePeriod         uint32   `json:"undelegate_period"`
+	MinValidatorStakeAmount  *big.Int `json:"min_validator_stake_amount"`
+	MinStakingAmount         *big.Int `json:"min_staking_amount"`
+}
+
+// epochBlockInterval returns the length of the staking epochs: the interval
+// captured from the last epoch block, or the Parlia epoch before the fork.
+func (s *Snapshot) epochBlockInterval() uint64 {
+	if s.ChainConfig != nil && s.ChainConfig.EpochBlockInterval > 0 {
+		return uint64(s.ChainConfig.EpochBlockInterval)
+	}
+	return s.config.Epoch
+}
+
+// encode packs the parameters into their extra-data representation.
+func (c *ChainConfigParams) encode() []byte {
+	enc := make([]byte, 0, chainConfigParamsLength)
+	for _, v := range []uint32{c.ActiveValidatorsLength, c.EpochBlockInterval, c.MisdemeanorThreshold,
+		c.FelonyThreshold, c.ValidatorJailEpochLength, c.UndelegatePeriod} {
+		enc = binary.BigEndian.AppendUint32(enc, v)
+	}
+	enc = append(enc, math.U256Bytes(new(big.Int).Set(c.MinValidatorStakeAmount))...)
+	return append(enc, math.U256Bytes(new(big.Int).Set(c.MinStakingAmount))...)
+}
+
+// hasChainConfigParams reports whether the extra-data of the header carries the
+// ChainConfig parameters.
+func hasChainConfigParams(header *types.Header, chainConfig *params.ChainConfig, parliaConfig *params.ParliaConfig) bool {
+	return header.Number.Uint64()%parliaConfig.Epoch == 0 && chainConfig.IsChainConfigParams(header.Time)
+}
+
+// chainConfigParamsSize returns the number of extra-data bytes ahead of the seal
+// taken by the ChainConfig parameters.
+func chainConfigParamsSize(header *types.Header, chainConfig *params.ChainConfig, parliaConfig *params.ParliaConfig) int {
+	if hasChainConfigParams(header, chainConfig, parliaConfig) {
+		return chainConfigParamsLength
+	}
+	return 0
+}
+
+// parseChainConfigParams retrieves the ChainConfig parameters carried by an epoch
+// block, nil if the block doesn't carry any.
+func parseChainConfigParams(header *types.Header, chainConfig *params.ChainConfig, parliaConfig *params.ParliaConfig) (*ChainConfigParams, error) {
+	if !hasChainConfigParams(header, chainConfig, parliaConfig) {
+		return nil, nil
+	}
+	if len(header.Extra) < extraVanity+chainConfigParamsLength+extraSeal {
+		return nil, errInvalidChainConfigParams
+	}
+	enc := header.Extra[len(header.Extra)-extraSeal-chainConfigParamsLength : len(header.Extra)-extraSeal]
+	return &ChainConfigParams{
+		Number:                   header.Number.Uint64(),
+		Hash:                     header.Hash(),
+		ActiveValidatorsLength:   binary.BigEndian.Uint32(enc[0:]),
+		EpochBlockInterval:       binary.BigEndian.Uint32(enc[4:]),
+		MisdemeanorThreshold:     binary.BigEndian.Uint32(enc[8:]),
+		FelonyThreshold:          binary.BigEndian.Uint32(enc[12:]),
+		ValidatorJailEpochLength: binary.BigEndian.Uint32(enc[16:]),
+		UndelegatePeriod:         binary.BigEndian.Uint32(enc[20:]),
+		MinValidatorStakeAmount:  new(big.Int).SetBytes(enc[24:56]),
+		MinStakingAmount:         new(big.Int).SetBytes(enc[56:88]),
+	}, nil
+}
+
+// readChainConfigParams reads the parameters from the ChainConfig contract, using
+// the state of the block with the given hash.
+func (p *Parlia) readChainConfigParams(hash common.Hash) (*ChainConfigParams, error) {
+	var (
+		caller = p.contracts.chainConfigCaller
+		opts   = &bind.CallOpts{BlockHash: hash, Context: context.Background()}
+		values = new(ChainConfigParams)
+		err    error
+	)
+	if values.ActiveValidatorsLength, err = caller.GetActiveValidatorsLength(opts); err != nil {
+		return nil, err
+	}
+	if values.EpochBlockInterval, err = caller.GetEpochBlockInterval(opts); err != nil {
+		return nil, err
+	}
+	if values.MisdemeanorThreshold, err = caller.GetMisdemeanorThreshold(opts); err != nil {
+		return nil, err
+	}
+	if values.FelonyThreshold, err = caller.GetFelonyThreshold(opts); err != nil {
+		return nil, err
+	}
+	if values.ValidatorJailEpochLength, err = caller.GetValidatorJailEpochLength(opts); err != nil {
+		return nil, err
+	}
+	if values.UndelegatePeriod, err = caller.GetUndelegatePeriod(opts); err != nil {
+		return nil, err
+	}
+	if values.MinValidatorStakeAmount, err = caller.GetMinValidatorStakeAmount(opts); err != nil {
+		return nil, err
+	}
+	if values.MinStakingAmount, err = caller.GetMinStakingAmount(opts); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// prepareChainConfigParams appends the ChainConfig parameters to the extra-data
+// of an epoch block. It must be the last section ahead of the seal.
+func (p *Parlia) prepareChainConfigParams(header *types.Header) error {
+	if !hasChainConfigParams(header, p.chainConfig, p.config) {
+		return nil
+	}
+	values, err := p.readChainConfigParams(header.ParentHash)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra, values.encode()...)
+	return nil
+}
+
+// verifyChainConfigParams checks the ChainConfig parameters carried by an epoch
+// block against the contract. The verification can only be done when the state
+// is ready, it can't be done in VerifyHeader.
+func (p *Parlia) verifyChainConfigParams(header *types.Header) error {
+	if !hasChainConfigParams(header, p.chainConfig, p.config) {
+		return nil
+	}
+	carried, err := parseChainConfigParams(header, p.chainConfig, p.config)
+	if err != nil {
+		return err
+	}
+	values, err := p.readChainConfigParams(header.ParentHash)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(carried.encode(), values.encode()) {
+		return errMismatchingEpochChainConfigParams
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package parlia
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestChainConfigParamsExtra(t *testing.T) {
+	forkTime := uint64(1000)
+	chainConfig := &params.ChainConfig{LubanBlock: big.NewInt(1e9), ChainConfigParamsTime: &forkTime}
+	parliaConfig := &params.ParliaConfig{Epoch: 200}
+
+	values := &ChainConfigParams{
+		ActiveValidatorsLength:   21,
+		EpochBlockInterval:       400,
+		MisdemeanorThreshold:     50,
+		FelonyThreshold:          150,
+		ValidatorJailEpochLength: 7,
+		UndelegatePeriod:         6,
+		MinValidatorStakeAmount:  new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000)),
+		MinStakingAmount:         big.NewInt(1),
+	}
+	validator := common.HexToAddress("0x01")
+	extra := make([]byte, extraVanity)
+	extra = append(extra, validator.Bytes()...)
+	extra = append(extra, values.encode()...)
+	extra = append(extra, make([]byte, extraSeal)...)
+
+	header := &types.Header{Number: big.NewInt(400), Time: forkTime, Extra: extra}
+	parsed, err := parseChainConfigParams(header, chainConfig, parliaConfig)
+	require.NoError(t, err)
+	values.Number, values.Hash = header.Number.Uint64(), header.Hash()
+	assert.Equal(t, values, parsed)
+
+	// The parameters don't spill over the validators of the epoch block
+	validators, _, err := parseValidators(header, chainConfig, parliaConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{validator}, validators)
+
+	// Neither the blocks before the fork nor the blocks within an epoch carry any
+	header = &types.Header{Number: big.NewInt(400), Time: forkTime - 1, Extra: extra}
+	parsed, err = parseChainConfigParams(header, chainConfig, parliaConfig)
+	require.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	header = &types.Header{Number: big.NewInt(401), Time: forkTime, Extra: extra}
+	parsed, err = parseChainConfigParams(header, chainConfig, parliaConfig)
+	require.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	// An epoch block after the fork must carry them
+	header = &types.Header{Number: big.NewInt(400), Time: forkTime, Extra: make([]byte, extraVanity+extraSeal)}
+	_, err = parseChainConfigParams(header, chainConfig, parliaConfig)
+	assert.ErrorIs(t, err, errInvalidChainConfigParams)
+}
+
+func TestSnapshotEpochBlockInterval(t *testing.T) {
+	snap := &Snapshot{config: &params.ParliaConfig{Epoch: 200}}
+	assert.Equal(t, uint64(200), snap.epochBlockInterval())
+
+	snap.ChainConfig = &ChainConfigParams{EpochBlockInterval: 400}
+	assert.Equal(t, uint64(400), snap.epochBlockInterval())
+
+	cpy := snap.copy()
+	assert.Equal(t, uint64(400), cpy.epochBlockInterval())
+}
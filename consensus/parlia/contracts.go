@@ -0,0 +1,120 @@
+package parlia
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/contracts/bas"
+	"github.com/ethereum/go-ethereum/contracts/bsc"
+	"github.com/ethereum/go-ethereum/core/systemcontracts"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// contractCaller adapts the local BlockChainAPI to the bind.ContractCaller and
+// bind.BlockHashContractCaller interfaces, so the generated system contract
+// bindings can be used to read the chain state from within the engine.
+type contractCaller struct {
+	ethAPI *ethapi.BlockChainAPI
+}
+
+func blockNumberOrLatest(number *big.Int) rpc.BlockNumberOrHash {
+	if number == nil {
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	}
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number.Int64()))
+}
+
+func (c *contractCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.ethAPI.GetCode(ctx, contract, blockNumberOrLatest(blockNumber))
+}
+
+func (c *contractCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	blockNr := blockNumberOrLatest(blockNumber)
+	return c.call(ctx, call, &blockNr)
+}
+
+func (c *contractCaller) CodeAtHash(ctx context.Context, contract common.Address, blockHash common.Hash) ([]byte, error) {
+	return c.ethAPI.GetCode(ctx, contract, rpc.BlockNumberOrHashWithHash(blockHash, false))
+}
+
+func (c *contractCaller) CallContractAtHash(ctx context.Context, call ethereum.CallMsg, blockHash common.Hash) ([]byte, error) {
+	blockNr := rpc.BlockNumberOrHashWithHash(blockHash, false)
+	return c.call(ctx, call, &blockNr)
+}
+
+func (c *contractCaller) call(ctx context.Context, call ethereum.CallMsg, blockNr *rpc.BlockNumberOrHash) ([]byte, error) {
+	msgData := (hexutil.Bytes)(call.Data)
+	gas := (hexutil.Uint64)(uint64(math.MaxUint64 / 2))
+	args := ethapi.TransactionArgs{
+		Gas:  &gas,
+		To:   call.To,
+		Data: &msgData,
+	}
+	if call.From != (common.Address{}) {
+		args.From = &call.From
+	}
+	return c.ethAPI.Call(ctx, args, blockNr, nil, nil)
+}
+
+// systemContracts holds the typed bindings of the BAS and BSC system contracts
+// used by the engine. Callers read the state through the local API, transactors
+// are only used to assemble the calldata of system transactions.
+type systemContracts struct {
+	stakingCaller        *bas.StakingCaller
+	stakingTransactor    *bas.StakingTransactor
+	tokenomicsCaller     *bas.TokenomicsCaller
+	tokenomicsTransactor *bas.TokenomicsTransactor
+	chainConfigCaller    *bas.ChainConfigCaller
+
+	validatorSetBeforeLubanCaller *bsc.ValidatorSetBeforeLubanCaller
+	slashIndicatorTransactor      *bsc.SlashIndicatorTransactor
+	stakeHubCaller                *bsc.StakeHubCaller
+	stakeHubTransactor            *bsc.StakeHubTransactor
+}
+
+func newSystemContracts(ethAPI *ethapi.BlockChainAPI) (*systemContracts, error) {
+	var (
+		caller         = &contractCaller{ethAPI: ethAPI}
+		stakingAddr    = common.HexToAddress(systemcontract.ValidatorContract)
+		tokenomicsAddr = systemcontract.TokenomicsContractAddress
+		configAddr     = systemcontract.ChainConfigContractAddress
+		slashAddr      = common.HexToAddress(systemcontract.SlashContract)
+		stakeHubAddr   = common.HexToAddress(systemcontracts.StakeHubContract)
+		contracts      = new(systemContracts)
+		err            error
+	)
+	if contracts.stakingCaller, err = bas.NewStakingCaller(stakingAddr, caller); err != nil {
+		return nil, err
+	}
+	if contracts.stakingTransactor, err = bas.NewStakingTransactor(stakingAddr, nil); err != nil {
+		return nil, err
+	}
+	if contracts.tokenomicsCaller, err = bas.NewTokenomicsCaller(tokenomicsAddr, caller); err != nil {
+		return nil, err
+	}
+	if contracts.tokenomicsTransactor, err = bas.NewTokenomicsTransactor(tokenomicsAddr, nil); err != nil {
+		return nil, err
+	}
+	if contracts.chainConfigCaller, err = bas.NewChainConfigCaller(configAddr, caller); err != nil {
+		return nil, err
+	}
+	if contracts.validatorSetBeforeLubanCaller, err = bsc.NewValidatorSetBeforeLubanCaller(stakingAddr, caller); err != nil {
+		return nil, err
+	}
+	if contracts.slashIndicatorTransactor, err = bsc.NewSlashIndicatorTransactor(slashAddr, nil); err != nil {
+		return nil, err
+	}
+	if contracts.stakeHubCaller, err = bsc.NewStakeHubCaller(stakeHubAddr, caller); err != nil {
+		return nil, err
+	}
+	if contracts.stakeHubTransactor, err = bsc.NewStakeHubTransactor(stakeHubAddr, nil); err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
@@ -4,19 +4,17 @@ import (
 	"container/heap"
 	"context"
 	"errors"
-	"math"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/bas"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/systemcontracts"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
-	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // the params should be two blocks' time(timestamp)
@@ -30,11 +28,8 @@ func isBreatheBlock(lastBlockTime, blockTime uint64) bool {
 
 // initializeFeynmanContract initialize new contracts of Feynman fork
 func (p *Parlia) initializeFeynmanContract(state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool,
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool,
 ) error {
-	// method
-	method := "initialize"
-
 	// initialize contracts
 	contracts := []string{
 		systemcontracts.StakeHubContract,
@@ -43,17 +38,18 @@ func (p *Parlia) initializeFeynmanContract(state *state.StateDB, header *types.H
 		systemcontracts.TimelockContract,
 		systemcontracts.TokenRecoverPortalContract,
 	}
-	// get packed data
-	data, err := p.stakeHubABI.Pack(method)
+	// get packed data, all the contracts share the same initialize method
+	tx, err := p.contracts.stakeHubTransactor.Initialize(bas.SystemTxOpts(header.Coinbase, common.Big0))
 	if err != nil {
 		log.Error("Unable to pack tx for initialize feynman contracts", "error", err)
 		return err
 	}
+	data := tx.Data()
 	for _, c := range contracts {
 		msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(c), data, common.Big0)
 		// apply message
 		log.Info("initialize feynman contract", "block number", header.Number.Uint64(), "contract", c)
-		err = p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+		err = p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 		if err != nil {
 			return err
 		}
@@ -96,15 +92,14 @@ func (h *ValidatorHeap) Pop() interface{} {
 }
 
 func (p *Parlia) updateValidatorSetV2(state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool,
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool,
 ) error {
 	// 1. get all validators and its voting power
-	blockNr := rpc.BlockNumberOrHashWithHash(header.ParentHash, false)
-	validatorItems, err := p.getValidatorElectionInfo(blockNr)
+	validatorItems, err := p.getValidatorElectionInfo(header.ParentHash)
 	if err != nil {
 		return err
 	}
-	maxElectedValidators, err := p.getMaxElectedValidators(blockNr)
+	maxElectedValidators, err := p.getMaxElectedValidators(header.ParentHash)
 	if err != nil {
 		return err
 	}
@@ -123,40 +118,18 @@ func (p *Parlia) updateValidatorSetV2(state *state.StateDB, header *types.Header
 	// get system message
 	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontracts.ValidatorContract), data, common.Big0)
 	// apply message
-	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
-func (p *Parlia) getValidatorElectionInfo(blockNr rpc.BlockNumberOrHash) ([]ValidatorItem, error) {
+func (p *Parlia) getValidatorElectionInfo(blockHash common.Hash) ([]ValidatorItem, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	method := "getValidatorElectionInfo"
-	toAddress := common.HexToAddress(systemcontracts.StakeHubContract)
-	gas := (hexutil.Uint64)(uint64(math.MaxUint64 / 2))
-
-	data, err := p.stakeHubABI.Pack(method, big.NewInt(0), big.NewInt(0))
-	if err != nil {
-		log.Error("Unable to pack tx for getValidatorElectionInfo", "error", err)
-		return nil, err
-	}
-	msgData := (hexutil.Bytes)(data)
-
-	result, err := p.ethAPI.Call(ctx, ethapi.TransactionArgs{
-		Gas:  &gas,
-		To:   &toAddress,
-		Data: &msgData,
-	}, &blockNr, nil, nil)
+	info, err := p.contracts.stakeHubCaller.GetValidatorElectionInfo(&bind.CallOpts{BlockHash: blockHash, Context: ctx}, big.NewInt(0), big.NewInt(0))
 	if err != nil {
 		return nil, err
 	}
-
-	var validators []common.Address
-	var votingPowers []*big.Int
-	var voteAddrs [][]byte
-	var totalLength *big.Int
-	if err := p.stakeHubABI.UnpackIntoInterface(&[]interface{}{&validators, &votingPowers, &voteAddrs, &totalLength}, method, result); err != nil {
-		return nil, err
-	}
+	validators, votingPowers, voteAddrs, totalLength := info.ConsensusAddrs, info.VotingPowers, info.VoteAddrs, info.TotalLength
 	if totalLength.Int64() != int64(len(validators)) || totalLength.Int64() != int64(len(votingPowers)) || totalLength.Int64() != int64(len(voteAddrs)) {
 		return nil, errors.New("validator length not match")
 	}
@@ -173,35 +146,11 @@ func (p *Parlia) getValidatorElectionInfo(blockNr rpc.BlockNumberOrHash) ([]Vali
 	return validatorItems, nil
 }
 
-func (p *Parlia) getMaxElectedValidators(blockNr rpc.BlockNumberOrHash) (maxElectedValidators *big.Int, err error) {
+func (p *Parlia) getMaxElectedValidators(blockHash common.Hash) (maxElectedValidators *big.Int, err error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	method := "maxElectedValidators"
-	toAddress := common.HexToAddress(systemcontracts.StakeHubContract)
-	gas := (hexutil.Uint64)(uint64(math.MaxUint64 / 2))
-
-	data, err := p.stakeHubABI.Pack(method)
-	if err != nil {
-		log.Error("Unable to pack tx for maxElectedValidators", "error", err)
-		return nil, err
-	}
-	msgData := (hexutil.Bytes)(data)
-
-	result, err := p.ethAPI.Call(ctx, ethapi.TransactionArgs{
-		Gas:  &gas,
-		To:   &toAddress,
-		Data: &msgData,
-	}, &blockNr, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := p.stakeHubABI.UnpackIntoInterface(&maxElectedValidators, method, result); err != nil {
-		return nil, err
-	}
-
-	return maxElectedValidators, nil
+	return p.contracts.stakeHubCaller.MaxElectedValidators(&bind.CallOpts{BlockHash: blockHash, Context: ctx})
 }
 
 func getTopValidatorsByVotingPower(validatorItems []ValidatorItem, maxElectedValidators *big.Int) ([]common.Address, []uint64, [][]byte) {
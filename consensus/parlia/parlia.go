@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/gopool"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -33,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/contracts/bas"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -229,13 +231,14 @@ type Parlia struct {
 
 	lock sync.RWMutex // Protects the signer fields
 
-	ethAPI                     *ethapi.BlockChainAPI
-	VotePool                   consensus.VotePool
-	validatorSetABIBeforeLuban abi.ABI
-	validatorSetABI            abi.ABI
-	slashABI                   abi.ABI
-	tokenomicsABI              abi.ABI
-	stakeHubABI                abi.ABI
+	ethAPI    *ethapi.BlockChainAPI
+	VotePool  consensus.VotePool
+	contracts *systemContracts
+
+	// validatorSetABI packs the validator set methods of the BSC Luban and later
+	// forks, which the Chiliz validator contract does not implement, so that no
+	// binding exists for them.
+	validatorSetABI abi.ABI
 
 	// The fields below are for testing only
 	fakeDiff bool // Skip difficulty verifications
@@ -270,41 +273,26 @@ func New(
 	if err != nil {
 		panic(err)
 	}
-	vABIBeforeLuban, err := abi.JSON(strings.NewReader(validatorSetABIBeforeLuban))
-	if err != nil {
-		panic(err)
-	}
-	vABI, err := abi.JSON(strings.NewReader(validatorSetABI))
+	vABI, err := bas.StakingMetaData.GetAbi()
 	if err != nil {
 		panic(err)
 	}
-	sABI, err := abi.JSON(strings.NewReader(slashABI))
-	if err != nil {
-		panic(err)
-	}
-	tABI, err := abi.JSON(strings.NewReader(tokenomicsABI))
-	if err != nil {
-		panic(err)
-	}
-	stABI, err := abi.JSON(strings.NewReader(stakeABI))
+	contracts, err := newSystemContracts(ethAPI)
 	if err != nil {
 		panic(err)
 	}
 	c := &Parlia{
-		chainConfig:                chainConfig,
-		config:                     parliaConfig,
-		genesisHash:                genesisHash,
-		db:                         db,
-		ethAPI:                     ethAPI,
-		recentSnaps:                recentSnaps,
-		recentHeaders:              recentHeaders,
-		signatures:                 signatures,
-		validatorSetABIBeforeLuban: vABIBeforeLuban,
-		validatorSetABI:            vABI,
-		slashABI:                   sABI,
-		tokenomicsABI:              tABI,
-		stakeHubABI:                stABI,
-		signer:                     types.LatestSigner(chainConfig),
+		chainConfig:     chainConfig,
+		config:          parliaConfig,
+		genesisHash:     genesisHash,
+		db:              db,
+		ethAPI:          ethAPI,
+		recentSnaps:     recentSnaps,
+		recentHeaders:   recentHeaders,
+		signatures:      signatures,
+		validatorSetABI: *vABI,
+		contracts:       contracts,
+		signer:          types.LatestSigner(chainConfig),
 	}
 
 	return c
@@ -386,6 +374,7 @@ func (p *Parlia) VerifyHeaders(chain consensus.ChainHeaderReader, headers []*typ
 // After luban fork:  |---Extra Vanity---|---Validators Number and Validators Bytes (or Empty)---|---Vote Attestation (or Empty)---|---Extra Seal---|
 // After bohr fork:   |---Extra Vanity---|---Validators Number and Validators Bytes (or Empty)---|---Turn Length (or Empty)---|---Vote Attestation (or Empty)---|---Extra Seal---|
 // After snake8 fork:   |---Extra Vanity---|---Validators Bytes (or Empty) ---|---Turn Length (or Empty)---/---Vote Attestation (or Empty)---/---Frequency Data Prefix---|---Parent Timestamp---|---Frequency data---|---Extra Seal---|
+// After ChainConfigParams fork, the epoch blocks carry the ChainConfig parameters right ahead of the Extra Seal.
 func getValidatorBytesFromHeader(header *types.Header, chainConfig *params.ChainConfig, parliaConfig *params.ParliaConfig) []byte {
 	if len(header.Extra) <= extraVanity+extraSeal {
 		return nil
@@ -393,7 +382,7 @@ func getValidatorBytesFromHeader(header *types.Header, chainConfig *params.Chain
 
 	if !chainConfig.IsLuban(header.Number) {
 		start := extraVanity
-		end := len(header.Extra) - extraSeal
+		end := len(header.Extra) - extraSeal - chainConfigParamsSize(header, chainConfig, parliaConfig)
 		if bytes.HasPrefix(header.Extra[start:end], validatorFrequencyDataPrefix) {
 			return nil
 		}
@@ -452,7 +441,7 @@ func getVoteAttestationFromHeader(header *types.Header, chainConfig *params.Chai
 		if chainConfig.IsBohr(header.Number, header.Time) {
 			start += turnLengthSize
 		}
-		end := len(header.Extra) - extraSeal
+		end := len(header.Extra) - extraSeal - chainConfigParamsSize(header, chainConfig, parliaConfig)
 		if end <= start {
 			return nil, nil
 		}
@@ -501,7 +490,7 @@ func (p *Parlia) isSnake8Enabled(chain consensus.ChainHeaderReader, header *type
     }
 
     start := extraVanity
-    end := len(header.Extra) - extraSeal
+    end := len(header.Extra) - extraSeal - chainConfigParamsSize(header, p.chainConfig, p.config)
     // Skip validator data (only on epoch blocks)
     if !p.chainConfig.IsLuban(header.Number) {
         // Before Luban: validators are 20 bytes each, no count byte
@@ -857,6 +846,12 @@ func (p *Parlia) snapshot(chain consensus.ChainHeaderReader, number uint64, hash
 					snap.TurnLength = *turnLength
 				}
 
+				// get the chain config parameters from headers, if carried
+				snap.ChainConfig, err = parseChainConfigParams(checkpoint, p.chainConfig, p.config)
+				if err != nil {
+					return nil, err
+				}
+
 				// snap.Recents is currently empty, which affects the following:
 				// a. The function SignRecently - This is acceptable since an empty snap.Recents results in a more lenient check.
 				// b. The function blockTimeVerifyForRamanujanFork - This is also acceptable as it won't be invoked during `snap.apply`.
@@ -1133,8 +1128,9 @@ func (p *Parlia) assembleVoteAttestation(chain consensus.ChainHeaderReader, head
 		return err
 	}
 
-	// Insert vote attestation into header extra ahead extra seal.
-	extraSealStart := len(header.Extra) - extraSeal
+	// Insert vote attestation into header extra ahead extra seal, and ahead the
+	// chain config parameters of the epoch blocks.
+	extraSealStart := len(header.Extra) - extraSeal - chainConfigParamsSize(header, p.chainConfig, p.config)
 	extraSealBytes := header.Extra[extraSealStart:]
 	header.Extra = append(header.Extra[0:extraSealStart], buf.Bytes()...)
 	header.Extra = append(header.Extra, extraSealBytes...)
@@ -1171,7 +1167,7 @@ func (p *Parlia) Prepare(chain consensus.ChainHeaderReader, header *types.Header
  	if p.isSnake8Enabled(chain, header) {
      	stakes := make(map[common.Address]*big.Int)
 		for addr := range snap.Validators {
-			totalDelegated, err := p.getValidatorTotalDelegated(addr, number-1)
+			totalDelegated, err := p.getValidatorTotalDelegated(addr, (number-1)/snap.epochBlockInterval())
 			if err != nil {
 				log.Error("error when fetching total delegated amount ", err)
 			}
@@ -1225,6 +1221,10 @@ func (p *Parlia) Prepare(chain consensus.ChainHeaderReader, header *types.Header
         header.Extra = append(header.Extra, snap.FrequencyRLP...)
     }
 
+	if err := p.prepareChainConfigParams(header); err != nil {
+		return err
+	}
+
 	// add extra seal space
 	header.Extra = append(header.Extra, make([]byte, extraSeal)...)
 
@@ -1302,7 +1302,7 @@ func (p *Parlia) verifyTurnLength(chain consensus.ChainHeaderReader, header *typ
 
 func (p *Parlia) distributeFinalityReward(chain consensus.ChainHeaderReader, state *state.StateDB, header *types.Header,
 	cx core.ChainContext, txs *[]*types.Transaction, receipts *[]*types.Receipt, systemTxs *[]*types.Transaction,
-	usedGas *uint64, mining bool) error {
+	usedGas *uint64, mining, simulate bool) error {
 	currentHeight := header.Number.Uint64()
 	epoch := p.config.Epoch
 	chainConfig := chain.Config()
@@ -1374,7 +1374,7 @@ func (p *Parlia) distributeFinalityReward(chain consensus.ChainHeaderReader, sta
 		return err
 	}
 	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontracts.ValidatorContract), data, common.Big0)
-	return p.applyTransaction(msg, state, header, cx, txs, receipts, systemTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, cx, txs, receipts, systemTxs, usedGas, mining, simulate)
 }
 
 // Finalize implements consensus.Engine, ensuring no uncles are set, nor block
@@ -1405,6 +1405,24 @@ func (p *Parlia) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 		return err
 	}
 
+	if err := p.verifyChainConfigParams(header); err != nil {
+		return err
+	}
+
+	if err := p.applySystemTxs(chain, header, parent, snap, state, txs, receipts, systemTxs, usedGas, false); err != nil {
+		return err
+	}
+	if len(*systemTxs) > 0 {
+		return errors.New("the length of systemTxs do not match")
+	}
+	return nil
+}
+
+// applySystemTxs applies the system transactions injected at the end of the
+// given block on top of its parent, checking them against systemTxs. Simulated
+// blocks carry no system transactions, they are applied unsigned instead.
+func (p *Parlia) applySystemTxs(chain consensus.ChainHeaderReader, header, parent *types.Header, snap *Snapshot, state *state.StateDB,
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, systemTxs *[]*types.Transaction, usedGas *uint64, simulate bool) error {
 	cx := chainContext{Chain: chain, parlia: p}
 
 	if p.chainConfig.IsFeynman(header.Number, header.Time) {
@@ -1412,7 +1430,7 @@ func (p *Parlia) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 	}
 
 	if p.chainConfig.IsOnFeynman(header.Number, parent.Time, header.Time) {
-		err := p.initializeFeynmanContract(state, header, cx, txs, receipts, systemTxs, usedGas, false)
+		err := p.initializeFeynmanContract(state, header, cx, txs, receipts, systemTxs, usedGas, false, simulate)
 		if err != nil {
 			log.Error("init feynman contract failed", "error", err)
 		}
@@ -1420,7 +1438,7 @@ func (p *Parlia) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 
 	// No block rewards in PoA, so the state remains as is and uncles are dropped
 	if header.Number.Cmp(common.Big1) == 0 {
-		err := p.initContract(state, header, cx, txs, receipts, systemTxs, usedGas, false)
+		err := p.initContract(state, header, cx, txs, receipts, systemTxs, usedGas, false, simulate)
 		if err != nil {
 			log.Error("init contract failed", "error", err)
 			return err
@@ -1442,7 +1460,7 @@ func (p *Parlia) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 
 		if !signedRecently {
 			log.Trace("slash validator", "block hash", header.Hash(), "address", spoiledVal)
-			err = p.slash(spoiledVal, state, header, cx, txs, receipts, systemTxs, usedGas, false)
+			err := p.slash(spoiledVal, state, header, cx, txs, receipts, systemTxs, usedGas, false, simulate)
 			if err != nil {
 				// it is possible that slash validator failed because of the slash channel is disabled.
 				log.Error("slash validator failed", "block hash", header.Hash(), "address", spoiledVal)
@@ -1450,13 +1468,13 @@ func (p *Parlia) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 		}
 	}
 	val := header.Coinbase
-	err = p.distributeIncoming(val, state, header, cx, txs, receipts, systemTxs, usedGas, false)
+	err := p.distributeIncoming(val, state, header, cx, txs, receipts, systemTxs, usedGas, false, simulate)
 	if err != nil {
 		return err
 	}
 
 	if p.chainConfig.IsPlato(header.Number) {
-		if err := p.distributeFinalityReward(chain, state, header, cx, txs, receipts, systemTxs, usedGas, false); err != nil {
+		if err := p.distributeFinalityReward(chain, state, header, cx, txs, receipts, systemTxs, usedGas, false, simulate); err != nil {
 			return err
 		}
 	}
@@ -1465,15 +1483,11 @@ func (p *Parlia) Finalize(chain consensus.ChainHeaderReader, header *types.Heade
 	if p.chainConfig.IsFeynman(header.Number, header.Time) && isBreatheBlock(parent.Time, header.Time) {
 		// we should avoid update validators in the Feynman upgrade block
 		if !p.chainConfig.IsOnFeynman(header.Number, parent.Time, header.Time) {
-			if err := p.updateValidatorSetV2(state, header, cx, txs, receipts, systemTxs, usedGas, false); err != nil {
+			if err := p.updateValidatorSetV2(state, header, cx, txs, receipts, systemTxs, usedGas, false, simulate); err != nil {
 				return err
 			}
 		}
 	}
-
-	if len(*systemTxs) > 0 {
-		return errors.New("the length of systemTxs do not match")
-	}
 	return nil
 }
 
@@ -1500,14 +1514,14 @@ func (p *Parlia) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 	}
 
 	if p.chainConfig.IsOnFeynman(header.Number, parent.Time, header.Time) {
-		err := p.initializeFeynmanContract(state, header, cx, &txs, &receipts, nil, &header.GasUsed, true)
+		err := p.initializeFeynmanContract(state, header, cx, &txs, &receipts, nil, &header.GasUsed, true, false)
 		if err != nil {
 			log.Error("init feynman contract failed", "error", err)
 		}
 	}
 
 	if header.Number.Cmp(common.Big1) == 0 {
-		err := p.initContract(state, header, cx, &txs, &receipts, nil, &header.GasUsed, true)
+		err := p.initContract(state, header, cx, &txs, &receipts, nil, &header.GasUsed, true, false)
 		if err != nil {
 			log.Error("init contract failed", "error", err)
 			return nil, nil, err
@@ -1532,7 +1546,7 @@ func (p *Parlia) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 			}
 		}
 		if !signedRecently {
-			err = p.slash(spoiledVal, state, header, cx, &txs, &receipts, nil, &header.GasUsed, true)
+			err = p.slash(spoiledVal, state, header, cx, &txs, &receipts, nil, &header.GasUsed, true, false)
 			if err != nil {
 				// it is possible that slash validator failed because of the slash channel is disabled.
 				log.Error("slash validator failed", "block hash", header.Hash(), "address", spoiledVal)
@@ -1540,13 +1554,13 @@ func (p *Parlia) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 		}
 	}
 
-	err := p.distributeIncoming(p.val, state, header, cx, &txs, &receipts, nil, &header.GasUsed, true)
+	err := p.distributeIncoming(p.val, state, header, cx, &txs, &receipts, nil, &header.GasUsed, true, false)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if p.chainConfig.IsPlato(header.Number) {
-		if err := p.distributeFinalityReward(chain, state, header, cx, &txs, &receipts, nil, &header.GasUsed, true); err != nil {
+		if err := p.distributeFinalityReward(chain, state, header, cx, &txs, &receipts, nil, &header.GasUsed, true, false); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -1555,7 +1569,7 @@ func (p *Parlia) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *
 	if p.chainConfig.IsFeynman(header.Number, header.Time) && isBreatheBlock(parent.Time, header.Time) {
 		// we should avoid update validators in the Feynman upgrade block
 		if !p.chainConfig.IsOnFeynman(header.Number, parent.Time, header.Time) {
-			if err := p.updateValidatorSetV2(state, header, cx, &txs, &receipts, nil, &header.GasUsed, true); err != nil {
+			if err := p.updateValidatorSetV2(state, header, cx, &txs, &receipts, nil, &header.GasUsed, true, false); err != nil {
 				return nil, nil, err
 			}
 		}
@@ -1878,6 +1892,11 @@ func (p *Parlia) APIs(chain consensus.ChainHeaderReader) []rpc.API {
 		Version:   "1.0",
 		Service:   &API{chain: chain, parlia: p},
 		Public:    false,
+	}, {
+		Namespace: "chiliz",
+		Version:   "1.0",
+		Service:   &StakingAPI{api: &API{chain: chain, parlia: p}},
+		Public:    false,
 	}}
 }
 
@@ -1974,53 +1993,30 @@ func getNewSupplyForBlockDragon8Fix(forkTime uint64, currentTime uint64) (*big.I
 }
 
 func (p *Parlia) getLastSupplyFromTokenomics(header *types.Header) (*big.Int, error) {
-	method := "getTotalSupply"
-	data, err := p.tokenomicsABI.Pack(method)
-	if err != nil {
-		return nil, err
-	}
-	msgData := (hexutil.Bytes)(data)
-	gas := (hexutil.Uint64)(uint64(math.MaxUint64 / 2))
-	args := ethapi.TransactionArgs{
-		From: &header.Coinbase,
-		To:   &systemcontract.TokenomicsContractAddress,
-		Gas:  &gas,
-		Data: &msgData,
-	}
-	blockNum := (rpc.BlockNumber)(big.NewInt(0).Sub(header.Number, big.NewInt(1)).Int64())
-	blockNr := rpc.BlockNumberOrHashWithNumber(blockNum)
-	res, err := p.ethAPI.Call(context.Background(), args, &blockNr, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	initialTotalSupply := big.NewInt(0)
-	if err := p.tokenomicsABI.UnpackIntoInterface(&initialTotalSupply, method, res); err != nil {
-		return nil, err
-	}
-	return initialTotalSupply, nil
+	return p.contracts.tokenomicsCaller.GetTotalSupply(&bind.CallOpts{
+		From:        header.Coinbase,
+		BlockNumber: new(big.Int).Sub(header.Number, big.NewInt(1)),
+		Context:     context.Background(),
+	})
 }
 
 func (p *Parlia) distributeToTokenomics(amount *big.Int, inflationPct *big.Int, validator common.Address, newTotalSupply *big.Int,
 	state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
-	// method
-	method := "deposit"
-
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
 	// get packed data
-	data, err := p.tokenomicsABI.Pack(method, validator, newTotalSupply, inflationPct)
+	tx, err := p.contracts.tokenomicsTransactor.Deposit(bas.SystemTxOpts(header.Coinbase, amount), validator, newTotalSupply, inflationPct)
 	if err != nil {
 		log.Error("Unable to pack tx for tokenomics deposit", "error", err)
 		return err
 	}
 	// get system message
-	msg := p.getSystemMessage(header.Coinbase, systemcontract.TokenomicsContractAddress, data, amount)
+	msg := p.getSystemMessage(header.Coinbase, systemcontract.TokenomicsContractAddress, tx.Data(), amount)
 	// apply message
-	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
 func (p *Parlia) distributePepper8(state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
 
 	amount := p.GetPepper8MintAmount()
 	recipient := p.getPepper8RecipientAddress()
@@ -2028,50 +2024,18 @@ func (p *Parlia) distributePepper8(state *state.StateDB, header *types.Header, c
 	// get system message
 	msg := p.getSystemMessage(header.Coinbase, recipient, nil, amount)
 	// apply message
-	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
 // get total delegated amount at epoch for validator
-func (p *Parlia) getValidatorTotalDelegated(validatorAddress common.Address, blockNumber uint64) (*big.Int, error) {
-	method := "getValidatorStatusAtEpoch"
-	epoch := blockNumber / p.chainConfig.Parlia.Epoch
-	data, err := p.validatorSetABI.Pack(method, validatorAddress, epoch)
-	if err != nil {
-		log.Error("Unable to pack tx for getValidatorStatusAtEpoch", "error", err)
-		return nil, err
-	}
-
+func (p *Parlia) getValidatorTotalDelegated(validatorAddress common.Address, epoch uint64) (*big.Int, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	msgData := (hexutil.Bytes)(data)
-	toAddress := common.HexToAddress(systemcontracts.ValidatorContract)
-	gas := (hexutil.Uint64)(uint64(math.MaxUint64 / 2))
-	result, err := p.ethAPI.Call(ctx, ethapi.TransactionArgs{
-		Gas:  &gas,
-		To:   &toAddress,
-		Data: &msgData,
-	}, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var status struct {
-		OwnerAddress   common.Address `json:"ownerAddress"`   // Address of the owner
-		Status         uint8          `json:"status"`         // Status of the validator
-		TotalDelegated *big.Int       `json:"totalDelegated"` // Total amount delegated (uint256)
-		SlashesCount   uint32         `json:"slashesCount"`   // Count of slashes (uint32)
-		ChangedAt      uint64         `json:"changedAt"`      // Timestamp when status changed (uint64)
-		JailedBefore   uint64         `json:"jailedBefore"`   // Timestamp when jailed (uint64)
-		ClaimedAt      uint64         `json:"claimedAt"`      // Timestamp when rewards were claimed (uint64)
-		CommissionRate uint16         `json:"commissionRate"` // Commission rate (uint16)
-		TotalRewards   *big.Int       `json:"totalRewards"`   // Total rewards earned (uint96)
-	}
-	err = p.validatorSetABI.UnpackIntoInterface(&status, method, result)
+	status, err := p.contracts.stakingCaller.GetValidatorStatusAtEpoch(&bind.CallOpts{Context: ctx}, validatorAddress, epoch)
 	if err != nil {
 		return nil, err
 	}
-
 	return status.TotalDelegated, nil
 }
 
@@ -2124,7 +2088,7 @@ func (p *Parlia) getCurrentValidators(blockHash common.Hash, blockNum *big.Int)
 
 // distributeIncoming distributes system incoming of the block
 func (p *Parlia) distributeIncoming(val common.Address, state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
 	var (
 		coinbase  = header.Coinbase
 		isDragon8 = p.chainConfig.IsDragon8(header.Time) || p.chainConfig.IsDragon8Fix(header.Time)
@@ -2146,7 +2110,7 @@ func (p *Parlia) distributeIncoming(val common.Address, state *state.StateDB, he
 		// distribute Pepper8
 		log.Trace("distributePRB", "block hash", header.Number.Uint64())
 		state.AddBalance(coinbase, uint256.MustFromBig(p.GetPepper8MintAmount()))
-		if err := p.distributePepper8(state, header, chain, txs, receipts, receivedTxs, usedGas, mining); err != nil {
+		if err := p.distributePepper8(state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate); err != nil {
 			return err
 		}
 	}
@@ -2174,7 +2138,7 @@ func (p *Parlia) distributeIncoming(val common.Address, state *state.StateDB, he
 
 		// DEPOSIT to tokenomics
 		log.Trace("distribute to tokenomics", "block hash", header.Hash(), "amount", blockAmount, "inflation", inflationPct, "lastSupply", lastSupply, "newTotalSupply", newTotalSupply)
-		if err := p.distributeToTokenomics(blockAmount, inflationPct, val, newTotalSupply, state, header, chain, txs, receipts, receivedTxs, usedGas, mining); err != nil {
+		if err := p.distributeToTokenomics(blockAmount, inflationPct, val, newTotalSupply, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate); err != nil {
 			return err
 		}
 	}
@@ -2191,7 +2155,7 @@ func (p *Parlia) distributeIncoming(val common.Address, state *state.StateDB, he
 		rewards := new(big.Int)
 		rewards = rewards.Div(balance.ToBig(), big.NewInt(systemRewardPercent))
 		if rewards.Cmp(common.Big0) > 0 {
-			err := p.distributeToSystem(rewards, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+			err := p.distributeToSystem(rewards, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 			if err != nil {
 				return err
 			}
@@ -2200,40 +2164,34 @@ func (p *Parlia) distributeIncoming(val common.Address, state *state.StateDB, he
 		}
 	}
 	log.Trace("distribute to validator contract", "block hash", header.Hash(), "amount", balance)
-	return p.distributeToValidator(balance.ToBig(), val, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.distributeToValidator(balance.ToBig(), val, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
 // slash spoiled validators
 func (p *Parlia) slash(spoiledVal common.Address, state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
-	// method
-	method := "slash"
-
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
 	// get packed data
-	data, err := p.slashABI.Pack(method,
-		spoiledVal,
-	)
+	tx, err := p.contracts.slashIndicatorTransactor.Slash(bas.SystemTxOpts(header.Coinbase, common.Big0), spoiledVal)
 	if err != nil {
 		log.Error("Unable to pack tx for slash", "error", err)
 		return err
 	}
 	// get system message
-	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontract.SlashContract), data, common.Big0)
+	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontract.SlashContract), tx.Data(), common.Big0)
 	// apply message
-	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
 // init contract
 func (p *Parlia) initContract(state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
-	// method
-	method := "init"
-	// get packed data
-	data, err := p.validatorSetABI.Pack(method)
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
+	// get packed data, all system contracts share the same init method
+	tx, err := p.contracts.stakingTransactor.Init(bas.SystemTxOpts(header.Coinbase, common.Big0))
 	if err != nil {
 		log.Error("Unable to pack tx for init validator set", "error", err)
 		return err
 	}
+	data := tx.Data()
 	contracts := []common.Address{
 		common.HexToAddress(systemcontract.ValidatorContract),
 		common.HexToAddress(systemcontract.SlashContract),
@@ -2251,7 +2209,7 @@ func (p *Parlia) initContract(state *state.StateDB, header *types.Header, chain
 		msg := p.getSystemMessage(header.Coinbase, c, data, common.Big0)
 		// apply message
 		log.Info("init contract", "block hash", header.Hash(), "contract", c)
-		err = p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+		err = p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 		if err != nil {
 			return err
 		}
@@ -2260,32 +2218,27 @@ func (p *Parlia) initContract(state *state.StateDB, header *types.Header, chain
 }
 
 func (p *Parlia) distributeToSystem(amount *big.Int, state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
 	// get system message
 	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontract.SystemRewardContract), nil, amount)
 	// apply message
-	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
 // distributeToValidator deposits validator reward to validator contract
 func (p *Parlia) distributeToValidator(amount *big.Int, validator common.Address,
 	state *state.StateDB, header *types.Header, chain core.ChainContext,
-	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool) error {
-	// method
-	method := "deposit"
-
+	txs *[]*types.Transaction, receipts *[]*types.Receipt, receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool) error {
 	// get packed data
-	data, err := p.validatorSetABI.Pack(method,
-		validator,
-	)
+	tx, err := p.contracts.stakingTransactor.Deposit(bas.SystemTxOpts(header.Coinbase, amount), validator)
 	if err != nil {
 		log.Error("Unable to pack tx for deposit", "error", err)
 		return err
 	}
 	// get system message
-	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontract.ValidatorContract), data, amount)
+	msg := p.getSystemMessage(header.Coinbase, common.HexToAddress(systemcontract.ValidatorContract), tx.Data(), amount)
 	// apply message
-	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining)
+	return p.applyTransaction(msg, state, header, chain, txs, receipts, receivedTxs, usedGas, mining, simulate)
 }
 
 // get system message
@@ -2308,7 +2261,7 @@ func (p *Parlia) applyTransaction(
 	header *types.Header,
 	chainContext core.ChainContext,
 	txs *[]*types.Transaction, receipts *[]*types.Receipt,
-	receivedTxs *[]*types.Transaction, usedGas *uint64, mining bool,
+	receivedTxs *[]*types.Transaction, usedGas *uint64, mining, simulate bool,
 ) (err error) {
 	nonce := state.GetNonce(msg.From())
 	expectedTx := types.NewTransaction(nonce, *msg.To(), msg.Value(), msg.Gas(), msg.GasPrice(), msg.Data())
@@ -2319,6 +2272,8 @@ func (p *Parlia) applyTransaction(
 		if err != nil {
 			return err
 		}
+	} else if simulate {
+		// Simulated blocks carry their system transactions unsigned
 	} else {
 		if receivedTxs == nil || len(*receivedTxs) == 0 || (*receivedTxs)[0] == nil {
 			return errors.New("supposed to get a actual transaction, but get none")
@@ -2423,6 +2378,27 @@ func (p *Parlia) GetFinalizedHeader(chain consensus.ChainHeaderReader, header *t
 	return chain.GetHeader(snap.Attestation.SourceHash, snap.Attestation.SourceNumber)
 }
 
+// GetSigner returns the validator which sealed the header.
+func (p *Parlia) GetSigner(header *types.Header) (common.Address, error) {
+	return ecrecover(header, p.signatures, p.chainConfig.ChainID)
+}
+
+// GetVoteAttestation returns the vote attestation carried by the header, nil if
+// there is none.
+func (p *Parlia) GetVoteAttestation(header *types.Header) (*types.VoteAttestation, error) {
+	return getVoteAttestationFromHeader(header, p.chainConfig, p.config)
+}
+
+// GetEpochValidators returns the validator set elected at an epoch block, nil
+// if the header is not an epoch block.
+func (p *Parlia) GetEpochValidators(header *types.Header) ([]common.Address, error) {
+	if header.Number.Uint64()%p.config.Epoch != 0 {
+		return nil, nil
+	}
+	validators, _, err := parseValidators(header, p.chainConfig, p.config)
+	return validators, err
+}
+
 // ===========================     utility function        ==========================
 func (p *Parlia) backOffTime(snap *Snapshot, header *types.Header, val common.Address) uint64 {
 	if snap.inturn(val) {
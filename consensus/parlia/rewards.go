@@ -0,0 +1,139 @@
+package parlia
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/contracts/bas"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxRewardsRange is the maximum number of blocks aggregated by a single
+// validator rewards request.
+const maxRewardsRange = 10000
+
+// errBodiesUnavailable is returned if rewards are requested from a chain which
+// does not store block bodies and receipts, e.g. a light client.
+var errBodiesUnavailable = errors.New("block bodies and receipts not available")
+
+// blockReader is a chain serving the block bodies and receipts the rewards are
+// derived from.
+type blockReader interface {
+	GetBlock(hash common.Hash, number uint64) *types.Block
+	GetReceiptsByHash(hash common.Hash) types.Receipts
+}
+
+// Rewards is the breakdown of the rewards paid out by the system transactions
+// at the end of blocks.
+type Rewards struct {
+	Fees             *hexutil.Big `json:"fees"`                  // Gas fees paid by the transactions to the validator
+	Minted           *hexutil.Big `json:"minted"`                // Dragon8 inflation deposited into the tokenomics contract
+	SystemReward     *hexutil.Big `json:"systemReward"`          // Share of the fees sent to the system reward contract
+	ValidatorDeposit *hexutil.Big `json:"validatorDeposit"`      // Fees deposited to the validator into the staking contract
+	Pepper8Mint      *hexutil.Big `json:"pepper8Mint,omitempty"` // One-off Pepper8 mint, if any
+}
+
+func newRewards() Rewards {
+	return Rewards{
+		Fees:             new(hexutil.Big),
+		Minted:           new(hexutil.Big),
+		SystemReward:     new(hexutil.Big),
+		ValidatorDeposit: new(hexutil.Big),
+	}
+}
+
+// add accumulates other into the rewards.
+func (r *Rewards) add(other *Rewards) {
+	addBig(&r.Fees, other.Fees)
+	addBig(&r.Minted, other.Minted)
+	addBig(&r.SystemReward, other.SystemReward)
+	addBig(&r.ValidatorDeposit, other.ValidatorDeposit)
+	if other.Pepper8Mint != nil {
+		addBig(&r.Pepper8Mint, other.Pepper8Mint)
+	}
+}
+
+// addBig adds value to sum, allocating it if nil.
+func addBig(sum **hexutil.Big, value *hexutil.Big) {
+	total := new(big.Int).Set(value.ToInt())
+	if *sum != nil {
+		total.Add(total, (*sum).ToInt())
+	}
+	*sum = (*hexutil.Big)(total)
+}
+
+// BlockRewards is the breakdown of the rewards of a single block.
+type BlockRewards struct {
+	Number    hexutil.Uint64 `json:"number"`
+	Hash      common.Hash    `json:"hash"`
+	Validator common.Address `json:"validator"`
+	Rewards
+}
+
+// ValidatorRewards are the rewards of a validator aggregated over the blocks it
+// produced in a range.
+type ValidatorRewards struct {
+	Validator common.Address `json:"validator"`
+	Blocks    hexutil.Uint64 `json:"blocks"`
+	Rewards
+}
+
+// BlockRewards derives the rewards of a block from its system transactions, and
+// the fees from the receipts of the other ones.
+func (p *Parlia) BlockRewards(block *types.Block, receipts types.Receipts) (*BlockRewards, error) {
+	var (
+		header = block.Header()
+		txs    = block.Transactions()
+	)
+	if len(receipts) != len(txs) {
+		return nil, fmt.Errorf("receipt count mismatch: have %d, want %d", len(receipts), len(txs))
+	}
+	rewards := &BlockRewards{
+		Number:    hexutil.Uint64(header.Number.Uint64()),
+		Hash:      block.Hash(),
+		Validator: header.Coinbase,
+		Rewards:   newRewards(),
+	}
+	for i, tx := range txs {
+		kind, err := p.SystemTransactionKind(tx, header)
+		if err != nil {
+			return nil, err
+		}
+		value := (*hexutil.Big)(tx.Value())
+		switch kind {
+		case consensus.SystemTxNone:
+			tip, err := tx.EffectiveGasTip(header.BaseFee)
+			if err != nil {
+				return nil, err
+			}
+			addBig(&rewards.Fees, (*hexutil.Big)(new(big.Int).Mul(tip, new(big.Int).SetUint64(receipts[i].GasUsed))))
+		case consensus.SystemTxPepper8Mint:
+			addBig(&rewards.Pepper8Mint, value)
+		case consensus.SystemTxTokenomicsDeposit:
+			addBig(&rewards.Minted, value)
+		case consensus.SystemTxRewardDistribution:
+			switch *tx.To() {
+			case common.HexToAddress(systemcontract.SystemRewardContract):
+				addBig(&rewards.SystemReward, value)
+			case common.HexToAddress(systemcontract.ValidatorContract):
+				if isValidatorDeposit(tx.Data()) {
+					addBig(&rewards.ValidatorDeposit, value)
+				}
+			}
+		}
+	}
+	return rewards, nil
+}
+
+// isValidatorDeposit reports whether a call to the validator contract deposits
+// the fees of a block to its validator.
+func isValidatorDeposit(data []byte) bool {
+	staking, err := bas.StakingMetaData.GetAbi()
+	return err == nil && len(data) >= 4 && bytes.Equal(data[:4], staking.Methods["deposit"].ID)
+}
@@ -0,0 +1,81 @@
+package parlia
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/contracts/bas"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+func TestBlockRewards(t *testing.T) {
+	var (
+		engine     = New(params.ParliaTestChainConfig, rawdb.NewMemoryDatabase(), nil, common.Hash{})
+		key, _     = crypto.GenerateKey()
+		userKey, _ = crypto.GenerateKey()
+		coinbase   = crypto.PubkeyToAddress(key.PublicKey)
+		header     = &types.Header{Number: big.NewInt(1), Coinbase: coinbase, Difficulty: diffInTurn}
+	)
+	deposit, err := engine.contracts.stakingTransactor.Deposit(bas.SystemTxOpts(coinbase, big.NewInt(11)), coinbase)
+	require.NoError(t, err)
+	tokenomics, err := engine.contracts.tokenomicsTransactor.Deposit(bas.SystemTxOpts(coinbase, big.NewInt(7)), coinbase, common.Big1, common.Big1)
+	require.NoError(t, err)
+	initData, err := engine.contracts.stakingTransactor.Init(bas.SystemTxOpts(coinbase, common.Big0))
+	require.NoError(t, err)
+
+	var (
+		txs      []*types.Transaction
+		receipts []*types.Receipt
+		nonces   = make(map[*ecdsa.PrivateKey]uint64)
+	)
+	add := func(key *ecdsa.PrivateKey, to common.Address, value int64, gasPrice int64, data []byte, gasUsed uint64) {
+		tx, err := types.SignTx(types.NewTransaction(nonces[key], to, big.NewInt(value), 100000, big.NewInt(gasPrice), data), engine.signer, key)
+		require.NoError(t, err)
+		nonces[key]++
+		txs = append(txs, tx)
+		receipts = append(receipts, &types.Receipt{Status: types.ReceiptStatusSuccessful, GasUsed: gasUsed, TxHash: tx.Hash()})
+	}
+	add(userKey, common.HexToAddress("0x1234"), 1, 5, nil, 21000)
+	add(userKey, common.HexToAddress("0x1234"), 1, 2, nil, 30000)
+	add(key, getPepper8RecipientAddress(), 13, 0, nil, 0)
+	add(key, systemcontract.TokenomicsContractAddress, 7, 0, tokenomics.Data(), 0)
+	add(key, common.HexToAddress(systemcontract.SystemRewardContract), 3, 0, nil, 0)
+	add(key, common.HexToAddress(systemcontract.ValidatorContract), 11, 0, deposit.Data(), 0)
+	add(key, common.HexToAddress(systemcontract.ValidatorContract), 0, 0, initData.Data(), 0)
+
+	block := types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+	rewards, err := engine.BlockRewards(block, receipts)
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), rewards.Hash)
+	require.Equal(t, coinbase, rewards.Validator)
+
+	want := Rewards{
+		Fees:             (*hexutil.Big)(big.NewInt(5*21000 + 2*30000)),
+		Minted:           (*hexutil.Big)(big.NewInt(7)),
+		SystemReward:     (*hexutil.Big)(big.NewInt(3)),
+		ValidatorDeposit: (*hexutil.Big)(big.NewInt(11)),
+		Pepper8Mint:      (*hexutil.Big)(big.NewInt(13)),
+	}
+	require.Equal(t, want, rewards.Rewards)
+
+	// Aggregating blocks sums every component
+	total := newRewards()
+	total.add(&rewards.Rewards)
+	total.add(&rewards.Rewards)
+	require.Equal(t, big.NewInt(2*(5*21000+2*30000)), total.Fees.ToInt())
+	require.Equal(t, big.NewInt(26), total.Pepper8Mint.ToInt())
+
+	// Receipts must match the transactions
+	_, err = engine.BlockRewards(block, receipts[1:])
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package parlia
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// PrepareSimulated implements consensus.SystemTxSimulator, setting the coinbase
+// and difficulty of the given simulated block as if it was sealed in turn.
+func (p *Parlia) PrepareSimulated(chain consensus.ChainHeaderReader, header *types.Header) error {
+	snap, _, err := p.simulatedSnapshot(chain, header)
+	if err != nil {
+		return err
+	}
+	header.Coinbase = snap.inturnValidator()
+	header.Difficulty = new(big.Int).Set(diffInTurn)
+	return nil
+}
+
+// FinalizeSimulated implements consensus.SystemTxSimulator, applying the system
+// transactions Finalize would apply to the given block, without verifying its
+// header against the validator set.
+func (p *Parlia) FinalizeSimulated(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs *[]*types.Transaction,
+	receipts *[]*types.Receipt, usedGas *uint64) error {
+	snap, parent, err := p.simulatedSnapshot(chain, header)
+	if err != nil {
+		return err
+	}
+	return p.applySystemTxs(chain, header, parent, snap, state, txs, receipts, nil, usedGas, true)
+}
+
+// simulatedSnapshot returns the parent of the given simulated block along with
+// the snapshot at that parent. Simulated blocks are not sealed, so the snapshot
+// is the one of their last sealed ancestor moved up to the parent: the validator
+// set and the recent signers are left as they were at that ancestor.
+func (p *Parlia) simulatedSnapshot(chain consensus.ChainHeaderReader, header *types.Header) (*Snapshot, *types.Header, error) {
+	number := header.Number.Uint64()
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return nil, nil, consensus.ErrUnknownAncestor
+	}
+	sealed := parent
+	for sealed.Number.Sign() > 0 && len(sealed.Extra) < extraVanity+extraSeal {
+		if sealed = chain.GetHeader(sealed.ParentHash, sealed.Number.Uint64()-1); sealed == nil {
+			return nil, nil, consensus.ErrUnknownAncestor
+		}
+	}
+	snap, err := p.snapshot(chain, sealed.Number.Uint64(), sealed.Hash(), nil, p.isSnake8Enabled(chain, header), header)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sealed != parent {
+		snap = snap.copy()
+		snap.Number, snap.Hash = parent.Number.Uint64(), parent.Hash()
+	}
+	return snap, parent, nil
+}
@@ -0,0 +1,117 @@
+package parlia
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// simulatedChain serves a sealed genesis block along with the unsealed simulated
+// blocks built on top of it.
+type simulatedChain struct {
+	consensus.ChainHeaderReader
+	headers []*types.Header
+}
+
+func (c *simulatedChain) Config() *params.ChainConfig {
+	return params.ParliaTestChainConfig
+}
+
+func (c *simulatedChain) GenesisHeader() *types.Header {
+	return c.headers[0]
+}
+
+func (c *simulatedChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.GetHeaderByNumber(number); header != nil && header.Hash() == hash {
+		return header
+	}
+	return nil
+}
+
+func (c *simulatedChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+func (c *simulatedChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number < uint64(len(c.headers)) {
+		return c.headers[number]
+	}
+	return nil
+}
+
+func TestFinalizeSimulated(t *testing.T) {
+	validators := []common.Address{{1}, {2}, {3}}
+	extra := make([]byte, extraVanity+validatorNumberSize+len(validators)*validatorBytesLength+extraSeal)
+	extra[extraVanity] = byte(len(validators))
+	for i, val := range validators {
+		copy(extra[extraVanity+validatorNumberSize+i*validatorBytesLength:], val.Bytes())
+	}
+	var (
+		genesis = &types.Header{Number: common.Big0, Difficulty: diffInTurn, Extra: extra}
+		chain   = &simulatedChain{headers: []*types.Header{genesis}}
+		engine  = New(params.ParliaTestChainConfig, rawdb.NewMemoryDatabase(), nil, genesis.Hash())
+	)
+	simulate := func(parent *types.Header) *types.Header {
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).Add(parent.Number, common.Big1),
+			Time:       parent.Time + params.ParliaTestChainConfig.Parlia.Period,
+		}
+		require.NoError(t, engine.PrepareSimulated(chain, header))
+		chain.headers = append(chain.headers, header)
+		return header
+	}
+	// Blocks on top of simulated ones are sealed by the next in-turn validator
+	first := simulate(genesis)
+	second := simulate(first)
+	require.Equal(t, validators[1], first.Coinbase)
+	require.Equal(t, validators[2], second.Coinbase)
+	require.Equal(t, diffInTurn, second.Difficulty)
+
+	finalize := func(header *types.Header) []*types.Transaction {
+		statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		require.NoError(t, err)
+		statedb.AddBalance(consensus.SystemAddress, uint256.NewInt(1000))
+
+		var (
+			txs      []*types.Transaction
+			receipts []*types.Receipt
+			usedGas  uint64
+		)
+		require.NoError(t, engine.FinalizeSimulated(chain, header, statedb, &txs, &receipts, &usedGas))
+		require.Len(t, receipts, len(txs))
+		require.True(t, statedb.GetBalance(consensus.SystemAddress).IsZero())
+		require.Equal(t, uint64(200), statedb.GetBalance(common.HexToAddress(systemcontract.SystemRewardContract)).Uint64())
+		require.Equal(t, uint64(800), statedb.GetBalance(common.HexToAddress(systemcontract.ValidatorContract)).Uint64())
+		return txs
+	}
+	// The fees collected by the system address are distributed in turn
+	txs := finalize(second)
+	require.Len(t, txs, 2)
+	require.Equal(t, common.HexToAddress(systemcontract.SystemRewardContract), *txs[0].To())
+	require.Equal(t, big.NewInt(200), txs[0].Value())
+	require.Equal(t, common.HexToAddress(systemcontract.ValidatorContract), *txs[1].To())
+	require.Equal(t, big.NewInt(800), txs[1].Value())
+
+	// The in-turn validator is slashed if the block is sealed out of turn
+	outOfTurn := types.CopyHeader(second)
+	outOfTurn.Coinbase, outOfTurn.Difficulty = validators[0], diffNoTurn
+	txs = finalize(outOfTurn)
+	require.Len(t, txs, 3)
+	require.Equal(t, common.HexToAddress(systemcontract.SlashContract), *txs[0].To())
+}
@@ -57,6 +57,7 @@ type Snapshot struct {
 	Attestation      *types.VoteData                   `json:"attestation:omitempty"` 	// Attestation for fast finality, but `Source` used as `Finalized`
 	isSnake8Fork     bool                              `json:"is_snake8_fork"`          // Flag indicating whether Snake8 fork activated
 	FrequencyRLP    []byte                             `json:"frequency_rlp,omitempty"` // RLP encoded frequency data for validator selection
+	ChainConfig     *ChainConfigParams                 `json:"chain_config,omitempty"`  // ChainConfig parameters carried by the last epoch block
 }
 
 type ValidatorInfo struct {
@@ -165,6 +166,7 @@ func (s *Snapshot) copy() *Snapshot {
 		Recents:          make(map[uint64]common.Address),
 		RecentForkHashes: make(map[uint64]string),
 		isSnake8Fork:       s.isSnake8Fork,
+		ChainConfig:      s.ChainConfig,
 	}
 
 	for v := range s.Validators {
@@ -357,6 +359,15 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 				log.Debug("validator set switch", "turnLength", *turnLength)
 			}
 
+			// get the chain config parameters from headers, if carried
+			chainConfigParams, err := parseChainConfigParams(checkpointHeader, chainConfig, s.config)
+			if err != nil {
+				return nil, err
+			}
+			if chainConfigParams != nil {
+				snap.ChainConfig = chainConfigParams
+			}
+
 			// get validators from headers and use that for new validator set
 			newValArr, voteAddrs, err := parseValidators(checkpointHeader, chainConfig, s.config)
 			if err != nil {
@@ -502,7 +513,7 @@ func parseValidatorFrequencies(header *types.Header, chainConfig *params.ChainCo
 
     // Start parsing after vanity
     start := extraVanity
-    end := len(header.Extra) - extraSeal
+    end := len(header.Extra) - extraSeal - chainConfigParamsSize(header, chainConfig, parliaConfig)
 
     // Skip validator data (only on epoch blocks)
     if !chainConfig.IsLuban(header.Number) {
@@ -0,0 +1,114 @@
+package parlia
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ValidatorStatus is the state of a validator as recorded by the staking
+// contract.
+type ValidatorStatus struct {
+	Validator      common.Address `json:"validator"`
+	Owner          common.Address `json:"owner"`
+	Status         hexutil.Uint64 `json:"status"`         // 0: not found, 1: active, 2: pending, 3: jailed
+	TotalDelegated *hexutil.Big   `json:"totalDelegated"` // Stake delegated to the validator, including its own
+	SlashesCount   hexutil.Uint64 `json:"slashesCount"`
+	ChangedAt      hexutil.Uint64 `json:"changedAt"`    // Epoch of the last status change
+	JailedBefore   hexutil.Uint64 `json:"jailedBefore"` // Epoch the validator can be released from jail at
+	ClaimedAt      hexutil.Uint64 `json:"claimedAt"`    // Epoch of the last claim of the owner's fees
+	CommissionRate hexutil.Uint64 `json:"commissionRate"`
+	TotalRewards   *hexutil.Big   `json:"totalRewards"`
+}
+
+// StakingAPI is a user facing RPC API exposing the validators registered in the
+// staking contract, decoded so that no contract ABI is needed to read them.
+type StakingAPI struct {
+	api *API
+}
+
+// GetValidatorStatus retrieves the status of a validator at the given epoch from
+// the latest state, or its current status if no epoch is specified.
+func (s *StakingAPI) GetValidatorStatus(ctx context.Context, validator common.Address, epoch *hexutil.Uint64) (*ValidatorStatus, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	if epoch == nil {
+		return s.api.parlia.getValidatorStatus(opts, validator, nil)
+	}
+	at := uint64(*epoch)
+	return s.api.parlia.getValidatorStatus(opts, validator, &at)
+}
+
+// ListValidators retrieves the status of every validator registered in the
+// staking contract at the specified block.
+func (s *StakingAPI) ListValidators(ctx context.Context, number *rpc.BlockNumber) ([]*ValidatorStatus, error) {
+	header := s.api.getHeader(number)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return s.api.parlia.listValidators(&bind.CallOpts{BlockHash: header.Hash(), Context: ctx})
+}
+
+// stakingValidatorStatus is the result of the validator status getters of the
+// staking contract.
+type stakingValidatorStatus = struct {
+	OwnerAddress   common.Address
+	Status         uint8
+	TotalDelegated *big.Int
+	SlashesCount   uint32
+	ChangedAt      uint64
+	JailedBefore   uint64
+	ClaimedAt      uint64
+	CommissionRate uint16
+	TotalRewards   *big.Int
+}
+
+// getValidatorStatus reads the status of a validator at the given epoch, or its
+// current one if epoch is nil.
+func (p *Parlia) getValidatorStatus(opts *bind.CallOpts, validator common.Address, epoch *uint64) (*ValidatorStatus, error) {
+	var (
+		raw stakingValidatorStatus
+		err error
+	)
+	if epoch == nil {
+		raw, err = p.contracts.stakingCaller.GetValidatorStatus(opts, validator)
+	} else {
+		raw, err = p.contracts.stakingCaller.GetValidatorStatusAtEpoch(opts, validator, *epoch)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorStatus{
+		Validator:      validator,
+		Owner:          raw.OwnerAddress,
+		Status:         hexutil.Uint64(raw.Status),
+		TotalDelegated: (*hexutil.Big)(raw.TotalDelegated),
+		SlashesCount:   hexutil.Uint64(raw.SlashesCount),
+		ChangedAt:      hexutil.Uint64(raw.ChangedAt),
+		JailedBefore:   hexutil.Uint64(raw.JailedBefore),
+		ClaimedAt:      hexutil.Uint64(raw.ClaimedAt),
+		CommissionRate: hexutil.Uint64(raw.CommissionRate),
+		TotalRewards:   (*hexutil.Big)(raw.TotalRewards),
+	}, nil
+}
+
+// listValidators reads the status of all validators registered in the staking
+// contract.
+func (p *Parlia) listValidators(opts *bind.CallOpts) ([]*ValidatorStatus, error) {
+	validators, err := p.contracts.stakingCaller.GetValidators(opts)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*ValidatorStatus, 0, len(validators))
+	for _, validator := range validators {
+		status, err := p.getValidatorStatus(opts, validator, nil)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
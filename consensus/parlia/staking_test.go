@@ -0,0 +1,103 @@
+package parlia
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/contracts/bas"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// stakingBackend answers the staking contract getters from a fixed validator
+// set, recording the epoch and block hash the statuses are requested at.
+type stakingBackend struct {
+	t          *testing.T
+	validators []common.Address
+	epochs     []uint64
+	hashes     []common.Hash
+}
+
+func (b *stakingBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *stakingBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return b.call(call)
+}
+
+func (b *stakingBackend) CodeAtHash(ctx context.Context, contract common.Address, blockHash common.Hash) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *stakingBackend) CallContractAtHash(ctx context.Context, call ethereum.CallMsg, blockHash common.Hash) ([]byte, error) {
+	b.hashes = append(b.hashes, blockHash)
+	return b.call(call)
+}
+
+func (b *stakingBackend) call(call ethereum.CallMsg) ([]byte, error) {
+	staking, err := bas.StakingMetaData.GetAbi()
+	require.NoError(b.t, err)
+	require.Equal(b.t, common.HexToAddress(systemcontract.ValidatorContract), *call.To)
+
+	method, err := staking.MethodById(call.Data[:4])
+	require.NoError(b.t, err)
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(b.t, err)
+
+	switch method.Name {
+	case "getValidators":
+		return method.Outputs.Pack(b.validators)
+	case "getValidatorStatusAtEpoch":
+		b.epochs = append(b.epochs, args[1].(uint64))
+		fallthrough
+	case "getValidatorStatus":
+		validator := args[0].(common.Address)
+		return method.Outputs.Pack(validator, uint8(1), big.NewInt(int64(validator[19])*1000), uint32(2), uint64(3), uint64(4), uint64(5), uint16(500), big.NewInt(6))
+	}
+	b.t.Fatalf("unexpected call to %s", method.Name)
+	return nil, nil
+}
+
+func TestValidatorStatus(t *testing.T) {
+	var (
+		engine  = New(params.ParliaTestChainConfig, rawdb.NewMemoryDatabase(), nil, common.Hash{})
+		backend = &stakingBackend{t: t, validators: []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}}
+		err     error
+	)
+	engine.contracts.stakingCaller, err = bas.NewStakingCaller(common.HexToAddress(systemcontract.ValidatorContract), backend)
+	require.NoError(t, err)
+
+	// Statuses are decoded from the contract getters
+	epoch := uint64(7)
+	status, err := engine.getValidatorStatus(&bind.CallOpts{}, backend.validators[1], &epoch)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7}, backend.epochs)
+	require.Equal(t, backend.validators[1], status.Validator)
+	require.Equal(t, backend.validators[1], status.Owner)
+	require.EqualValues(t, 1, status.Status)
+	require.Equal(t, big.NewInt(2000), status.TotalDelegated.ToInt())
+	require.EqualValues(t, 2, status.SlashesCount)
+	require.EqualValues(t, 4, status.JailedBefore)
+	require.EqualValues(t, 500, status.CommissionRate)
+	require.Equal(t, big.NewInt(6), status.TotalRewards.ToInt())
+
+	// Listing reads every validator from the state of the same block
+	hash := common.HexToHash("0xabcd")
+	statuses, err := engine.listValidators(&bind.CallOpts{BlockHash: hash})
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	for i, status := range statuses {
+		require.Equal(t, backend.validators[i], status.Validator)
+		require.Equal(t, big.NewInt(int64(i+1)*1000), status.TotalDelegated.ToInt())
+	}
+	require.Equal(t, []common.Hash{hash, hash, hash}, backend.hashes)
+	require.Equal(t, []uint64{7}, backend.epochs)
+}
@@ -0,0 +1,49 @@
+package parlia
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/contracts/bas"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// isRewardDistribution reports whether a system call to the validator contract
+// pays out block rewards, as opposed to initialising or updating the validator set.
+func (p *Parlia) isRewardDistribution(data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	selector := data[:4]
+	if bytes.Equal(selector, p.validatorSetABI.Methods["distributeFinalityReward"].ID) {
+		return true
+	}
+	staking, err := bas.StakingMetaData.GetAbi()
+	return err == nil && bytes.Equal(selector, staking.Methods["deposit"].ID)
+}
+
+// SystemTransactionKind implements consensus.PoSA, classifying the given
+// transaction of the block with the given header. Transactions that are not
+// system transactions are reported as consensus.SystemTxNone.
+func (p *Parlia) SystemTransactionKind(tx *types.Transaction, header *types.Header) (consensus.SystemTxKind, error) {
+	isSystem, err := p.IsSystemTransaction(tx, header)
+	if err != nil || !isSystem {
+		return consensus.SystemTxNone, err
+	}
+	to := *tx.To()
+	switch {
+	case to == getPepper8RecipientAddress():
+		return consensus.SystemTxPepper8Mint, nil
+	case p.IsTokenomicsDeposit(tx.To(), tx.Data()):
+		return consensus.SystemTxTokenomicsDeposit, nil
+	case to == common.HexToAddress(systemcontract.SlashContract):
+		return consensus.SystemTxSlash, nil
+	case to == common.HexToAddress(systemcontract.SystemRewardContract):
+		return consensus.SystemTxRewardDistribution, nil
+	case to == common.HexToAddress(systemcontract.ValidatorContract) && p.isRewardDistribution(tx.Data()):
+		return consensus.SystemTxRewardDistribution, nil
+	}
+	return consensus.SystemTxOther, nil
+}
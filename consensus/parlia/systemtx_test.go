@@ -0,0 +1,63 @@
+package parlia
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/contracts/bas"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestSystemTransactionKind(t *testing.T) {
+	var (
+		engine    = New(params.ParliaTestChainConfig, rawdb.NewMemoryDatabase(), nil, common.Hash{})
+		key, _    = crypto.GenerateKey()
+		coinbase  = crypto.PubkeyToAddress(key.PublicKey)
+		header    = &types.Header{Number: big.NewInt(1), Coinbase: coinbase}
+		validator = common.HexToAddress("0x1234")
+	)
+	deposit, err := engine.contracts.stakingTransactor.Deposit(bas.SystemTxOpts(coinbase, common.Big1), validator)
+	require.NoError(t, err)
+	tokenomics, err := engine.contracts.tokenomicsTransactor.Deposit(bas.SystemTxOpts(coinbase, common.Big1), validator, common.Big1, common.Big1)
+	require.NoError(t, err)
+	initData, err := engine.contracts.stakingTransactor.Init(bas.SystemTxOpts(coinbase, common.Big0))
+	require.NoError(t, err)
+
+	sign := func(to common.Address, data []byte, gasPrice *big.Int) *types.Transaction {
+		tx, err := types.SignTx(types.NewTransaction(0, to, common.Big0, 100000, gasPrice, data), engine.signer, key)
+		require.NoError(t, err)
+		return tx
+	}
+	tests := []struct {
+		tx   *types.Transaction
+		kind consensus.SystemTxKind
+	}{
+		{sign(common.HexToAddress(systemcontract.ValidatorContract), deposit.Data(), common.Big0), consensus.SystemTxRewardDistribution},
+		{sign(common.HexToAddress(systemcontract.SystemRewardContract), nil, common.Big0), consensus.SystemTxRewardDistribution},
+		{sign(systemcontract.TokenomicsContractAddress, tokenomics.Data(), common.Big0), consensus.SystemTxTokenomicsDeposit},
+		{sign(common.HexToAddress(systemcontract.SlashContract), common.FromHex("0xc96be4cb"), common.Big0), consensus.SystemTxSlash},
+		{sign(getPepper8RecipientAddress(), nil, common.Big0), consensus.SystemTxPepper8Mint},
+		{sign(common.HexToAddress(systemcontract.ValidatorContract), initData.Data(), common.Big0), consensus.SystemTxOther},
+		// Paying for gas or calling a regular contract is never a system transaction
+		{sign(common.HexToAddress(systemcontract.ValidatorContract), deposit.Data(), common.Big1), consensus.SystemTxNone},
+		{sign(validator, nil, common.Big0), consensus.SystemTxNone},
+	}
+	for i, tt := range tests {
+		kind, err := engine.SystemTransactionKind(tt.tx, header)
+		require.NoError(t, err)
+		require.Equal(t, tt.kind, kind, "test %d", i)
+	}
+	// Transactions not sent by the block producer are regular transactions
+	other := &types.Header{Number: big.NewInt(1), Coinbase: validator}
+	kind, err := engine.SystemTransactionKind(tests[0].tx, other)
+	require.NoError(t, err)
+	require.Equal(t, consensus.SystemTxNone, kind)
+}
@@ -0,0 +1,12 @@
+// Package bas contains Go bindings for the BAS system contracts deployed in the
+// Chiliz genesis. The bindings are generated from the ABI files in the abi
+// directory, so any change to a contract interface has to be reflected there
+// and surfaces as a compile error in the callers.
+package bas
+
+//go:generate abigen --abi abi/Staking.json --pkg bas --type Staking --out staking.go
+//go:generate abigen --abi abi/StakingPool.json --pkg bas --type StakingPool --out staking_pool.go
+//go:generate abigen --abi abi/ChainConfig.json --pkg bas --type ChainConfig --out chain_config.go
+//go:generate abigen --abi abi/DeployerProxy.json --pkg bas --type DeployerProxy --out deployer_proxy.go
+//go:generate abigen --abi abi/RuntimeUpgrade.json --pkg bas --type RuntimeUpgrade --out runtime_upgrade.go
+//go:generate abigen --abi abi/Tokenomics.json --pkg bas --type Tokenomics --out tokenomics.go
@@ -0,0 +1,1677 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bas
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// DeployerProxyMetaData contains all meta data concerning the DeployerProxy contract.
+var DeployerProxyMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\",\"indexed\":false}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true}],\"name\":\"DeployerAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true}],\"name\":\"DeployerRemoved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true}],\"name\":\"DeployerBanned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true}],\"name\":\"DeployerUnbanned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\",\"indexed\":true}],\"name\":\"ContractDisabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\",\"indexed\":true}],\"name\":\"ContractEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"impl\",\"type\":\"address\",\"indexed\":false}],\"name\":\"ContractDeployed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isInitialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"deployers\",\"type\":\"address[]\"}],\"name\":\"ctor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"isDeployer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"isBanned\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"addDeployer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"removeDeployer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"banDeployer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"unbanDeployer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"}],\"name\":\"getContractState\",\"outputs\":[{\"internalType\":\"enumDeployerProxy.ContractState\",\"name\":\"state\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"impl\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"deployer\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"}],\"name\":\"disableContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"}],\"name\":\"enableContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"impl\",\"type\":\"address\"}],\"name\":\"registerDeployedContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"impl\",\"type\":\"address\"}],\"name\":\"checkContractActive\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// DeployerProxyABI is the input ABI used to generate the binding from.
+// Deprecated: Use DeployerProxyMetaData.ABI instead.
+var DeployerProxyABI = DeployerProxyMetaData.ABI
+
+// DeployerProxy is an auto generated Go binding around an Ethereum contract.
+type DeployerProxy struct {
+	DeployerProxyCaller     // Read-only binding to the contract
+	DeployerProxyTransactor // Write-only binding to the contract
+	DeployerProxyFilterer   // Log filterer for contract events
+}
+
+// DeployerProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DeployerProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeployerProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DeployerProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeployerProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DeployerProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeployerProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DeployerProxySession struct {
+	Contract     *DeployerProxy    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DeployerProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DeployerProxyCallerSession struct {
+	Contract *DeployerProxyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// DeployerProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DeployerProxyTransactorSession struct {
+	Contract     *DeployerProxyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// DeployerProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DeployerProxyRaw struct {
+	Contract *DeployerProxy // Generic contract binding to access the raw methods on
+}
+
+// DeployerProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DeployerProxyCallerRaw struct {
+	Contract *DeployerProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DeployerProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DeployerProxyTransactorRaw struct {
+	Contract *DeployerProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDeployerProxy creates a new instance of DeployerProxy, bound to a specific deployed contract.
+func NewDeployerProxy(address common.Address, backend bind.ContractBackend) (*DeployerProxy, error) {
+	contract, err := bindDeployerProxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxy{DeployerProxyCaller: DeployerProxyCaller{contract: contract}, DeployerProxyTransactor: DeployerProxyTransactor{contract: contract}, DeployerProxyFilterer: DeployerProxyFilterer{contract: contract}}, nil
+}
+
+// NewDeployerProxyCaller creates a new read-only instance of DeployerProxy, bound to a specific deployed contract.
+func NewDeployerProxyCaller(address common.Address, caller bind.ContractCaller) (*DeployerProxyCaller, error) {
+	contract, err := bindDeployerProxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyCaller{contract: contract}, nil
+}
+
+// NewDeployerProxyTransactor creates a new write-only instance of DeployerProxy, bound to a specific deployed contract.
+func NewDeployerProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*DeployerProxyTransactor, error) {
+	contract, err := bindDeployerProxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyTransactor{contract: contract}, nil
+}
+
+// NewDeployerProxyFilterer creates a new log filterer instance of DeployerProxy, bound to a specific deployed contract.
+func NewDeployerProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*DeployerProxyFilterer, error) {
+	contract, err := bindDeployerProxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyFilterer{contract: contract}, nil
+}
+
+// bindDeployerProxy binds a generic wrapper to an already deployed contract.
+func bindDeployerProxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := DeployerProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DeployerProxy *DeployerProxyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DeployerProxy.Contract.DeployerProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DeployerProxy *DeployerProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.DeployerProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DeployerProxy *DeployerProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.DeployerProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DeployerProxy *DeployerProxyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DeployerProxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DeployerProxy *DeployerProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DeployerProxy *DeployerProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetContractState is a free data retrieval call binding the contract method 0xaad7e363.
+//
+// Solidity: function getContractState(address contractAddress) view returns(uint8 state, address impl, address deployer)
+func (_DeployerProxy *DeployerProxyCaller) GetContractState(opts *bind.CallOpts, contractAddress common.Address) (struct {
+	State    uint8
+	Impl     common.Address
+	Deployer common.Address
+}, error) {
+	var out []interface{}
+	err := _DeployerProxy.contract.Call(opts, &out, "getContractState", contractAddress)
+
+	outstruct := new(struct {
+		State    uint8
+		Impl     common.Address
+		Deployer common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.State = *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	outstruct.Impl = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Deployer = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// GetContractState is a free data retrieval call binding the contract method 0xaad7e363.
+//
+// Solidity: function getContractState(address contractAddress) view returns(uint8 state, address impl, address deployer)
+func (_DeployerProxy *DeployerProxySession) GetContractState(contractAddress common.Address) (struct {
+	State    uint8
+	Impl     common.Address
+	Deployer common.Address
+}, error) {
+	return _DeployerProxy.Contract.GetContractState(&_DeployerProxy.CallOpts, contractAddress)
+}
+
+// GetContractState is a free data retrieval call binding the contract method 0xaad7e363.
+//
+// Solidity: function getContractState(address contractAddress) view returns(uint8 state, address impl, address deployer)
+func (_DeployerProxy *DeployerProxyCallerSession) GetContractState(contractAddress common.Address) (struct {
+	State    uint8
+	Impl     common.Address
+	Deployer common.Address
+}, error) {
+	return _DeployerProxy.Contract.GetContractState(&_DeployerProxy.CallOpts, contractAddress)
+}
+
+// IsBanned is a free data retrieval call binding the contract method 0x97f735d5.
+//
+// Solidity: function isBanned(address account) view returns(bool)
+func (_DeployerProxy *DeployerProxyCaller) IsBanned(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _DeployerProxy.contract.Call(opts, &out, "isBanned", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsBanned is a free data retrieval call binding the contract method 0x97f735d5.
+//
+// Solidity: function isBanned(address account) view returns(bool)
+func (_DeployerProxy *DeployerProxySession) IsBanned(account common.Address) (bool, error) {
+	return _DeployerProxy.Contract.IsBanned(&_DeployerProxy.CallOpts, account)
+}
+
+// IsBanned is a free data retrieval call binding the contract method 0x97f735d5.
+//
+// Solidity: function isBanned(address account) view returns(bool)
+func (_DeployerProxy *DeployerProxyCallerSession) IsBanned(account common.Address) (bool, error) {
+	return _DeployerProxy.Contract.IsBanned(&_DeployerProxy.CallOpts, account)
+}
+
+// IsDeployer is a free data retrieval call binding the contract method 0x50c358a4.
+//
+// Solidity: function isDeployer(address account) view returns(bool)
+func (_DeployerProxy *DeployerProxyCaller) IsDeployer(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _DeployerProxy.contract.Call(opts, &out, "isDeployer", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsDeployer is a free data retrieval call binding the contract method 0x50c358a4.
+//
+// Solidity: function isDeployer(address account) view returns(bool)
+func (_DeployerProxy *DeployerProxySession) IsDeployer(account common.Address) (bool, error) {
+	return _DeployerProxy.Contract.IsDeployer(&_DeployerProxy.CallOpts, account)
+}
+
+// IsDeployer is a free data retrieval call binding the contract method 0x50c358a4.
+//
+// Solidity: function isDeployer(address account) view returns(bool)
+func (_DeployerProxy *DeployerProxyCallerSession) IsDeployer(account common.Address) (bool, error) {
+	return _DeployerProxy.Contract.IsDeployer(&_DeployerProxy.CallOpts, account)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_DeployerProxy *DeployerProxyCaller) IsInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _DeployerProxy.contract.Call(opts, &out, "isInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_DeployerProxy *DeployerProxySession) IsInitialized() (bool, error) {
+	return _DeployerProxy.Contract.IsInitialized(&_DeployerProxy.CallOpts)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_DeployerProxy *DeployerProxyCallerSession) IsInitialized() (bool, error) {
+	return _DeployerProxy.Contract.IsInitialized(&_DeployerProxy.CallOpts)
+}
+
+// AddDeployer is a paid mutator transaction binding the contract method 0x880f4039.
+//
+// Solidity: function addDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactor) AddDeployer(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "addDeployer", account)
+}
+
+// AddDeployer is a paid mutator transaction binding the contract method 0x880f4039.
+//
+// Solidity: function addDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxySession) AddDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.AddDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// AddDeployer is a paid mutator transaction binding the contract method 0x880f4039.
+//
+// Solidity: function addDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) AddDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.AddDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// BanDeployer is a paid mutator transaction binding the contract method 0x77fdcede.
+//
+// Solidity: function banDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactor) BanDeployer(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "banDeployer", account)
+}
+
+// BanDeployer is a paid mutator transaction binding the contract method 0x77fdcede.
+//
+// Solidity: function banDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxySession) BanDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.BanDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// BanDeployer is a paid mutator transaction binding the contract method 0x77fdcede.
+//
+// Solidity: function banDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) BanDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.BanDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// CheckContractActive is a paid mutator transaction binding the contract method 0x39e7c536.
+//
+// Solidity: function checkContractActive(address impl) returns()
+func (_DeployerProxy *DeployerProxyTransactor) CheckContractActive(opts *bind.TransactOpts, impl common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "checkContractActive", impl)
+}
+
+// CheckContractActive is a paid mutator transaction binding the contract method 0x39e7c536.
+//
+// Solidity: function checkContractActive(address impl) returns()
+func (_DeployerProxy *DeployerProxySession) CheckContractActive(impl common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.CheckContractActive(&_DeployerProxy.TransactOpts, impl)
+}
+
+// CheckContractActive is a paid mutator transaction binding the contract method 0x39e7c536.
+//
+// Solidity: function checkContractActive(address impl) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) CheckContractActive(impl common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.CheckContractActive(&_DeployerProxy.TransactOpts, impl)
+}
+
+// Ctor is a paid mutator transaction binding the contract method 0x6ac4d975.
+//
+// Solidity: function ctor(address[] deployers) returns()
+func (_DeployerProxy *DeployerProxyTransactor) Ctor(opts *bind.TransactOpts, deployers []common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "ctor", deployers)
+}
+
+// Ctor is a paid mutator transaction binding the contract method 0x6ac4d975.
+//
+// Solidity: function ctor(address[] deployers) returns()
+func (_DeployerProxy *DeployerProxySession) Ctor(deployers []common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.Ctor(&_DeployerProxy.TransactOpts, deployers)
+}
+
+// Ctor is a paid mutator transaction binding the contract method 0x6ac4d975.
+//
+// Solidity: function ctor(address[] deployers) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) Ctor(deployers []common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.Ctor(&_DeployerProxy.TransactOpts, deployers)
+}
+
+// DisableContract is a paid mutator transaction binding the contract method 0xa154ce82.
+//
+// Solidity: function disableContract(address contractAddress) returns()
+func (_DeployerProxy *DeployerProxyTransactor) DisableContract(opts *bind.TransactOpts, contractAddress common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "disableContract", contractAddress)
+}
+
+// DisableContract is a paid mutator transaction binding the contract method 0xa154ce82.
+//
+// Solidity: function disableContract(address contractAddress) returns()
+func (_DeployerProxy *DeployerProxySession) DisableContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.DisableContract(&_DeployerProxy.TransactOpts, contractAddress)
+}
+
+// DisableContract is a paid mutator transaction binding the contract method 0xa154ce82.
+//
+// Solidity: function disableContract(address contractAddress) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) DisableContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.DisableContract(&_DeployerProxy.TransactOpts, contractAddress)
+}
+
+// EnableContract is a paid mutator transaction binding the contract method 0x47d23419.
+//
+// Solidity: function enableContract(address contractAddress) returns()
+func (_DeployerProxy *DeployerProxyTransactor) EnableContract(opts *bind.TransactOpts, contractAddress common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "enableContract", contractAddress)
+}
+
+// EnableContract is a paid mutator transaction binding the contract method 0x47d23419.
+//
+// Solidity: function enableContract(address contractAddress) returns()
+func (_DeployerProxy *DeployerProxySession) EnableContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.EnableContract(&_DeployerProxy.TransactOpts, contractAddress)
+}
+
+// EnableContract is a paid mutator transaction binding the contract method 0x47d23419.
+//
+// Solidity: function enableContract(address contractAddress) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) EnableContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.EnableContract(&_DeployerProxy.TransactOpts, contractAddress)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_DeployerProxy *DeployerProxyTransactor) Init(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "init")
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_DeployerProxy *DeployerProxySession) Init() (*types.Transaction, error) {
+	return _DeployerProxy.Contract.Init(&_DeployerProxy.TransactOpts)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) Init() (*types.Transaction, error) {
+	return _DeployerProxy.Contract.Init(&_DeployerProxy.TransactOpts)
+}
+
+// RegisterDeployedContract is a paid mutator transaction binding the contract method 0x0e17790f.
+//
+// Solidity: function registerDeployedContract(address account, address impl) returns()
+func (_DeployerProxy *DeployerProxyTransactor) RegisterDeployedContract(opts *bind.TransactOpts, account common.Address, impl common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "registerDeployedContract", account, impl)
+}
+
+// RegisterDeployedContract is a paid mutator transaction binding the contract method 0x0e17790f.
+//
+// Solidity: function registerDeployedContract(address account, address impl) returns()
+func (_DeployerProxy *DeployerProxySession) RegisterDeployedContract(account common.Address, impl common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.RegisterDeployedContract(&_DeployerProxy.TransactOpts, account, impl)
+}
+
+// RegisterDeployedContract is a paid mutator transaction binding the contract method 0x0e17790f.
+//
+// Solidity: function registerDeployedContract(address account, address impl) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) RegisterDeployedContract(account common.Address, impl common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.RegisterDeployedContract(&_DeployerProxy.TransactOpts, account, impl)
+}
+
+// RemoveDeployer is a paid mutator transaction binding the contract method 0xf315df86.
+//
+// Solidity: function removeDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactor) RemoveDeployer(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "removeDeployer", account)
+}
+
+// RemoveDeployer is a paid mutator transaction binding the contract method 0xf315df86.
+//
+// Solidity: function removeDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxySession) RemoveDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.RemoveDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// RemoveDeployer is a paid mutator transaction binding the contract method 0xf315df86.
+//
+// Solidity: function removeDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) RemoveDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.RemoveDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// UnbanDeployer is a paid mutator transaction binding the contract method 0x86497f41.
+//
+// Solidity: function unbanDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactor) UnbanDeployer(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.contract.Transact(opts, "unbanDeployer", account)
+}
+
+// UnbanDeployer is a paid mutator transaction binding the contract method 0x86497f41.
+//
+// Solidity: function unbanDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxySession) UnbanDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.UnbanDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// UnbanDeployer is a paid mutator transaction binding the contract method 0x86497f41.
+//
+// Solidity: function unbanDeployer(address account) returns()
+func (_DeployerProxy *DeployerProxyTransactorSession) UnbanDeployer(account common.Address) (*types.Transaction, error) {
+	return _DeployerProxy.Contract.UnbanDeployer(&_DeployerProxy.TransactOpts, account)
+}
+
+// DeployerProxyContractDeployedIterator is returned from FilterContractDeployed and is used to iterate over the raw logs and unpacked data for ContractDeployed events raised by the DeployerProxy contract.
+type DeployerProxyContractDeployedIterator struct {
+	Event *DeployerProxyContractDeployed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyContractDeployedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyContractDeployed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyContractDeployed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyContractDeployedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyContractDeployedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyContractDeployed represents a ContractDeployed event raised by the DeployerProxy contract.
+type DeployerProxyContractDeployed struct {
+	Account common.Address
+	Impl    common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractDeployed is a free log retrieval operation binding the contract event 0x33c981baba081f8fd2c52ac6ad1ea95b6814b4376640f55689051f6584729688.
+//
+// Solidity: event ContractDeployed(address indexed account, address impl)
+func (_DeployerProxy *DeployerProxyFilterer) FilterContractDeployed(opts *bind.FilterOpts, account []common.Address) (*DeployerProxyContractDeployedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "ContractDeployed", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyContractDeployedIterator{contract: _DeployerProxy.contract, event: "ContractDeployed", logs: logs, sub: sub}, nil
+}
+
+// WatchContractDeployed is a free log subscription operation binding the contract event 0x33c981baba081f8fd2c52ac6ad1ea95b6814b4376640f55689051f6584729688.
+//
+// Solidity: event ContractDeployed(address indexed account, address impl)
+func (_DeployerProxy *DeployerProxyFilterer) WatchContractDeployed(opts *bind.WatchOpts, sink chan<- *DeployerProxyContractDeployed, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "ContractDeployed", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyContractDeployed)
+				if err := _DeployerProxy.contract.UnpackLog(event, "ContractDeployed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractDeployed is a log parse operation binding the contract event 0x33c981baba081f8fd2c52ac6ad1ea95b6814b4376640f55689051f6584729688.
+//
+// Solidity: event ContractDeployed(address indexed account, address impl)
+func (_DeployerProxy *DeployerProxyFilterer) ParseContractDeployed(log types.Log) (*DeployerProxyContractDeployed, error) {
+	event := new(DeployerProxyContractDeployed)
+	if err := _DeployerProxy.contract.UnpackLog(event, "ContractDeployed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyContractDisabledIterator is returned from FilterContractDisabled and is used to iterate over the raw logs and unpacked data for ContractDisabled events raised by the DeployerProxy contract.
+type DeployerProxyContractDisabledIterator struct {
+	Event *DeployerProxyContractDisabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyContractDisabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyContractDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyContractDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyContractDisabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyContractDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyContractDisabled represents a ContractDisabled event raised by the DeployerProxy contract.
+type DeployerProxyContractDisabled struct {
+	ContractAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractDisabled is a free log retrieval operation binding the contract event 0x793bc6423dd0257d64f9d47db72fd4b2162f4541aa41740ba1c5fb5e2cd41bcd.
+//
+// Solidity: event ContractDisabled(address indexed contractAddress)
+func (_DeployerProxy *DeployerProxyFilterer) FilterContractDisabled(opts *bind.FilterOpts, contractAddress []common.Address) (*DeployerProxyContractDisabledIterator, error) {
+
+	var contractAddressRule []interface{}
+	for _, contractAddressItem := range contractAddress {
+		contractAddressRule = append(contractAddressRule, contractAddressItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "ContractDisabled", contractAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyContractDisabledIterator{contract: _DeployerProxy.contract, event: "ContractDisabled", logs: logs, sub: sub}, nil
+}
+
+// WatchContractDisabled is a free log subscription operation binding the contract event 0x793bc6423dd0257d64f9d47db72fd4b2162f4541aa41740ba1c5fb5e2cd41bcd.
+//
+// Solidity: event ContractDisabled(address indexed contractAddress)
+func (_DeployerProxy *DeployerProxyFilterer) WatchContractDisabled(opts *bind.WatchOpts, sink chan<- *DeployerProxyContractDisabled, contractAddress []common.Address) (event.Subscription, error) {
+
+	var contractAddressRule []interface{}
+	for _, contractAddressItem := range contractAddress {
+		contractAddressRule = append(contractAddressRule, contractAddressItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "ContractDisabled", contractAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyContractDisabled)
+				if err := _DeployerProxy.contract.UnpackLog(event, "ContractDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractDisabled is a log parse operation binding the contract event 0x793bc6423dd0257d64f9d47db72fd4b2162f4541aa41740ba1c5fb5e2cd41bcd.
+//
+// Solidity: event ContractDisabled(address indexed contractAddress)
+func (_DeployerProxy *DeployerProxyFilterer) ParseContractDisabled(log types.Log) (*DeployerProxyContractDisabled, error) {
+	event := new(DeployerProxyContractDisabled)
+	if err := _DeployerProxy.contract.UnpackLog(event, "ContractDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyContractEnabledIterator is returned from FilterContractEnabled and is used to iterate over the raw logs and unpacked data for ContractEnabled events raised by the DeployerProxy contract.
+type DeployerProxyContractEnabledIterator struct {
+	Event *DeployerProxyContractEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyContractEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyContractEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyContractEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyContractEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyContractEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyContractEnabled represents a ContractEnabled event raised by the DeployerProxy contract.
+type DeployerProxyContractEnabled struct {
+	ContractAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractEnabled is a free log retrieval operation binding the contract event 0xae81c15a680e6640ba872ca44f364ad67c60a04089ad9fa100f7a3ce94da9f68.
+//
+// Solidity: event ContractEnabled(address indexed contractAddress)
+func (_DeployerProxy *DeployerProxyFilterer) FilterContractEnabled(opts *bind.FilterOpts, contractAddress []common.Address) (*DeployerProxyContractEnabledIterator, error) {
+
+	var contractAddressRule []interface{}
+	for _, contractAddressItem := range contractAddress {
+		contractAddressRule = append(contractAddressRule, contractAddressItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "ContractEnabled", contractAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyContractEnabledIterator{contract: _DeployerProxy.contract, event: "ContractEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchContractEnabled is a free log subscription operation binding the contract event 0xae81c15a680e6640ba872ca44f364ad67c60a04089ad9fa100f7a3ce94da9f68.
+//
+// Solidity: event ContractEnabled(address indexed contractAddress)
+func (_DeployerProxy *DeployerProxyFilterer) WatchContractEnabled(opts *bind.WatchOpts, sink chan<- *DeployerProxyContractEnabled, contractAddress []common.Address) (event.Subscription, error) {
+
+	var contractAddressRule []interface{}
+	for _, contractAddressItem := range contractAddress {
+		contractAddressRule = append(contractAddressRule, contractAddressItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "ContractEnabled", contractAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyContractEnabled)
+				if err := _DeployerProxy.contract.UnpackLog(event, "ContractEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractEnabled is a log parse operation binding the contract event 0xae81c15a680e6640ba872ca44f364ad67c60a04089ad9fa100f7a3ce94da9f68.
+//
+// Solidity: event ContractEnabled(address indexed contractAddress)
+func (_DeployerProxy *DeployerProxyFilterer) ParseContractEnabled(log types.Log) (*DeployerProxyContractEnabled, error) {
+	event := new(DeployerProxyContractEnabled)
+	if err := _DeployerProxy.contract.UnpackLog(event, "ContractEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyDeployerAddedIterator is returned from FilterDeployerAdded and is used to iterate over the raw logs and unpacked data for DeployerAdded events raised by the DeployerProxy contract.
+type DeployerProxyDeployerAddedIterator struct {
+	Event *DeployerProxyDeployerAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyDeployerAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyDeployerAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyDeployerAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyDeployerAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyDeployerAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyDeployerAdded represents a DeployerAdded event raised by the DeployerProxy contract.
+type DeployerProxyDeployerAdded struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeployerAdded is a free log retrieval operation binding the contract event 0x861a21548a3ee34d896ccac3668a9d65030aaf2cb7367a2ed13608014016a032.
+//
+// Solidity: event DeployerAdded(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) FilterDeployerAdded(opts *bind.FilterOpts, account []common.Address) (*DeployerProxyDeployerAddedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "DeployerAdded", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyDeployerAddedIterator{contract: _DeployerProxy.contract, event: "DeployerAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchDeployerAdded is a free log subscription operation binding the contract event 0x861a21548a3ee34d896ccac3668a9d65030aaf2cb7367a2ed13608014016a032.
+//
+// Solidity: event DeployerAdded(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) WatchDeployerAdded(opts *bind.WatchOpts, sink chan<- *DeployerProxyDeployerAdded, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "DeployerAdded", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyDeployerAdded)
+				if err := _DeployerProxy.contract.UnpackLog(event, "DeployerAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeployerAdded is a log parse operation binding the contract event 0x861a21548a3ee34d896ccac3668a9d65030aaf2cb7367a2ed13608014016a032.
+//
+// Solidity: event DeployerAdded(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) ParseDeployerAdded(log types.Log) (*DeployerProxyDeployerAdded, error) {
+	event := new(DeployerProxyDeployerAdded)
+	if err := _DeployerProxy.contract.UnpackLog(event, "DeployerAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyDeployerBannedIterator is returned from FilterDeployerBanned and is used to iterate over the raw logs and unpacked data for DeployerBanned events raised by the DeployerProxy contract.
+type DeployerProxyDeployerBannedIterator struct {
+	Event *DeployerProxyDeployerBanned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyDeployerBannedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyDeployerBanned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyDeployerBanned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyDeployerBannedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyDeployerBannedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyDeployerBanned represents a DeployerBanned event raised by the DeployerProxy contract.
+type DeployerProxyDeployerBanned struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeployerBanned is a free log retrieval operation binding the contract event 0x9cf4d5b60708c113f822fd9addfd8d6d6280c3f539f5edf3ab97b528dedfa80a.
+//
+// Solidity: event DeployerBanned(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) FilterDeployerBanned(opts *bind.FilterOpts, account []common.Address) (*DeployerProxyDeployerBannedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "DeployerBanned", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyDeployerBannedIterator{contract: _DeployerProxy.contract, event: "DeployerBanned", logs: logs, sub: sub}, nil
+}
+
+// WatchDeployerBanned is a free log subscription operation binding the contract event 0x9cf4d5b60708c113f822fd9addfd8d6d6280c3f539f5edf3ab97b528dedfa80a.
+//
+// Solidity: event DeployerBanned(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) WatchDeployerBanned(opts *bind.WatchOpts, sink chan<- *DeployerProxyDeployerBanned, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "DeployerBanned", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyDeployerBanned)
+				if err := _DeployerProxy.contract.UnpackLog(event, "DeployerBanned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeployerBanned is a log parse operation binding the contract event 0x9cf4d5b60708c113f822fd9addfd8d6d6280c3f539f5edf3ab97b528dedfa80a.
+//
+// Solidity: event DeployerBanned(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) ParseDeployerBanned(log types.Log) (*DeployerProxyDeployerBanned, error) {
+	event := new(DeployerProxyDeployerBanned)
+	if err := _DeployerProxy.contract.UnpackLog(event, "DeployerBanned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyDeployerRemovedIterator is returned from FilterDeployerRemoved and is used to iterate over the raw logs and unpacked data for DeployerRemoved events raised by the DeployerProxy contract.
+type DeployerProxyDeployerRemovedIterator struct {
+	Event *DeployerProxyDeployerRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyDeployerRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyDeployerRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyDeployerRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyDeployerRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyDeployerRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyDeployerRemoved represents a DeployerRemoved event raised by the DeployerProxy contract.
+type DeployerProxyDeployerRemoved struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeployerRemoved is a free log retrieval operation binding the contract event 0xfdb22628e87f888d060acc53d048a6a8400a5024f81f9dcb0606e723f238864a.
+//
+// Solidity: event DeployerRemoved(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) FilterDeployerRemoved(opts *bind.FilterOpts, account []common.Address) (*DeployerProxyDeployerRemovedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "DeployerRemoved", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyDeployerRemovedIterator{contract: _DeployerProxy.contract, event: "DeployerRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchDeployerRemoved is a free log subscription operation binding the contract event 0xfdb22628e87f888d060acc53d048a6a8400a5024f81f9dcb0606e723f238864a.
+//
+// Solidity: event DeployerRemoved(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) WatchDeployerRemoved(opts *bind.WatchOpts, sink chan<- *DeployerProxyDeployerRemoved, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "DeployerRemoved", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyDeployerRemoved)
+				if err := _DeployerProxy.contract.UnpackLog(event, "DeployerRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeployerRemoved is a log parse operation binding the contract event 0xfdb22628e87f888d060acc53d048a6a8400a5024f81f9dcb0606e723f238864a.
+//
+// Solidity: event DeployerRemoved(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) ParseDeployerRemoved(log types.Log) (*DeployerProxyDeployerRemoved, error) {
+	event := new(DeployerProxyDeployerRemoved)
+	if err := _DeployerProxy.contract.UnpackLog(event, "DeployerRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyDeployerUnbannedIterator is returned from FilterDeployerUnbanned and is used to iterate over the raw logs and unpacked data for DeployerUnbanned events raised by the DeployerProxy contract.
+type DeployerProxyDeployerUnbannedIterator struct {
+	Event *DeployerProxyDeployerUnbanned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyDeployerUnbannedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyDeployerUnbanned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyDeployerUnbanned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyDeployerUnbannedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyDeployerUnbannedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyDeployerUnbanned represents a DeployerUnbanned event raised by the DeployerProxy contract.
+type DeployerProxyDeployerUnbanned struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeployerUnbanned is a free log retrieval operation binding the contract event 0x59c71bff57754566be21262a328a9c5c20b85a1be305ff7e01378049d02493e1.
+//
+// Solidity: event DeployerUnbanned(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) FilterDeployerUnbanned(opts *bind.FilterOpts, account []common.Address) (*DeployerProxyDeployerUnbannedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "DeployerUnbanned", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyDeployerUnbannedIterator{contract: _DeployerProxy.contract, event: "DeployerUnbanned", logs: logs, sub: sub}, nil
+}
+
+// WatchDeployerUnbanned is a free log subscription operation binding the contract event 0x59c71bff57754566be21262a328a9c5c20b85a1be305ff7e01378049d02493e1.
+//
+// Solidity: event DeployerUnbanned(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) WatchDeployerUnbanned(opts *bind.WatchOpts, sink chan<- *DeployerProxyDeployerUnbanned, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "DeployerUnbanned", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyDeployerUnbanned)
+				if err := _DeployerProxy.contract.UnpackLog(event, "DeployerUnbanned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeployerUnbanned is a log parse operation binding the contract event 0x59c71bff57754566be21262a328a9c5c20b85a1be305ff7e01378049d02493e1.
+//
+// Solidity: event DeployerUnbanned(address indexed account)
+func (_DeployerProxy *DeployerProxyFilterer) ParseDeployerUnbanned(log types.Log) (*DeployerProxyDeployerUnbanned, error) {
+	event := new(DeployerProxyDeployerUnbanned)
+	if err := _DeployerProxy.contract.UnpackLog(event, "DeployerUnbanned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DeployerProxyInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the DeployerProxy contract.
+type DeployerProxyInitializedIterator struct {
+	Event *DeployerProxyInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DeployerProxyInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DeployerProxyInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DeployerProxyInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DeployerProxyInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DeployerProxyInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DeployerProxyInitialized represents a Initialized event raised by the DeployerProxy contract.
+type DeployerProxyInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_DeployerProxy *DeployerProxyFilterer) FilterInitialized(opts *bind.FilterOpts) (*DeployerProxyInitializedIterator, error) {
+
+	logs, sub, err := _DeployerProxy.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &DeployerProxyInitializedIterator{contract: _DeployerProxy.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_DeployerProxy *DeployerProxyFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *DeployerProxyInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _DeployerProxy.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DeployerProxyInitialized)
+				if err := _DeployerProxy.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_DeployerProxy *DeployerProxyFilterer) ParseInitialized(log types.Log) (*DeployerProxyInitialized, error) {
+	event := new(DeployerProxyInitialized)
+	if err := _DeployerProxy.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,28 @@
+package bas
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SystemTxOpts returns transact options that make a generated transactor method
+// assemble its transaction without signing or sending it. The consensus engine
+// uses them to obtain the calldata of system transactions, so the transactor
+// bindings can be created with a nil backend.
+func SystemTxOpts(from common.Address, value *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:     from,
+		Nonce:    common.Big0,
+		Value:    value,
+		GasPrice: common.Big0,
+		GasLimit: math.MaxUint64 / 2,
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+		NoSend: true,
+	}
+}
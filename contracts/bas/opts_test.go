@@ -0,0 +1,32 @@
+package bas
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSystemTxOptsCalldata(t *testing.T) {
+	staking, err := NewStakingTransactor(common.HexToAddress("0x0000000000000000000000000000000000001000"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tx, err := staking.Deposit(SystemTxOpts(common.Address{}, big.NewInt(1)), validator)
+	if err != nil {
+		t.Fatalf("failed to assemble deposit: %v", err)
+	}
+	selector := crypto.Keccak256([]byte("deposit(address)"))[:4]
+	if !bytes.Equal(tx.Data()[:4], selector) {
+		t.Fatalf("selector mismatch: have %x, want %x", tx.Data()[:4], selector)
+	}
+	if !bytes.Equal(tx.Data()[4:], common.LeftPadBytes(validator.Bytes(), 32)) {
+		t.Fatalf("argument mismatch: have %x", tx.Data()[4:])
+	}
+	if tx.Value().Cmp(big.NewInt(1)) != 0 || tx.GasPrice().Sign() != 0 || tx.Nonce() != 0 {
+		t.Fatalf("unexpected transaction fields: value %v, gas price %v, nonce %d", tx.Value(), tx.GasPrice(), tx.Nonce())
+	}
+}
@@ -0,0 +1,803 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bas
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RuntimeUpgradeMetaData contains all meta data concerning the RuntimeUpgrade contract.
+var RuntimeUpgradeMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\",\"indexed\":false}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"bytes\",\"name\":\"newByteCode\",\"type\":\"bytes\",\"indexed\":false}],\"name\":\"SmartContractUpgrade\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isInitialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStaking\",\"name\":\"stakingContract\",\"type\":\"address\"},{\"internalType\":\"contractISlashingIndicator\",\"name\":\"slashingIndicatorContract\",\"type\":\"address\"},{\"internalType\":\"contractISystemReward\",\"name\":\"systemRewardContract\",\"type\":\"address\"},{\"internalType\":\"contractIStakingPool\",\"name\":\"stakingPoolContract\",\"type\":\"address\"},{\"internalType\":\"contractIGovernance\",\"name\":\"governanceContract\",\"type\":\"address\"},{\"internalType\":\"contractIChainConfig\",\"name\":\"chainConfigContract\",\"type\":\"address\"},{\"internalType\":\"contractIRuntimeUpgrade\",\"name\":\"runtimeUpgradeContract\",\"type\":\"address\"},{\"internalType\":\"contractIDeployerProxy\",\"name\":\"deployerProxyContract\",\"type\":\"address\"}],\"name\":\"initManually\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"systemContract\",\"type\":\"address\"}],\"name\":\"ctor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getStaking\",\"outputs\":[{\"internalType\":\"contractIStaking\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSlashingIndicator\",\"outputs\":[{\"internalType\":\"contractISlashingIndicator\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSystemReward\",\"outputs\":[{\"internalType\":\"contractISystemReward\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getStakingPool\",\"outputs\":[{\"internalType\":\"contractIStakingPool\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGovernance\",\"outputs\":[{\"internalType\":\"contractIGovernance\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getChainConfig\",\"outputs\":[{\"internalType\":\"contractIChainConfig\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getSystemContracts\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"systemContractAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"newByteCode\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"applyFunction\",\"type\":\"bytes\"}],\"name\":\"upgradeSystemSmartContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"systemContractAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"newByteCode\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"applyFunction\",\"type\":\"bytes\"}],\"name\":\"deploySystemSmartContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// RuntimeUpgradeABI is the input ABI used to generate the binding from.
+// Deprecated: Use RuntimeUpgradeMetaData.ABI instead.
+var RuntimeUpgradeABI = RuntimeUpgradeMetaData.ABI
+
+// RuntimeUpgrade is an auto generated Go binding around an Ethereum contract.
+type RuntimeUpgrade struct {
+	RuntimeUpgradeCaller     // Read-only binding to the contract
+	RuntimeUpgradeTransactor // Write-only binding to the contract
+	RuntimeUpgradeFilterer   // Log filterer for contract events
+}
+
+// RuntimeUpgradeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RuntimeUpgradeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RuntimeUpgradeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RuntimeUpgradeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RuntimeUpgradeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RuntimeUpgradeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RuntimeUpgradeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RuntimeUpgradeSession struct {
+	Contract     *RuntimeUpgrade   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// RuntimeUpgradeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RuntimeUpgradeCallerSession struct {
+	Contract *RuntimeUpgradeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// RuntimeUpgradeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RuntimeUpgradeTransactorSession struct {
+	Contract     *RuntimeUpgradeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// RuntimeUpgradeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RuntimeUpgradeRaw struct {
+	Contract *RuntimeUpgrade // Generic contract binding to access the raw methods on
+}
+
+// RuntimeUpgradeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RuntimeUpgradeCallerRaw struct {
+	Contract *RuntimeUpgradeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RuntimeUpgradeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RuntimeUpgradeTransactorRaw struct {
+	Contract *RuntimeUpgradeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRuntimeUpgrade creates a new instance of RuntimeUpgrade, bound to a specific deployed contract.
+func NewRuntimeUpgrade(address common.Address, backend bind.ContractBackend) (*RuntimeUpgrade, error) {
+	contract, err := bindRuntimeUpgrade(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RuntimeUpgrade{RuntimeUpgradeCaller: RuntimeUpgradeCaller{contract: contract}, RuntimeUpgradeTransactor: RuntimeUpgradeTransactor{contract: contract}, RuntimeUpgradeFilterer: RuntimeUpgradeFilterer{contract: contract}}, nil
+}
+
+// NewRuntimeUpgradeCaller creates a new read-only instance of RuntimeUpgrade, bound to a specific deployed contract.
+func NewRuntimeUpgradeCaller(address common.Address, caller bind.ContractCaller) (*RuntimeUpgradeCaller, error) {
+	contract, err := bindRuntimeUpgrade(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RuntimeUpgradeCaller{contract: contract}, nil
+}
+
+// NewRuntimeUpgradeTransactor creates a new write-only instance of RuntimeUpgrade, bound to a specific deployed contract.
+func NewRuntimeUpgradeTransactor(address common.Address, transactor bind.ContractTransactor) (*RuntimeUpgradeTransactor, error) {
+	contract, err := bindRuntimeUpgrade(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RuntimeUpgradeTransactor{contract: contract}, nil
+}
+
+// NewRuntimeUpgradeFilterer creates a new log filterer instance of RuntimeUpgrade, bound to a specific deployed contract.
+func NewRuntimeUpgradeFilterer(address common.Address, filterer bind.ContractFilterer) (*RuntimeUpgradeFilterer, error) {
+	contract, err := bindRuntimeUpgrade(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RuntimeUpgradeFilterer{contract: contract}, nil
+}
+
+// bindRuntimeUpgrade binds a generic wrapper to an already deployed contract.
+func bindRuntimeUpgrade(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RuntimeUpgradeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RuntimeUpgrade *RuntimeUpgradeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RuntimeUpgrade.Contract.RuntimeUpgradeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RuntimeUpgrade *RuntimeUpgradeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.RuntimeUpgradeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RuntimeUpgrade *RuntimeUpgradeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.RuntimeUpgradeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RuntimeUpgrade *RuntimeUpgradeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RuntimeUpgrade.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetChainConfig is a free data retrieval call binding the contract method 0x606c0c94.
+//
+// Solidity: function getChainConfig() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetChainConfig(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getChainConfig")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetChainConfig is a free data retrieval call binding the contract method 0x606c0c94.
+//
+// Solidity: function getChainConfig() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetChainConfig() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetChainConfig(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetChainConfig is a free data retrieval call binding the contract method 0x606c0c94.
+//
+// Solidity: function getChainConfig() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetChainConfig() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetChainConfig(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetGovernance is a free data retrieval call binding the contract method 0x289b3c0d.
+//
+// Solidity: function getGovernance() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetGovernance(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getGovernance")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetGovernance is a free data retrieval call binding the contract method 0x289b3c0d.
+//
+// Solidity: function getGovernance() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetGovernance() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetGovernance(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetGovernance is a free data retrieval call binding the contract method 0x289b3c0d.
+//
+// Solidity: function getGovernance() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetGovernance() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetGovernance(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetSlashingIndicator is a free data retrieval call binding the contract method 0x244d8257.
+//
+// Solidity: function getSlashingIndicator() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetSlashingIndicator(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getSlashingIndicator")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetSlashingIndicator is a free data retrieval call binding the contract method 0x244d8257.
+//
+// Solidity: function getSlashingIndicator() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetSlashingIndicator() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetSlashingIndicator(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetSlashingIndicator is a free data retrieval call binding the contract method 0x244d8257.
+//
+// Solidity: function getSlashingIndicator() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetSlashingIndicator() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetSlashingIndicator(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetStaking is a free data retrieval call binding the contract method 0x7b1391a6.
+//
+// Solidity: function getStaking() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetStaking(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getStaking")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetStaking is a free data retrieval call binding the contract method 0x7b1391a6.
+//
+// Solidity: function getStaking() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetStaking() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetStaking(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetStaking is a free data retrieval call binding the contract method 0x7b1391a6.
+//
+// Solidity: function getStaking() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetStaking() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetStaking(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetStakingPool is a free data retrieval call binding the contract method 0x45946334.
+//
+// Solidity: function getStakingPool() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetStakingPool(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getStakingPool")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetStakingPool is a free data retrieval call binding the contract method 0x45946334.
+//
+// Solidity: function getStakingPool() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetStakingPool() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetStakingPool(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetStakingPool is a free data retrieval call binding the contract method 0x45946334.
+//
+// Solidity: function getStakingPool() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetStakingPool() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetStakingPool(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetSystemContracts is a free data retrieval call binding the contract method 0x18f062cd.
+//
+// Solidity: function getSystemContracts() view returns(address[])
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetSystemContracts(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getSystemContracts")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetSystemContracts is a free data retrieval call binding the contract method 0x18f062cd.
+//
+// Solidity: function getSystemContracts() view returns(address[])
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetSystemContracts() ([]common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetSystemContracts(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetSystemContracts is a free data retrieval call binding the contract method 0x18f062cd.
+//
+// Solidity: function getSystemContracts() view returns(address[])
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetSystemContracts() ([]common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetSystemContracts(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetSystemReward is a free data retrieval call binding the contract method 0x7a37cc59.
+//
+// Solidity: function getSystemReward() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) GetSystemReward(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "getSystemReward")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetSystemReward is a free data retrieval call binding the contract method 0x7a37cc59.
+//
+// Solidity: function getSystemReward() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) GetSystemReward() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetSystemReward(&_RuntimeUpgrade.CallOpts)
+}
+
+// GetSystemReward is a free data retrieval call binding the contract method 0x7a37cc59.
+//
+// Solidity: function getSystemReward() view returns(address)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) GetSystemReward() (common.Address, error) {
+	return _RuntimeUpgrade.Contract.GetSystemReward(&_RuntimeUpgrade.CallOpts)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_RuntimeUpgrade *RuntimeUpgradeCaller) IsInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _RuntimeUpgrade.contract.Call(opts, &out, "isInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_RuntimeUpgrade *RuntimeUpgradeSession) IsInitialized() (bool, error) {
+	return _RuntimeUpgrade.Contract.IsInitialized(&_RuntimeUpgrade.CallOpts)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_RuntimeUpgrade *RuntimeUpgradeCallerSession) IsInitialized() (bool, error) {
+	return _RuntimeUpgrade.Contract.IsInitialized(&_RuntimeUpgrade.CallOpts)
+}
+
+// Ctor is a paid mutator transaction binding the contract method 0x16cbea51.
+//
+// Solidity: function ctor(address systemContract) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactor) Ctor(opts *bind.TransactOpts, systemContract common.Address) (*types.Transaction, error) {
+	return _RuntimeUpgrade.contract.Transact(opts, "ctor", systemContract)
+}
+
+// Ctor is a paid mutator transaction binding the contract method 0x16cbea51.
+//
+// Solidity: function ctor(address systemContract) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeSession) Ctor(systemContract common.Address) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.Ctor(&_RuntimeUpgrade.TransactOpts, systemContract)
+}
+
+// Ctor is a paid mutator transaction binding the contract method 0x16cbea51.
+//
+// Solidity: function ctor(address systemContract) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorSession) Ctor(systemContract common.Address) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.Ctor(&_RuntimeUpgrade.TransactOpts, systemContract)
+}
+
+// DeploySystemSmartContract is a paid mutator transaction binding the contract method 0xafaf1dbc.
+//
+// Solidity: function deploySystemSmartContract(address systemContractAddress, bytes newByteCode, bytes applyFunction) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactor) DeploySystemSmartContract(opts *bind.TransactOpts, systemContractAddress common.Address, newByteCode []byte, applyFunction []byte) (*types.Transaction, error) {
+	return _RuntimeUpgrade.contract.Transact(opts, "deploySystemSmartContract", systemContractAddress, newByteCode, applyFunction)
+}
+
+// DeploySystemSmartContract is a paid mutator transaction binding the contract method 0xafaf1dbc.
+//
+// Solidity: function deploySystemSmartContract(address systemContractAddress, bytes newByteCode, bytes applyFunction) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeSession) DeploySystemSmartContract(systemContractAddress common.Address, newByteCode []byte, applyFunction []byte) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.DeploySystemSmartContract(&_RuntimeUpgrade.TransactOpts, systemContractAddress, newByteCode, applyFunction)
+}
+
+// DeploySystemSmartContract is a paid mutator transaction binding the contract method 0xafaf1dbc.
+//
+// Solidity: function deploySystemSmartContract(address systemContractAddress, bytes newByteCode, bytes applyFunction) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorSession) DeploySystemSmartContract(systemContractAddress common.Address, newByteCode []byte, applyFunction []byte) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.DeploySystemSmartContract(&_RuntimeUpgrade.TransactOpts, systemContractAddress, newByteCode, applyFunction)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactor) Init(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RuntimeUpgrade.contract.Transact(opts, "init")
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_RuntimeUpgrade *RuntimeUpgradeSession) Init() (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.Init(&_RuntimeUpgrade.TransactOpts)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorSession) Init() (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.Init(&_RuntimeUpgrade.TransactOpts)
+}
+
+// InitManually is a paid mutator transaction binding the contract method 0x3e1bbdc9.
+//
+// Solidity: function initManually(address stakingContract, address slashingIndicatorContract, address systemRewardContract, address stakingPoolContract, address governanceContract, address chainConfigContract, address runtimeUpgradeContract, address deployerProxyContract) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactor) InitManually(opts *bind.TransactOpts, stakingContract common.Address, slashingIndicatorContract common.Address, systemRewardContract common.Address, stakingPoolContract common.Address, governanceContract common.Address, chainConfigContract common.Address, runtimeUpgradeContract common.Address, deployerProxyContract common.Address) (*types.Transaction, error) {
+	return _RuntimeUpgrade.contract.Transact(opts, "initManually", stakingContract, slashingIndicatorContract, systemRewardContract, stakingPoolContract, governanceContract, chainConfigContract, runtimeUpgradeContract, deployerProxyContract)
+}
+
+// InitManually is a paid mutator transaction binding the contract method 0x3e1bbdc9.
+//
+// Solidity: function initManually(address stakingContract, address slashingIndicatorContract, address systemRewardContract, address stakingPoolContract, address governanceContract, address chainConfigContract, address runtimeUpgradeContract, address deployerProxyContract) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeSession) InitManually(stakingContract common.Address, slashingIndicatorContract common.Address, systemRewardContract common.Address, stakingPoolContract common.Address, governanceContract common.Address, chainConfigContract common.Address, runtimeUpgradeContract common.Address, deployerProxyContract common.Address) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.InitManually(&_RuntimeUpgrade.TransactOpts, stakingContract, slashingIndicatorContract, systemRewardContract, stakingPoolContract, governanceContract, chainConfigContract, runtimeUpgradeContract, deployerProxyContract)
+}
+
+// InitManually is a paid mutator transaction binding the contract method 0x3e1bbdc9.
+//
+// Solidity: function initManually(address stakingContract, address slashingIndicatorContract, address systemRewardContract, address stakingPoolContract, address governanceContract, address chainConfigContract, address runtimeUpgradeContract, address deployerProxyContract) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorSession) InitManually(stakingContract common.Address, slashingIndicatorContract common.Address, systemRewardContract common.Address, stakingPoolContract common.Address, governanceContract common.Address, chainConfigContract common.Address, runtimeUpgradeContract common.Address, deployerProxyContract common.Address) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.InitManually(&_RuntimeUpgrade.TransactOpts, stakingContract, slashingIndicatorContract, systemRewardContract, stakingPoolContract, governanceContract, chainConfigContract, runtimeUpgradeContract, deployerProxyContract)
+}
+
+// UpgradeSystemSmartContract is a paid mutator transaction binding the contract method 0x38dc8ff3.
+//
+// Solidity: function upgradeSystemSmartContract(address systemContractAddress, bytes newByteCode, bytes applyFunction) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactor) UpgradeSystemSmartContract(opts *bind.TransactOpts, systemContractAddress common.Address, newByteCode []byte, applyFunction []byte) (*types.Transaction, error) {
+	return _RuntimeUpgrade.contract.Transact(opts, "upgradeSystemSmartContract", systemContractAddress, newByteCode, applyFunction)
+}
+
+// UpgradeSystemSmartContract is a paid mutator transaction binding the contract method 0x38dc8ff3.
+//
+// Solidity: function upgradeSystemSmartContract(address systemContractAddress, bytes newByteCode, bytes applyFunction) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeSession) UpgradeSystemSmartContract(systemContractAddress common.Address, newByteCode []byte, applyFunction []byte) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.UpgradeSystemSmartContract(&_RuntimeUpgrade.TransactOpts, systemContractAddress, newByteCode, applyFunction)
+}
+
+// UpgradeSystemSmartContract is a paid mutator transaction binding the contract method 0x38dc8ff3.
+//
+// Solidity: function upgradeSystemSmartContract(address systemContractAddress, bytes newByteCode, bytes applyFunction) returns()
+func (_RuntimeUpgrade *RuntimeUpgradeTransactorSession) UpgradeSystemSmartContract(systemContractAddress common.Address, newByteCode []byte, applyFunction []byte) (*types.Transaction, error) {
+	return _RuntimeUpgrade.Contract.UpgradeSystemSmartContract(&_RuntimeUpgrade.TransactOpts, systemContractAddress, newByteCode, applyFunction)
+}
+
+// RuntimeUpgradeInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the RuntimeUpgrade contract.
+type RuntimeUpgradeInitializedIterator struct {
+	Event *RuntimeUpgradeInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RuntimeUpgradeInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RuntimeUpgradeInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RuntimeUpgradeInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RuntimeUpgradeInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RuntimeUpgradeInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RuntimeUpgradeInitialized represents a Initialized event raised by the RuntimeUpgrade contract.
+type RuntimeUpgradeInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_RuntimeUpgrade *RuntimeUpgradeFilterer) FilterInitialized(opts *bind.FilterOpts) (*RuntimeUpgradeInitializedIterator, error) {
+
+	logs, sub, err := _RuntimeUpgrade.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &RuntimeUpgradeInitializedIterator{contract: _RuntimeUpgrade.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_RuntimeUpgrade *RuntimeUpgradeFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *RuntimeUpgradeInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _RuntimeUpgrade.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RuntimeUpgradeInitialized)
+				if err := _RuntimeUpgrade.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_RuntimeUpgrade *RuntimeUpgradeFilterer) ParseInitialized(log types.Log) (*RuntimeUpgradeInitialized, error) {
+	event := new(RuntimeUpgradeInitialized)
+	if err := _RuntimeUpgrade.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RuntimeUpgradeSmartContractUpgradeIterator is returned from FilterSmartContractUpgrade and is used to iterate over the raw logs and unpacked data for SmartContractUpgrade events raised by the RuntimeUpgrade contract.
+type RuntimeUpgradeSmartContractUpgradeIterator struct {
+	Event *RuntimeUpgradeSmartContractUpgrade // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RuntimeUpgradeSmartContractUpgradeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RuntimeUpgradeSmartContractUpgrade)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RuntimeUpgradeSmartContractUpgrade)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RuntimeUpgradeSmartContractUpgradeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RuntimeUpgradeSmartContractUpgradeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RuntimeUpgradeSmartContractUpgrade represents a SmartContractUpgrade event raised by the RuntimeUpgrade contract.
+type RuntimeUpgradeSmartContractUpgrade struct {
+	ContractAddress common.Address
+	NewByteCode     []byte
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterSmartContractUpgrade is a free log retrieval operation binding the contract event 0x294c52758d41df5421795a058ea4837ce9d9714c75091eb30fe6925d1231db4a.
+//
+// Solidity: event SmartContractUpgrade(address contractAddress, bytes newByteCode)
+func (_RuntimeUpgrade *RuntimeUpgradeFilterer) FilterSmartContractUpgrade(opts *bind.FilterOpts) (*RuntimeUpgradeSmartContractUpgradeIterator, error) {
+
+	logs, sub, err := _RuntimeUpgrade.contract.FilterLogs(opts, "SmartContractUpgrade")
+	if err != nil {
+		return nil, err
+	}
+	return &RuntimeUpgradeSmartContractUpgradeIterator{contract: _RuntimeUpgrade.contract, event: "SmartContractUpgrade", logs: logs, sub: sub}, nil
+}
+
+// WatchSmartContractUpgrade is a free log subscription operation binding the contract event 0x294c52758d41df5421795a058ea4837ce9d9714c75091eb30fe6925d1231db4a.
+//
+// Solidity: event SmartContractUpgrade(address contractAddress, bytes newByteCode)
+func (_RuntimeUpgrade *RuntimeUpgradeFilterer) WatchSmartContractUpgrade(opts *bind.WatchOpts, sink chan<- *RuntimeUpgradeSmartContractUpgrade) (event.Subscription, error) {
+
+	logs, sub, err := _RuntimeUpgrade.contract.WatchLogs(opts, "SmartContractUpgrade")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RuntimeUpgradeSmartContractUpgrade)
+				if err := _RuntimeUpgrade.contract.UnpackLog(event, "SmartContractUpgrade", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSmartContractUpgrade is a log parse operation binding the contract event 0x294c52758d41df5421795a058ea4837ce9d9714c75091eb30fe6925d1231db4a.
+//
+// Solidity: event SmartContractUpgrade(address contractAddress, bytes newByteCode)
+func (_RuntimeUpgrade *RuntimeUpgradeFilterer) ParseSmartContractUpgrade(log types.Log) (*RuntimeUpgradeSmartContractUpgrade, error) {
+	event := new(RuntimeUpgradeSmartContractUpgrade)
+	if err := _RuntimeUpgrade.contract.UnpackLog(event, "SmartContractUpgrade", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1056 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bas
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// StakingPoolValidatorPool is an auto generated low-level Go binding around an user-defined struct.
+type StakingPoolValidatorPool struct {
+	ValidatorAddress  common.Address
+	SharesSupply      *big.Int
+	TotalStakedAmount *big.Int
+	DustRewards       *big.Int
+	PendingUnstake    *big.Int
+}
+
+// StakingPoolMetaData contains all meta data concerning the StakingPool contract.
+var StakingPoolMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\",\"indexed\":false}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Stake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Unstake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Claim\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isInitialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"}],\"name\":\"getStakedAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"}],\"name\":\"getShares\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getValidatorPool\",\"outputs\":[{\"internalType\":\"structStakingPool.ValidatorPool\",\"name\":\"\",\"type\":\"tuple\",\"components\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"sharesSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalStakedAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"dustRewards\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"pendingUnstake\",\"type\":\"uint256\"}]}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getRatio\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"stake\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"unstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"}],\"name\":\"claimableRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"claim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// StakingPoolABI is the input ABI used to generate the binding from.
+// Deprecated: Use StakingPoolMetaData.ABI instead.
+var StakingPoolABI = StakingPoolMetaData.ABI
+
+// StakingPool is an auto generated Go binding around an Ethereum contract.
+type StakingPool struct {
+	StakingPoolCaller     // Read-only binding to the contract
+	StakingPoolTransactor // Write-only binding to the contract
+	StakingPoolFilterer   // Log filterer for contract events
+}
+
+// StakingPoolCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StakingPoolCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingPoolTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StakingPoolTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingPoolFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StakingPoolFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingPoolSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StakingPoolSession struct {
+	Contract     *StakingPool      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StakingPoolCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StakingPoolCallerSession struct {
+	Contract *StakingPoolCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// StakingPoolTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StakingPoolTransactorSession struct {
+	Contract     *StakingPoolTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// StakingPoolRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StakingPoolRaw struct {
+	Contract *StakingPool // Generic contract binding to access the raw methods on
+}
+
+// StakingPoolCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StakingPoolCallerRaw struct {
+	Contract *StakingPoolCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StakingPoolTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StakingPoolTransactorRaw struct {
+	Contract *StakingPoolTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStakingPool creates a new instance of StakingPool, bound to a specific deployed contract.
+func NewStakingPool(address common.Address, backend bind.ContractBackend) (*StakingPool, error) {
+	contract, err := bindStakingPool(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPool{StakingPoolCaller: StakingPoolCaller{contract: contract}, StakingPoolTransactor: StakingPoolTransactor{contract: contract}, StakingPoolFilterer: StakingPoolFilterer{contract: contract}}, nil
+}
+
+// NewStakingPoolCaller creates a new read-only instance of StakingPool, bound to a specific deployed contract.
+func NewStakingPoolCaller(address common.Address, caller bind.ContractCaller) (*StakingPoolCaller, error) {
+	contract, err := bindStakingPool(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolCaller{contract: contract}, nil
+}
+
+// NewStakingPoolTransactor creates a new write-only instance of StakingPool, bound to a specific deployed contract.
+func NewStakingPoolTransactor(address common.Address, transactor bind.ContractTransactor) (*StakingPoolTransactor, error) {
+	contract, err := bindStakingPool(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolTransactor{contract: contract}, nil
+}
+
+// NewStakingPoolFilterer creates a new log filterer instance of StakingPool, bound to a specific deployed contract.
+func NewStakingPoolFilterer(address common.Address, filterer bind.ContractFilterer) (*StakingPoolFilterer, error) {
+	contract, err := bindStakingPool(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolFilterer{contract: contract}, nil
+}
+
+// bindStakingPool binds a generic wrapper to an already deployed contract.
+func bindStakingPool(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StakingPoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StakingPool *StakingPoolRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StakingPool.Contract.StakingPoolCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StakingPool *StakingPoolRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakingPool.Contract.StakingPoolTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StakingPool *StakingPoolRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StakingPool.Contract.StakingPoolTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StakingPool *StakingPoolCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StakingPool.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StakingPool *StakingPoolTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakingPool.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StakingPool *StakingPoolTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StakingPool.Contract.contract.Transact(opts, method, params...)
+}
+
+// ClaimableRewards is a free data retrieval call binding the contract method 0x6be9dcce.
+//
+// Solidity: function claimableRewards(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolCaller) ClaimableRewards(opts *bind.CallOpts, validator common.Address, staker common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StakingPool.contract.Call(opts, &out, "claimableRewards", validator, staker)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ClaimableRewards is a free data retrieval call binding the contract method 0x6be9dcce.
+//
+// Solidity: function claimableRewards(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolSession) ClaimableRewards(validator common.Address, staker common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.ClaimableRewards(&_StakingPool.CallOpts, validator, staker)
+}
+
+// ClaimableRewards is a free data retrieval call binding the contract method 0x6be9dcce.
+//
+// Solidity: function claimableRewards(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolCallerSession) ClaimableRewards(validator common.Address, staker common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.ClaimableRewards(&_StakingPool.CallOpts, validator, staker)
+}
+
+// GetRatio is a free data retrieval call binding the contract method 0x754b2707.
+//
+// Solidity: function getRatio(address validator) view returns(uint256)
+func (_StakingPool *StakingPoolCaller) GetRatio(opts *bind.CallOpts, validator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StakingPool.contract.Call(opts, &out, "getRatio", validator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetRatio is a free data retrieval call binding the contract method 0x754b2707.
+//
+// Solidity: function getRatio(address validator) view returns(uint256)
+func (_StakingPool *StakingPoolSession) GetRatio(validator common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.GetRatio(&_StakingPool.CallOpts, validator)
+}
+
+// GetRatio is a free data retrieval call binding the contract method 0x754b2707.
+//
+// Solidity: function getRatio(address validator) view returns(uint256)
+func (_StakingPool *StakingPoolCallerSession) GetRatio(validator common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.GetRatio(&_StakingPool.CallOpts, validator)
+}
+
+// GetShares is a free data retrieval call binding the contract method 0x00392040.
+//
+// Solidity: function getShares(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolCaller) GetShares(opts *bind.CallOpts, validator common.Address, staker common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StakingPool.contract.Call(opts, &out, "getShares", validator, staker)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetShares is a free data retrieval call binding the contract method 0x00392040.
+//
+// Solidity: function getShares(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolSession) GetShares(validator common.Address, staker common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.GetShares(&_StakingPool.CallOpts, validator, staker)
+}
+
+// GetShares is a free data retrieval call binding the contract method 0x00392040.
+//
+// Solidity: function getShares(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolCallerSession) GetShares(validator common.Address, staker common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.GetShares(&_StakingPool.CallOpts, validator, staker)
+}
+
+// GetStakedAmount is a free data retrieval call binding the contract method 0x0db14e95.
+//
+// Solidity: function getStakedAmount(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolCaller) GetStakedAmount(opts *bind.CallOpts, validator common.Address, staker common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StakingPool.contract.Call(opts, &out, "getStakedAmount", validator, staker)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetStakedAmount is a free data retrieval call binding the contract method 0x0db14e95.
+//
+// Solidity: function getStakedAmount(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolSession) GetStakedAmount(validator common.Address, staker common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.GetStakedAmount(&_StakingPool.CallOpts, validator, staker)
+}
+
+// GetStakedAmount is a free data retrieval call binding the contract method 0x0db14e95.
+//
+// Solidity: function getStakedAmount(address validator, address staker) view returns(uint256)
+func (_StakingPool *StakingPoolCallerSession) GetStakedAmount(validator common.Address, staker common.Address) (*big.Int, error) {
+	return _StakingPool.Contract.GetStakedAmount(&_StakingPool.CallOpts, validator, staker)
+}
+
+// GetValidatorPool is a free data retrieval call binding the contract method 0x1712b0d8.
+//
+// Solidity: function getValidatorPool(address validator) view returns((address,uint256,uint256,uint256,uint256))
+func (_StakingPool *StakingPoolCaller) GetValidatorPool(opts *bind.CallOpts, validator common.Address) (StakingPoolValidatorPool, error) {
+	var out []interface{}
+	err := _StakingPool.contract.Call(opts, &out, "getValidatorPool", validator)
+
+	if err != nil {
+		return *new(StakingPoolValidatorPool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(StakingPoolValidatorPool)).(*StakingPoolValidatorPool)
+
+	return out0, err
+
+}
+
+// GetValidatorPool is a free data retrieval call binding the contract method 0x1712b0d8.
+//
+// Solidity: function getValidatorPool(address validator) view returns((address,uint256,uint256,uint256,uint256))
+func (_StakingPool *StakingPoolSession) GetValidatorPool(validator common.Address) (StakingPoolValidatorPool, error) {
+	return _StakingPool.Contract.GetValidatorPool(&_StakingPool.CallOpts, validator)
+}
+
+// GetValidatorPool is a free data retrieval call binding the contract method 0x1712b0d8.
+//
+// Solidity: function getValidatorPool(address validator) view returns((address,uint256,uint256,uint256,uint256))
+func (_StakingPool *StakingPoolCallerSession) GetValidatorPool(validator common.Address) (StakingPoolValidatorPool, error) {
+	return _StakingPool.Contract.GetValidatorPool(&_StakingPool.CallOpts, validator)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_StakingPool *StakingPoolCaller) IsInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _StakingPool.contract.Call(opts, &out, "isInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_StakingPool *StakingPoolSession) IsInitialized() (bool, error) {
+	return _StakingPool.Contract.IsInitialized(&_StakingPool.CallOpts)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_StakingPool *StakingPoolCallerSession) IsInitialized() (bool, error) {
+	return _StakingPool.Contract.IsInitialized(&_StakingPool.CallOpts)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x1e83409a.
+//
+// Solidity: function claim(address validator) returns()
+func (_StakingPool *StakingPoolTransactor) Claim(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _StakingPool.contract.Transact(opts, "claim", validator)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x1e83409a.
+//
+// Solidity: function claim(address validator) returns()
+func (_StakingPool *StakingPoolSession) Claim(validator common.Address) (*types.Transaction, error) {
+	return _StakingPool.Contract.Claim(&_StakingPool.TransactOpts, validator)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x1e83409a.
+//
+// Solidity: function claim(address validator) returns()
+func (_StakingPool *StakingPoolTransactorSession) Claim(validator common.Address) (*types.Transaction, error) {
+	return _StakingPool.Contract.Claim(&_StakingPool.TransactOpts, validator)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_StakingPool *StakingPoolTransactor) Init(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakingPool.contract.Transact(opts, "init")
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_StakingPool *StakingPoolSession) Init() (*types.Transaction, error) {
+	return _StakingPool.Contract.Init(&_StakingPool.TransactOpts)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_StakingPool *StakingPoolTransactorSession) Init() (*types.Transaction, error) {
+	return _StakingPool.Contract.Init(&_StakingPool.TransactOpts)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x26476204.
+//
+// Solidity: function stake(address validator) payable returns()
+func (_StakingPool *StakingPoolTransactor) Stake(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _StakingPool.contract.Transact(opts, "stake", validator)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x26476204.
+//
+// Solidity: function stake(address validator) payable returns()
+func (_StakingPool *StakingPoolSession) Stake(validator common.Address) (*types.Transaction, error) {
+	return _StakingPool.Contract.Stake(&_StakingPool.TransactOpts, validator)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x26476204.
+//
+// Solidity: function stake(address validator) payable returns()
+func (_StakingPool *StakingPoolTransactorSession) Stake(validator common.Address) (*types.Transaction, error) {
+	return _StakingPool.Contract.Stake(&_StakingPool.TransactOpts, validator)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc2a672e0.
+//
+// Solidity: function unstake(address validator, uint256 amount) returns()
+func (_StakingPool *StakingPoolTransactor) Unstake(opts *bind.TransactOpts, validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _StakingPool.contract.Transact(opts, "unstake", validator, amount)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc2a672e0.
+//
+// Solidity: function unstake(address validator, uint256 amount) returns()
+func (_StakingPool *StakingPoolSession) Unstake(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _StakingPool.Contract.Unstake(&_StakingPool.TransactOpts, validator, amount)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc2a672e0.
+//
+// Solidity: function unstake(address validator, uint256 amount) returns()
+func (_StakingPool *StakingPoolTransactorSession) Unstake(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _StakingPool.Contract.Unstake(&_StakingPool.TransactOpts, validator, amount)
+}
+
+// StakingPoolClaimIterator is returned from FilterClaim and is used to iterate over the raw logs and unpacked data for Claim events raised by the StakingPool contract.
+type StakingPoolClaimIterator struct {
+	Event *StakingPoolClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingPoolClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingPoolClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingPoolClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingPoolClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingPoolClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingPoolClaim represents a Claim event raised by the StakingPool contract.
+type StakingPoolClaim struct {
+	Validator common.Address
+	Staker    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaim is a free log retrieval operation binding the contract event 0x70eb43c4a8ae8c40502dcf22436c509c28d6ff421cf07c491be56984bd987068.
+//
+// Solidity: event Claim(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) FilterClaim(opts *bind.FilterOpts, validator []common.Address, staker []common.Address) (*StakingPoolClaimIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _StakingPool.contract.FilterLogs(opts, "Claim", validatorRule, stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolClaimIterator{contract: _StakingPool.contract, event: "Claim", logs: logs, sub: sub}, nil
+}
+
+// WatchClaim is a free log subscription operation binding the contract event 0x70eb43c4a8ae8c40502dcf22436c509c28d6ff421cf07c491be56984bd987068.
+//
+// Solidity: event Claim(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) WatchClaim(opts *bind.WatchOpts, sink chan<- *StakingPoolClaim, validator []common.Address, staker []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _StakingPool.contract.WatchLogs(opts, "Claim", validatorRule, stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingPoolClaim)
+				if err := _StakingPool.contract.UnpackLog(event, "Claim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaim is a log parse operation binding the contract event 0x70eb43c4a8ae8c40502dcf22436c509c28d6ff421cf07c491be56984bd987068.
+//
+// Solidity: event Claim(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) ParseClaim(log types.Log) (*StakingPoolClaim, error) {
+	event := new(StakingPoolClaim)
+	if err := _StakingPool.contract.UnpackLog(event, "Claim", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingPoolInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the StakingPool contract.
+type StakingPoolInitializedIterator struct {
+	Event *StakingPoolInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingPoolInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingPoolInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingPoolInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingPoolInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingPoolInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingPoolInitialized represents a Initialized event raised by the StakingPool contract.
+type StakingPoolInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_StakingPool *StakingPoolFilterer) FilterInitialized(opts *bind.FilterOpts) (*StakingPoolInitializedIterator, error) {
+
+	logs, sub, err := _StakingPool.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolInitializedIterator{contract: _StakingPool.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_StakingPool *StakingPoolFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *StakingPoolInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _StakingPool.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingPoolInitialized)
+				if err := _StakingPool.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_StakingPool *StakingPoolFilterer) ParseInitialized(log types.Log) (*StakingPoolInitialized, error) {
+	event := new(StakingPoolInitialized)
+	if err := _StakingPool.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingPoolStakeIterator is returned from FilterStake and is used to iterate over the raw logs and unpacked data for Stake events raised by the StakingPool contract.
+type StakingPoolStakeIterator struct {
+	Event *StakingPoolStake // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingPoolStakeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingPoolStake)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingPoolStake)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingPoolStakeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingPoolStakeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingPoolStake represents a Stake event raised by the StakingPool contract.
+type StakingPoolStake struct {
+	Validator common.Address
+	Staker    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterStake is a free log retrieval operation binding the contract event 0x99039fcf0a98f484616c5196ee8b2ecfa971babf0b519848289ea4db381f85f7.
+//
+// Solidity: event Stake(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) FilterStake(opts *bind.FilterOpts, validator []common.Address, staker []common.Address) (*StakingPoolStakeIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _StakingPool.contract.FilterLogs(opts, "Stake", validatorRule, stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolStakeIterator{contract: _StakingPool.contract, event: "Stake", logs: logs, sub: sub}, nil
+}
+
+// WatchStake is a free log subscription operation binding the contract event 0x99039fcf0a98f484616c5196ee8b2ecfa971babf0b519848289ea4db381f85f7.
+//
+// Solidity: event Stake(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) WatchStake(opts *bind.WatchOpts, sink chan<- *StakingPoolStake, validator []common.Address, staker []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _StakingPool.contract.WatchLogs(opts, "Stake", validatorRule, stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingPoolStake)
+				if err := _StakingPool.contract.UnpackLog(event, "Stake", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStake is a log parse operation binding the contract event 0x99039fcf0a98f484616c5196ee8b2ecfa971babf0b519848289ea4db381f85f7.
+//
+// Solidity: event Stake(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) ParseStake(log types.Log) (*StakingPoolStake, error) {
+	event := new(StakingPoolStake)
+	if err := _StakingPool.contract.UnpackLog(event, "Stake", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingPoolUnstakeIterator is returned from FilterUnstake and is used to iterate over the raw logs and unpacked data for Unstake events raised by the StakingPool contract.
+type StakingPoolUnstakeIterator struct {
+	Event *StakingPoolUnstake // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingPoolUnstakeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingPoolUnstake)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingPoolUnstake)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingPoolUnstakeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingPoolUnstakeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingPoolUnstake represents a Unstake event raised by the StakingPool contract.
+type StakingPoolUnstake struct {
+	Validator common.Address
+	Staker    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnstake is a free log retrieval operation binding the contract event 0x390b1276974b9463e5d66ab10df69b6f3d7b930eb066a0e66df327edd2cc811c.
+//
+// Solidity: event Unstake(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) FilterUnstake(opts *bind.FilterOpts, validator []common.Address, staker []common.Address) (*StakingPoolUnstakeIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _StakingPool.contract.FilterLogs(opts, "Unstake", validatorRule, stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolUnstakeIterator{contract: _StakingPool.contract, event: "Unstake", logs: logs, sub: sub}, nil
+}
+
+// WatchUnstake is a free log subscription operation binding the contract event 0x390b1276974b9463e5d66ab10df69b6f3d7b930eb066a0e66df327edd2cc811c.
+//
+// Solidity: event Unstake(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) WatchUnstake(opts *bind.WatchOpts, sink chan<- *StakingPoolUnstake, validator []common.Address, staker []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _StakingPool.contract.WatchLogs(opts, "Unstake", validatorRule, stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingPoolUnstake)
+				if err := _StakingPool.contract.UnpackLog(event, "Unstake", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnstake is a log parse operation binding the contract event 0x390b1276974b9463e5d66ab10df69b6f3d7b930eb066a0e66df327edd2cc811c.
+//
+// Solidity: event Unstake(address indexed validator, address indexed staker, uint256 amount)
+func (_StakingPool *StakingPoolFilterer) ParseUnstake(log types.Log) (*StakingPoolUnstake, error) {
+	event := new(StakingPoolUnstake)
+	if err := _StakingPool.contract.UnpackLog(event, "Unstake", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,233 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bas
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TokenomicsMetaData contains all meta data concerning the Tokenomics contract.
+var TokenomicsMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"getTotalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validatorAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"newTotalSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"inflationPct\",\"type\":\"uint256\"}],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// TokenomicsABI is the input ABI used to generate the binding from.
+// Deprecated: Use TokenomicsMetaData.ABI instead.
+var TokenomicsABI = TokenomicsMetaData.ABI
+
+// Tokenomics is an auto generated Go binding around an Ethereum contract.
+type Tokenomics struct {
+	TokenomicsCaller     // Read-only binding to the contract
+	TokenomicsTransactor // Write-only binding to the contract
+	TokenomicsFilterer   // Log filterer for contract events
+}
+
+// TokenomicsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TokenomicsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenomicsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TokenomicsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenomicsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TokenomicsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenomicsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TokenomicsSession struct {
+	Contract     *Tokenomics       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TokenomicsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TokenomicsCallerSession struct {
+	Contract *TokenomicsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// TokenomicsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TokenomicsTransactorSession struct {
+	Contract     *TokenomicsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// TokenomicsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TokenomicsRaw struct {
+	Contract *Tokenomics // Generic contract binding to access the raw methods on
+}
+
+// TokenomicsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TokenomicsCallerRaw struct {
+	Contract *TokenomicsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TokenomicsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TokenomicsTransactorRaw struct {
+	Contract *TokenomicsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTokenomics creates a new instance of Tokenomics, bound to a specific deployed contract.
+func NewTokenomics(address common.Address, backend bind.ContractBackend) (*Tokenomics, error) {
+	contract, err := bindTokenomics(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokenomics{TokenomicsCaller: TokenomicsCaller{contract: contract}, TokenomicsTransactor: TokenomicsTransactor{contract: contract}, TokenomicsFilterer: TokenomicsFilterer{contract: contract}}, nil
+}
+
+// NewTokenomicsCaller creates a new read-only instance of Tokenomics, bound to a specific deployed contract.
+func NewTokenomicsCaller(address common.Address, caller bind.ContractCaller) (*TokenomicsCaller, error) {
+	contract, err := bindTokenomics(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenomicsCaller{contract: contract}, nil
+}
+
+// NewTokenomicsTransactor creates a new write-only instance of Tokenomics, bound to a specific deployed contract.
+func NewTokenomicsTransactor(address common.Address, transactor bind.ContractTransactor) (*TokenomicsTransactor, error) {
+	contract, err := bindTokenomics(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenomicsTransactor{contract: contract}, nil
+}
+
+// NewTokenomicsFilterer creates a new log filterer instance of Tokenomics, bound to a specific deployed contract.
+func NewTokenomicsFilterer(address common.Address, filterer bind.ContractFilterer) (*TokenomicsFilterer, error) {
+	contract, err := bindTokenomics(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenomicsFilterer{contract: contract}, nil
+}
+
+// bindTokenomics binds a generic wrapper to an already deployed contract.
+func bindTokenomics(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := TokenomicsMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Tokenomics *TokenomicsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Tokenomics.Contract.TokenomicsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Tokenomics *TokenomicsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Tokenomics.Contract.TokenomicsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Tokenomics *TokenomicsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Tokenomics.Contract.TokenomicsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Tokenomics *TokenomicsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Tokenomics.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Tokenomics *TokenomicsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Tokenomics.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Tokenomics *TokenomicsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Tokenomics.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetTotalSupply is a free data retrieval call binding the contract method 0xc4e41b22.
+//
+// Solidity: function getTotalSupply() view returns(uint256)
+func (_Tokenomics *TokenomicsCaller) GetTotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Tokenomics.contract.Call(opts, &out, "getTotalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalSupply is a free data retrieval call binding the contract method 0xc4e41b22.
+//
+// Solidity: function getTotalSupply() view returns(uint256)
+func (_Tokenomics *TokenomicsSession) GetTotalSupply() (*big.Int, error) {
+	return _Tokenomics.Contract.GetTotalSupply(&_Tokenomics.CallOpts)
+}
+
+// GetTotalSupply is a free data retrieval call binding the contract method 0xc4e41b22.
+//
+// Solidity: function getTotalSupply() view returns(uint256)
+func (_Tokenomics *TokenomicsCallerSession) GetTotalSupply() (*big.Int, error) {
+	return _Tokenomics.Contract.GetTotalSupply(&_Tokenomics.CallOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x0efe6a8b.
+//
+// Solidity: function deposit(address validatorAddress, uint256 newTotalSupply, uint256 inflationPct) payable returns()
+func (_Tokenomics *TokenomicsTransactor) Deposit(opts *bind.TransactOpts, validatorAddress common.Address, newTotalSupply *big.Int, inflationPct *big.Int) (*types.Transaction, error) {
+	return _Tokenomics.contract.Transact(opts, "deposit", validatorAddress, newTotalSupply, inflationPct)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x0efe6a8b.
+//
+// Solidity: function deposit(address validatorAddress, uint256 newTotalSupply, uint256 inflationPct) payable returns()
+func (_Tokenomics *TokenomicsSession) Deposit(validatorAddress common.Address, newTotalSupply *big.Int, inflationPct *big.Int) (*types.Transaction, error) {
+	return _Tokenomics.Contract.Deposit(&_Tokenomics.TransactOpts, validatorAddress, newTotalSupply, inflationPct)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x0efe6a8b.
+//
+// Solidity: function deposit(address validatorAddress, uint256 newTotalSupply, uint256 inflationPct) payable returns()
+func (_Tokenomics *TokenomicsTransactorSession) Deposit(validatorAddress common.Address, newTotalSupply *big.Int, inflationPct *big.Int) (*types.Transaction, error) {
+	return _Tokenomics.Contract.Deposit(&_Tokenomics.TransactOpts, validatorAddress, newTotalSupply, inflationPct)
+}
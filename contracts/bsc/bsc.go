@@ -0,0 +1,9 @@
+// Package bsc contains Go bindings for the system contracts Parlia inherits from
+// BNB Smart Chain. The bindings are generated from the ABI files in the abi
+// directory, so any change to a contract interface has to be reflected there
+// and surfaces as a compile error in the callers.
+package bsc
+
+//go:generate abigen --abi abi/ValidatorSetBeforeLuban.json --pkg bsc --type ValidatorSetBeforeLuban --out validator_set_before_luban.go
+//go:generate abigen --abi abi/SlashIndicator.json --pkg bsc --type SlashIndicator --out slash_indicator.go
+//go:generate abigen --abi abi/StakeHub.json --pkg bsc --type StakeHub --out stake_hub.go
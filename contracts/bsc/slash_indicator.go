@@ -0,0 +1,2916 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bsc
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SlashIndicatorFinalityEvidence is an auto generated low-level Go binding around an user-defined struct.
+type SlashIndicatorFinalityEvidence struct {
+	VoteA    SlashIndicatorVoteData
+	VoteB    SlashIndicatorVoteData
+	VoteAddr []byte
+}
+
+// SlashIndicatorVoteData is an auto generated low-level Go binding around an user-defined struct.
+type SlashIndicatorVoteData struct {
+	SrcNum  *big.Int
+	SrcHash [32]byte
+	TarNum  *big.Int
+	TarHash [32]byte
+	Sig     []byte
+}
+
+// SlashIndicatorMetaData contains all meta data concerning the SlashIndicator contract.
+var SlashIndicatorMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"BC_FUSION_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"BIND_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"CODE_OK\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"CROSS_CHAIN_CONTRACT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"CROSS_STAKE_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DECREASE_RATE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ERROR_FAIL_DECODE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"FELONY_THRESHOLD\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"GOVERNOR_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"GOV_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"GOV_HUB_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"GOV_TOKEN_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"INCENTIVIZE_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"INIT_FELONY_SLASH_REWARD_RATIO\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"INIT_FELONY_SLASH_SCOPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"LIGHT_CLIENT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MISDEMEANOR_THRESHOLD\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"RELAYERHUB_CONTRACT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SLASH_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SLASH_CONTRACT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"STAKE_CREDIT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"STAKE_HUB_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"STAKING_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"STAKING_CONTRACT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SYSTEM_REWARD_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TIMELOCK_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TOKEN_HUB_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TOKEN_MANAGER_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TOKEN_RECOVER_PORTAL_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TRANSFER_IN_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TRANSFER_OUT_CHANNELID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VALIDATOR_CONTRACT_ADDR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"alreadyInit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"bscChainID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"clean\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"downtimeSlash\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"count\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"enableMaliciousVoteSlash\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"felonySlashRewardRatio\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"felonySlashScope\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"felonyThreshold\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSlashIndicator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSlashThresholds\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"handleAckPackage\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"msgBytes\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"handleFailAckPackage\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"handleSynPackage\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"indicators\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"height\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"count\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"exist\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"init\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"misdemeanorThreshold\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previousHeight\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sendFelonyPackage\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"slash\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"submitDoubleSignEvidence\",\"inputs\":[{\"name\":\"header1\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"header2\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"submitFinalityViolationEvidence\",\"inputs\":[{\"name\":\"_evidence\",\"type\":\"tuple\",\"internalType\":\"structSlashIndicator.FinalityEvidence\",\"components\":[{\"name\":\"voteA\",\"type\":\"tuple\",\"internalType\":\"structSlashIndicator.VoteData\",\"components\":[{\"name\":\"srcNum\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"srcHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"tarNum\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"tarHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"sig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"voteB\",\"type\":\"tuple\",\"internalType\":\"structSlashIndicator.VoteData\",\"components\":[{\"name\":\"srcNum\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"srcHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"tarNum\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"tarHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"sig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"voteAddr\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateParam\",\"inputs\":[{\"name\":\"key\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validators\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"crashResponse\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"failedFelony\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"slashCount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"failReason\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"indicatorCleaned\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"knownResponse\",\"inputs\":[{\"name\":\"code\",\"type\":\"uint32\",\"indexed\":false,\"internalType\":\"uint32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"maliciousVoteSlashed\",\"inputs\":[{\"name\":\"voteAddrSlice\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"paramChange\",\"inputs\":[{\"name\":\"key\",\"type\":\"string\",\"indexed\":false,\"internalType\":\"string\"},{\"name\":\"value\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"unKnownResponse\",\"inputs\":[{\"name\":\"code\",\"type\":\"uint32\",\"indexed\":false,\"internalType\":\"uint32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"validatorSlashed\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false}]",
+}
+
+// SlashIndicatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use SlashIndicatorMetaData.ABI instead.
+var SlashIndicatorABI = SlashIndicatorMetaData.ABI
+
+// SlashIndicator is an auto generated Go binding around an Ethereum contract.
+type SlashIndicator struct {
+	SlashIndicatorCaller     // Read-only binding to the contract
+	SlashIndicatorTransactor // Write-only binding to the contract
+	SlashIndicatorFilterer   // Log filterer for contract events
+}
+
+// SlashIndicatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SlashIndicatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SlashIndicatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SlashIndicatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SlashIndicatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SlashIndicatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SlashIndicatorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SlashIndicatorSession struct {
+	Contract     *SlashIndicator   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SlashIndicatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SlashIndicatorCallerSession struct {
+	Contract *SlashIndicatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// SlashIndicatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SlashIndicatorTransactorSession struct {
+	Contract     *SlashIndicatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// SlashIndicatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SlashIndicatorRaw struct {
+	Contract *SlashIndicator // Generic contract binding to access the raw methods on
+}
+
+// SlashIndicatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SlashIndicatorCallerRaw struct {
+	Contract *SlashIndicatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SlashIndicatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SlashIndicatorTransactorRaw struct {
+	Contract *SlashIndicatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSlashIndicator creates a new instance of SlashIndicator, bound to a specific deployed contract.
+func NewSlashIndicator(address common.Address, backend bind.ContractBackend) (*SlashIndicator, error) {
+	contract, err := bindSlashIndicator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicator{SlashIndicatorCaller: SlashIndicatorCaller{contract: contract}, SlashIndicatorTransactor: SlashIndicatorTransactor{contract: contract}, SlashIndicatorFilterer: SlashIndicatorFilterer{contract: contract}}, nil
+}
+
+// NewSlashIndicatorCaller creates a new read-only instance of SlashIndicator, bound to a specific deployed contract.
+func NewSlashIndicatorCaller(address common.Address, caller bind.ContractCaller) (*SlashIndicatorCaller, error) {
+	contract, err := bindSlashIndicator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorCaller{contract: contract}, nil
+}
+
+// NewSlashIndicatorTransactor creates a new write-only instance of SlashIndicator, bound to a specific deployed contract.
+func NewSlashIndicatorTransactor(address common.Address, transactor bind.ContractTransactor) (*SlashIndicatorTransactor, error) {
+	contract, err := bindSlashIndicator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorTransactor{contract: contract}, nil
+}
+
+// NewSlashIndicatorFilterer creates a new log filterer instance of SlashIndicator, bound to a specific deployed contract.
+func NewSlashIndicatorFilterer(address common.Address, filterer bind.ContractFilterer) (*SlashIndicatorFilterer, error) {
+	contract, err := bindSlashIndicator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorFilterer{contract: contract}, nil
+}
+
+// bindSlashIndicator binds a generic wrapper to an already deployed contract.
+func bindSlashIndicator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SlashIndicatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SlashIndicator *SlashIndicatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SlashIndicator.Contract.SlashIndicatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SlashIndicator *SlashIndicatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SlashIndicatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SlashIndicator *SlashIndicatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SlashIndicatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SlashIndicator *SlashIndicatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SlashIndicator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SlashIndicator *SlashIndicatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SlashIndicator *SlashIndicatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.contract.Transact(opts, method, params...)
+}
+
+// BCFUSIONCHANNELID is a free data retrieval call binding the contract method 0xf1fad104.
+//
+// Solidity: function BC_FUSION_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) BCFUSIONCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "BC_FUSION_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// BCFUSIONCHANNELID is a free data retrieval call binding the contract method 0xf1fad104.
+//
+// Solidity: function BC_FUSION_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) BCFUSIONCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.BCFUSIONCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// BCFUSIONCHANNELID is a free data retrieval call binding the contract method 0xf1fad104.
+//
+// Solidity: function BC_FUSION_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) BCFUSIONCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.BCFUSIONCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// BINDCHANNELID is a free data retrieval call binding the contract method 0x3dffc387.
+//
+// Solidity: function BIND_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) BINDCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "BIND_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// BINDCHANNELID is a free data retrieval call binding the contract method 0x3dffc387.
+//
+// Solidity: function BIND_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) BINDCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.BINDCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// BINDCHANNELID is a free data retrieval call binding the contract method 0x3dffc387.
+//
+// Solidity: function BIND_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) BINDCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.BINDCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// CODEOK is a free data retrieval call binding the contract method 0xab51bb96.
+//
+// Solidity: function CODE_OK() view returns(uint32)
+func (_SlashIndicator *SlashIndicatorCaller) CODEOK(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "CODE_OK")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// CODEOK is a free data retrieval call binding the contract method 0xab51bb96.
+//
+// Solidity: function CODE_OK() view returns(uint32)
+func (_SlashIndicator *SlashIndicatorSession) CODEOK() (uint32, error) {
+	return _SlashIndicator.Contract.CODEOK(&_SlashIndicator.CallOpts)
+}
+
+// CODEOK is a free data retrieval call binding the contract method 0xab51bb96.
+//
+// Solidity: function CODE_OK() view returns(uint32)
+func (_SlashIndicator *SlashIndicatorCallerSession) CODEOK() (uint32, error) {
+	return _SlashIndicator.Contract.CODEOK(&_SlashIndicator.CallOpts)
+}
+
+// CROSSCHAINCONTRACTADDR is a free data retrieval call binding the contract method 0x51e80672.
+//
+// Solidity: function CROSS_CHAIN_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) CROSSCHAINCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "CROSS_CHAIN_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CROSSCHAINCONTRACTADDR is a free data retrieval call binding the contract method 0x51e80672.
+//
+// Solidity: function CROSS_CHAIN_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) CROSSCHAINCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.CROSSCHAINCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// CROSSCHAINCONTRACTADDR is a free data retrieval call binding the contract method 0x51e80672.
+//
+// Solidity: function CROSS_CHAIN_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) CROSSCHAINCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.CROSSCHAINCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// CROSSSTAKECHANNELID is a free data retrieval call binding the contract method 0x718a8aa8.
+//
+// Solidity: function CROSS_STAKE_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) CROSSSTAKECHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "CROSS_STAKE_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// CROSSSTAKECHANNELID is a free data retrieval call binding the contract method 0x718a8aa8.
+//
+// Solidity: function CROSS_STAKE_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) CROSSSTAKECHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.CROSSSTAKECHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// CROSSSTAKECHANNELID is a free data retrieval call binding the contract method 0x718a8aa8.
+//
+// Solidity: function CROSS_STAKE_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) CROSSSTAKECHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.CROSSSTAKECHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// DECREASERATE is a free data retrieval call binding the contract method 0xac0af629.
+//
+// Solidity: function DECREASE_RATE() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) DECREASERATE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "DECREASE_RATE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DECREASERATE is a free data retrieval call binding the contract method 0xac0af629.
+//
+// Solidity: function DECREASE_RATE() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) DECREASERATE() (*big.Int, error) {
+	return _SlashIndicator.Contract.DECREASERATE(&_SlashIndicator.CallOpts)
+}
+
+// DECREASERATE is a free data retrieval call binding the contract method 0xac0af629.
+//
+// Solidity: function DECREASE_RATE() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) DECREASERATE() (*big.Int, error) {
+	return _SlashIndicator.Contract.DECREASERATE(&_SlashIndicator.CallOpts)
+}
+
+// ERRORFAILDECODE is a free data retrieval call binding the contract method 0x0bee7a67.
+//
+// Solidity: function ERROR_FAIL_DECODE() view returns(uint32)
+func (_SlashIndicator *SlashIndicatorCaller) ERRORFAILDECODE(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "ERROR_FAIL_DECODE")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// ERRORFAILDECODE is a free data retrieval call binding the contract method 0x0bee7a67.
+//
+// Solidity: function ERROR_FAIL_DECODE() view returns(uint32)
+func (_SlashIndicator *SlashIndicatorSession) ERRORFAILDECODE() (uint32, error) {
+	return _SlashIndicator.Contract.ERRORFAILDECODE(&_SlashIndicator.CallOpts)
+}
+
+// ERRORFAILDECODE is a free data retrieval call binding the contract method 0x0bee7a67.
+//
+// Solidity: function ERROR_FAIL_DECODE() view returns(uint32)
+func (_SlashIndicator *SlashIndicatorCallerSession) ERRORFAILDECODE() (uint32, error) {
+	return _SlashIndicator.Contract.ERRORFAILDECODE(&_SlashIndicator.CallOpts)
+}
+
+// FELONYTHRESHOLD is a free data retrieval call binding the contract method 0xc80d4b8f.
+//
+// Solidity: function FELONY_THRESHOLD() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) FELONYTHRESHOLD(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "FELONY_THRESHOLD")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FELONYTHRESHOLD is a free data retrieval call binding the contract method 0xc80d4b8f.
+//
+// Solidity: function FELONY_THRESHOLD() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) FELONYTHRESHOLD() (*big.Int, error) {
+	return _SlashIndicator.Contract.FELONYTHRESHOLD(&_SlashIndicator.CallOpts)
+}
+
+// FELONYTHRESHOLD is a free data retrieval call binding the contract method 0xc80d4b8f.
+//
+// Solidity: function FELONY_THRESHOLD() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) FELONYTHRESHOLD() (*big.Int, error) {
+	return _SlashIndicator.Contract.FELONYTHRESHOLD(&_SlashIndicator.CallOpts)
+}
+
+// GOVERNORADDR is a free data retrieval call binding the contract method 0xdf8079e9.
+//
+// Solidity: function GOVERNOR_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) GOVERNORADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "GOVERNOR_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GOVERNORADDR is a free data retrieval call binding the contract method 0xdf8079e9.
+//
+// Solidity: function GOVERNOR_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) GOVERNORADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.GOVERNORADDR(&_SlashIndicator.CallOpts)
+}
+
+// GOVERNORADDR is a free data retrieval call binding the contract method 0xdf8079e9.
+//
+// Solidity: function GOVERNOR_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) GOVERNORADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.GOVERNORADDR(&_SlashIndicator.CallOpts)
+}
+
+// GOVCHANNELID is a free data retrieval call binding the contract method 0x96713da9.
+//
+// Solidity: function GOV_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) GOVCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "GOV_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GOVCHANNELID is a free data retrieval call binding the contract method 0x96713da9.
+//
+// Solidity: function GOV_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) GOVCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.GOVCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// GOVCHANNELID is a free data retrieval call binding the contract method 0x96713da9.
+//
+// Solidity: function GOV_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) GOVCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.GOVCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// GOVHUBADDR is a free data retrieval call binding the contract method 0x9dc09262.
+//
+// Solidity: function GOV_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) GOVHUBADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "GOV_HUB_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GOVHUBADDR is a free data retrieval call binding the contract method 0x9dc09262.
+//
+// Solidity: function GOV_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) GOVHUBADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.GOVHUBADDR(&_SlashIndicator.CallOpts)
+}
+
+// GOVHUBADDR is a free data retrieval call binding the contract method 0x9dc09262.
+//
+// Solidity: function GOV_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) GOVHUBADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.GOVHUBADDR(&_SlashIndicator.CallOpts)
+}
+
+// GOVTOKENADDR is a free data retrieval call binding the contract method 0x28087028.
+//
+// Solidity: function GOV_TOKEN_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) GOVTOKENADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "GOV_TOKEN_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GOVTOKENADDR is a free data retrieval call binding the contract method 0x28087028.
+//
+// Solidity: function GOV_TOKEN_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) GOVTOKENADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.GOVTOKENADDR(&_SlashIndicator.CallOpts)
+}
+
+// GOVTOKENADDR is a free data retrieval call binding the contract method 0x28087028.
+//
+// Solidity: function GOV_TOKEN_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) GOVTOKENADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.GOVTOKENADDR(&_SlashIndicator.CallOpts)
+}
+
+// INCENTIVIZEADDR is a free data retrieval call binding the contract method 0x6e47b482.
+//
+// Solidity: function INCENTIVIZE_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) INCENTIVIZEADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "INCENTIVIZE_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// INCENTIVIZEADDR is a free data retrieval call binding the contract method 0x6e47b482.
+//
+// Solidity: function INCENTIVIZE_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) INCENTIVIZEADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.INCENTIVIZEADDR(&_SlashIndicator.CallOpts)
+}
+
+// INCENTIVIZEADDR is a free data retrieval call binding the contract method 0x6e47b482.
+//
+// Solidity: function INCENTIVIZE_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) INCENTIVIZEADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.INCENTIVIZEADDR(&_SlashIndicator.CallOpts)
+}
+
+// INITFELONYSLASHREWARDRATIO is a free data retrieval call binding the contract method 0xdcc6f156.
+//
+// Solidity: function INIT_FELONY_SLASH_REWARD_RATIO() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) INITFELONYSLASHREWARDRATIO(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "INIT_FELONY_SLASH_REWARD_RATIO")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// INITFELONYSLASHREWARDRATIO is a free data retrieval call binding the contract method 0xdcc6f156.
+//
+// Solidity: function INIT_FELONY_SLASH_REWARD_RATIO() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) INITFELONYSLASHREWARDRATIO() (*big.Int, error) {
+	return _SlashIndicator.Contract.INITFELONYSLASHREWARDRATIO(&_SlashIndicator.CallOpts)
+}
+
+// INITFELONYSLASHREWARDRATIO is a free data retrieval call binding the contract method 0xdcc6f156.
+//
+// Solidity: function INIT_FELONY_SLASH_REWARD_RATIO() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) INITFELONYSLASHREWARDRATIO() (*big.Int, error) {
+	return _SlashIndicator.Contract.INITFELONYSLASHREWARDRATIO(&_SlashIndicator.CallOpts)
+}
+
+// INITFELONYSLASHSCOPE is a free data retrieval call binding the contract method 0x3306ccca.
+//
+// Solidity: function INIT_FELONY_SLASH_SCOPE() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) INITFELONYSLASHSCOPE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "INIT_FELONY_SLASH_SCOPE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// INITFELONYSLASHSCOPE is a free data retrieval call binding the contract method 0x3306ccca.
+//
+// Solidity: function INIT_FELONY_SLASH_SCOPE() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) INITFELONYSLASHSCOPE() (*big.Int, error) {
+	return _SlashIndicator.Contract.INITFELONYSLASHSCOPE(&_SlashIndicator.CallOpts)
+}
+
+// INITFELONYSLASHSCOPE is a free data retrieval call binding the contract method 0x3306ccca.
+//
+// Solidity: function INIT_FELONY_SLASH_SCOPE() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) INITFELONYSLASHSCOPE() (*big.Int, error) {
+	return _SlashIndicator.Contract.INITFELONYSLASHSCOPE(&_SlashIndicator.CallOpts)
+}
+
+// LIGHTCLIENTADDR is a free data retrieval call binding the contract method 0xdc927faf.
+//
+// Solidity: function LIGHT_CLIENT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) LIGHTCLIENTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "LIGHT_CLIENT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LIGHTCLIENTADDR is a free data retrieval call binding the contract method 0xdc927faf.
+//
+// Solidity: function LIGHT_CLIENT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) LIGHTCLIENTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.LIGHTCLIENTADDR(&_SlashIndicator.CallOpts)
+}
+
+// LIGHTCLIENTADDR is a free data retrieval call binding the contract method 0xdc927faf.
+//
+// Solidity: function LIGHT_CLIENT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) LIGHTCLIENTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.LIGHTCLIENTADDR(&_SlashIndicator.CallOpts)
+}
+
+// MISDEMEANORTHRESHOLD is a free data retrieval call binding the contract method 0x7912a65d.
+//
+// Solidity: function MISDEMEANOR_THRESHOLD() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) MISDEMEANORTHRESHOLD(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "MISDEMEANOR_THRESHOLD")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MISDEMEANORTHRESHOLD is a free data retrieval call binding the contract method 0x7912a65d.
+//
+// Solidity: function MISDEMEANOR_THRESHOLD() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) MISDEMEANORTHRESHOLD() (*big.Int, error) {
+	return _SlashIndicator.Contract.MISDEMEANORTHRESHOLD(&_SlashIndicator.CallOpts)
+}
+
+// MISDEMEANORTHRESHOLD is a free data retrieval call binding the contract method 0x7912a65d.
+//
+// Solidity: function MISDEMEANOR_THRESHOLD() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) MISDEMEANORTHRESHOLD() (*big.Int, error) {
+	return _SlashIndicator.Contract.MISDEMEANORTHRESHOLD(&_SlashIndicator.CallOpts)
+}
+
+// RELAYERHUBCONTRACTADDR is a free data retrieval call binding the contract method 0xa1a11bf5.
+//
+// Solidity: function RELAYERHUB_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) RELAYERHUBCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "RELAYERHUB_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RELAYERHUBCONTRACTADDR is a free data retrieval call binding the contract method 0xa1a11bf5.
+//
+// Solidity: function RELAYERHUB_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) RELAYERHUBCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.RELAYERHUBCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// RELAYERHUBCONTRACTADDR is a free data retrieval call binding the contract method 0xa1a11bf5.
+//
+// Solidity: function RELAYERHUB_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) RELAYERHUBCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.RELAYERHUBCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// SLASHCHANNELID is a free data retrieval call binding the contract method 0x7942fd05.
+//
+// Solidity: function SLASH_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) SLASHCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "SLASH_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// SLASHCHANNELID is a free data retrieval call binding the contract method 0x7942fd05.
+//
+// Solidity: function SLASH_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) SLASHCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.SLASHCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// SLASHCHANNELID is a free data retrieval call binding the contract method 0x7942fd05.
+//
+// Solidity: function SLASH_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) SLASHCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.SLASHCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// SLASHCONTRACTADDR is a free data retrieval call binding the contract method 0x43756e5c.
+//
+// Solidity: function SLASH_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) SLASHCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "SLASH_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SLASHCONTRACTADDR is a free data retrieval call binding the contract method 0x43756e5c.
+//
+// Solidity: function SLASH_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) SLASHCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.SLASHCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// SLASHCONTRACTADDR is a free data retrieval call binding the contract method 0x43756e5c.
+//
+// Solidity: function SLASH_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) SLASHCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.SLASHCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// STAKECREDITADDR is a free data retrieval call binding the contract method 0x7e434d54.
+//
+// Solidity: function STAKE_CREDIT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) STAKECREDITADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "STAKE_CREDIT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// STAKECREDITADDR is a free data retrieval call binding the contract method 0x7e434d54.
+//
+// Solidity: function STAKE_CREDIT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) STAKECREDITADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.STAKECREDITADDR(&_SlashIndicator.CallOpts)
+}
+
+// STAKECREDITADDR is a free data retrieval call binding the contract method 0x7e434d54.
+//
+// Solidity: function STAKE_CREDIT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) STAKECREDITADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.STAKECREDITADDR(&_SlashIndicator.CallOpts)
+}
+
+// STAKEHUBADDR is a free data retrieval call binding the contract method 0xaa82dce1.
+//
+// Solidity: function STAKE_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) STAKEHUBADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "STAKE_HUB_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// STAKEHUBADDR is a free data retrieval call binding the contract method 0xaa82dce1.
+//
+// Solidity: function STAKE_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) STAKEHUBADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.STAKEHUBADDR(&_SlashIndicator.CallOpts)
+}
+
+// STAKEHUBADDR is a free data retrieval call binding the contract method 0xaa82dce1.
+//
+// Solidity: function STAKE_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) STAKEHUBADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.STAKEHUBADDR(&_SlashIndicator.CallOpts)
+}
+
+// STAKINGCHANNELID is a free data retrieval call binding the contract method 0x4bf6c882.
+//
+// Solidity: function STAKING_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) STAKINGCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "STAKING_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// STAKINGCHANNELID is a free data retrieval call binding the contract method 0x4bf6c882.
+//
+// Solidity: function STAKING_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) STAKINGCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.STAKINGCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// STAKINGCHANNELID is a free data retrieval call binding the contract method 0x4bf6c882.
+//
+// Solidity: function STAKING_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) STAKINGCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.STAKINGCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// STAKINGCONTRACTADDR is a free data retrieval call binding the contract method 0x0e2374a5.
+//
+// Solidity: function STAKING_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) STAKINGCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "STAKING_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// STAKINGCONTRACTADDR is a free data retrieval call binding the contract method 0x0e2374a5.
+//
+// Solidity: function STAKING_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) STAKINGCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.STAKINGCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// STAKINGCONTRACTADDR is a free data retrieval call binding the contract method 0x0e2374a5.
+//
+// Solidity: function STAKING_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) STAKINGCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.STAKINGCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// SYSTEMREWARDADDR is a free data retrieval call binding the contract method 0xc81b1662.
+//
+// Solidity: function SYSTEM_REWARD_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) SYSTEMREWARDADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "SYSTEM_REWARD_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SYSTEMREWARDADDR is a free data retrieval call binding the contract method 0xc81b1662.
+//
+// Solidity: function SYSTEM_REWARD_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) SYSTEMREWARDADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.SYSTEMREWARDADDR(&_SlashIndicator.CallOpts)
+}
+
+// SYSTEMREWARDADDR is a free data retrieval call binding the contract method 0xc81b1662.
+//
+// Solidity: function SYSTEM_REWARD_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) SYSTEMREWARDADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.SYSTEMREWARDADDR(&_SlashIndicator.CallOpts)
+}
+
+// TIMELOCKADDR is a free data retrieval call binding the contract method 0x51b4dce3.
+//
+// Solidity: function TIMELOCK_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) TIMELOCKADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "TIMELOCK_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TIMELOCKADDR is a free data retrieval call binding the contract method 0x51b4dce3.
+//
+// Solidity: function TIMELOCK_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) TIMELOCKADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TIMELOCKADDR(&_SlashIndicator.CallOpts)
+}
+
+// TIMELOCKADDR is a free data retrieval call binding the contract method 0x51b4dce3.
+//
+// Solidity: function TIMELOCK_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) TIMELOCKADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TIMELOCKADDR(&_SlashIndicator.CallOpts)
+}
+
+// TOKENHUBADDR is a free data retrieval call binding the contract method 0xfd6a6879.
+//
+// Solidity: function TOKEN_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) TOKENHUBADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "TOKEN_HUB_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TOKENHUBADDR is a free data retrieval call binding the contract method 0xfd6a6879.
+//
+// Solidity: function TOKEN_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) TOKENHUBADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TOKENHUBADDR(&_SlashIndicator.CallOpts)
+}
+
+// TOKENHUBADDR is a free data retrieval call binding the contract method 0xfd6a6879.
+//
+// Solidity: function TOKEN_HUB_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) TOKENHUBADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TOKENHUBADDR(&_SlashIndicator.CallOpts)
+}
+
+// TOKENMANAGERADDR is a free data retrieval call binding the contract method 0x75d47a0a.
+//
+// Solidity: function TOKEN_MANAGER_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) TOKENMANAGERADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "TOKEN_MANAGER_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TOKENMANAGERADDR is a free data retrieval call binding the contract method 0x75d47a0a.
+//
+// Solidity: function TOKEN_MANAGER_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) TOKENMANAGERADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TOKENMANAGERADDR(&_SlashIndicator.CallOpts)
+}
+
+// TOKENMANAGERADDR is a free data retrieval call binding the contract method 0x75d47a0a.
+//
+// Solidity: function TOKEN_MANAGER_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) TOKENMANAGERADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TOKENMANAGERADDR(&_SlashIndicator.CallOpts)
+}
+
+// TOKENRECOVERPORTALADDR is a free data retrieval call binding the contract method 0xaad56063.
+//
+// Solidity: function TOKEN_RECOVER_PORTAL_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) TOKENRECOVERPORTALADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "TOKEN_RECOVER_PORTAL_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TOKENRECOVERPORTALADDR is a free data retrieval call binding the contract method 0xaad56063.
+//
+// Solidity: function TOKEN_RECOVER_PORTAL_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) TOKENRECOVERPORTALADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TOKENRECOVERPORTALADDR(&_SlashIndicator.CallOpts)
+}
+
+// TOKENRECOVERPORTALADDR is a free data retrieval call binding the contract method 0xaad56063.
+//
+// Solidity: function TOKEN_RECOVER_PORTAL_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) TOKENRECOVERPORTALADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.TOKENRECOVERPORTALADDR(&_SlashIndicator.CallOpts)
+}
+
+// TRANSFERINCHANNELID is a free data retrieval call binding the contract method 0x70fd5bad.
+//
+// Solidity: function TRANSFER_IN_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) TRANSFERINCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "TRANSFER_IN_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// TRANSFERINCHANNELID is a free data retrieval call binding the contract method 0x70fd5bad.
+//
+// Solidity: function TRANSFER_IN_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) TRANSFERINCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.TRANSFERINCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// TRANSFERINCHANNELID is a free data retrieval call binding the contract method 0x70fd5bad.
+//
+// Solidity: function TRANSFER_IN_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) TRANSFERINCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.TRANSFERINCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// TRANSFEROUTCHANNELID is a free data retrieval call binding the contract method 0xfc3e5908.
+//
+// Solidity: function TRANSFER_OUT_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCaller) TRANSFEROUTCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "TRANSFER_OUT_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// TRANSFEROUTCHANNELID is a free data retrieval call binding the contract method 0xfc3e5908.
+//
+// Solidity: function TRANSFER_OUT_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorSession) TRANSFEROUTCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.TRANSFEROUTCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// TRANSFEROUTCHANNELID is a free data retrieval call binding the contract method 0xfc3e5908.
+//
+// Solidity: function TRANSFER_OUT_CHANNELID() view returns(uint8)
+func (_SlashIndicator *SlashIndicatorCallerSession) TRANSFEROUTCHANNELID() (uint8, error) {
+	return _SlashIndicator.Contract.TRANSFEROUTCHANNELID(&_SlashIndicator.CallOpts)
+}
+
+// VALIDATORCONTRACTADDR is a free data retrieval call binding the contract method 0xf9a2bbc7.
+//
+// Solidity: function VALIDATOR_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) VALIDATORCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "VALIDATOR_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VALIDATORCONTRACTADDR is a free data retrieval call binding the contract method 0xf9a2bbc7.
+//
+// Solidity: function VALIDATOR_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) VALIDATORCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.VALIDATORCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// VALIDATORCONTRACTADDR is a free data retrieval call binding the contract method 0xf9a2bbc7.
+//
+// Solidity: function VALIDATOR_CONTRACT_ADDR() view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) VALIDATORCONTRACTADDR() (common.Address, error) {
+	return _SlashIndicator.Contract.VALIDATORCONTRACTADDR(&_SlashIndicator.CallOpts)
+}
+
+// AlreadyInit is a free data retrieval call binding the contract method 0xa78abc16.
+//
+// Solidity: function alreadyInit() view returns(bool)
+func (_SlashIndicator *SlashIndicatorCaller) AlreadyInit(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "alreadyInit")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AlreadyInit is a free data retrieval call binding the contract method 0xa78abc16.
+//
+// Solidity: function alreadyInit() view returns(bool)
+func (_SlashIndicator *SlashIndicatorSession) AlreadyInit() (bool, error) {
+	return _SlashIndicator.Contract.AlreadyInit(&_SlashIndicator.CallOpts)
+}
+
+// AlreadyInit is a free data retrieval call binding the contract method 0xa78abc16.
+//
+// Solidity: function alreadyInit() view returns(bool)
+func (_SlashIndicator *SlashIndicatorCallerSession) AlreadyInit() (bool, error) {
+	return _SlashIndicator.Contract.AlreadyInit(&_SlashIndicator.CallOpts)
+}
+
+// BscChainID is a free data retrieval call binding the contract method 0x493279b1.
+//
+// Solidity: function bscChainID() view returns(uint16)
+func (_SlashIndicator *SlashIndicatorCaller) BscChainID(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "bscChainID")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// BscChainID is a free data retrieval call binding the contract method 0x493279b1.
+//
+// Solidity: function bscChainID() view returns(uint16)
+func (_SlashIndicator *SlashIndicatorSession) BscChainID() (uint16, error) {
+	return _SlashIndicator.Contract.BscChainID(&_SlashIndicator.CallOpts)
+}
+
+// BscChainID is a free data retrieval call binding the contract method 0x493279b1.
+//
+// Solidity: function bscChainID() view returns(uint16)
+func (_SlashIndicator *SlashIndicatorCallerSession) BscChainID() (uint16, error) {
+	return _SlashIndicator.Contract.BscChainID(&_SlashIndicator.CallOpts)
+}
+
+// EnableMaliciousVoteSlash is a free data retrieval call binding the contract method 0x22d1e80b.
+//
+// Solidity: function enableMaliciousVoteSlash() view returns(bool)
+func (_SlashIndicator *SlashIndicatorCaller) EnableMaliciousVoteSlash(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "enableMaliciousVoteSlash")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// EnableMaliciousVoteSlash is a free data retrieval call binding the contract method 0x22d1e80b.
+//
+// Solidity: function enableMaliciousVoteSlash() view returns(bool)
+func (_SlashIndicator *SlashIndicatorSession) EnableMaliciousVoteSlash() (bool, error) {
+	return _SlashIndicator.Contract.EnableMaliciousVoteSlash(&_SlashIndicator.CallOpts)
+}
+
+// EnableMaliciousVoteSlash is a free data retrieval call binding the contract method 0x22d1e80b.
+//
+// Solidity: function enableMaliciousVoteSlash() view returns(bool)
+func (_SlashIndicator *SlashIndicatorCallerSession) EnableMaliciousVoteSlash() (bool, error) {
+	return _SlashIndicator.Contract.EnableMaliciousVoteSlash(&_SlashIndicator.CallOpts)
+}
+
+// FelonySlashRewardRatio is a free data retrieval call binding the contract method 0x50055f90.
+//
+// Solidity: function felonySlashRewardRatio() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) FelonySlashRewardRatio(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "felonySlashRewardRatio")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FelonySlashRewardRatio is a free data retrieval call binding the contract method 0x50055f90.
+//
+// Solidity: function felonySlashRewardRatio() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) FelonySlashRewardRatio() (*big.Int, error) {
+	return _SlashIndicator.Contract.FelonySlashRewardRatio(&_SlashIndicator.CallOpts)
+}
+
+// FelonySlashRewardRatio is a free data retrieval call binding the contract method 0x50055f90.
+//
+// Solidity: function felonySlashRewardRatio() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) FelonySlashRewardRatio() (*big.Int, error) {
+	return _SlashIndicator.Contract.FelonySlashRewardRatio(&_SlashIndicator.CallOpts)
+}
+
+// FelonySlashScope is a free data retrieval call binding the contract method 0x9f804f5f.
+//
+// Solidity: function felonySlashScope() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) FelonySlashScope(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "felonySlashScope")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FelonySlashScope is a free data retrieval call binding the contract method 0x9f804f5f.
+//
+// Solidity: function felonySlashScope() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) FelonySlashScope() (*big.Int, error) {
+	return _SlashIndicator.Contract.FelonySlashScope(&_SlashIndicator.CallOpts)
+}
+
+// FelonySlashScope is a free data retrieval call binding the contract method 0x9f804f5f.
+//
+// Solidity: function felonySlashScope() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) FelonySlashScope() (*big.Int, error) {
+	return _SlashIndicator.Contract.FelonySlashScope(&_SlashIndicator.CallOpts)
+}
+
+// FelonyThreshold is a free data retrieval call binding the contract method 0x389f4f71.
+//
+// Solidity: function felonyThreshold() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) FelonyThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "felonyThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FelonyThreshold is a free data retrieval call binding the contract method 0x389f4f71.
+//
+// Solidity: function felonyThreshold() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) FelonyThreshold() (*big.Int, error) {
+	return _SlashIndicator.Contract.FelonyThreshold(&_SlashIndicator.CallOpts)
+}
+
+// FelonyThreshold is a free data retrieval call binding the contract method 0x389f4f71.
+//
+// Solidity: function felonyThreshold() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) FelonyThreshold() (*big.Int, error) {
+	return _SlashIndicator.Contract.FelonyThreshold(&_SlashIndicator.CallOpts)
+}
+
+// GetSlashIndicator is a free data retrieval call binding the contract method 0x37c8dab9.
+//
+// Solidity: function getSlashIndicator(address validator) view returns(uint256, uint256)
+func (_SlashIndicator *SlashIndicatorCaller) GetSlashIndicator(opts *bind.CallOpts, validator common.Address) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "getSlashIndicator", validator)
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetSlashIndicator is a free data retrieval call binding the contract method 0x37c8dab9.
+//
+// Solidity: function getSlashIndicator(address validator) view returns(uint256, uint256)
+func (_SlashIndicator *SlashIndicatorSession) GetSlashIndicator(validator common.Address) (*big.Int, *big.Int, error) {
+	return _SlashIndicator.Contract.GetSlashIndicator(&_SlashIndicator.CallOpts, validator)
+}
+
+// GetSlashIndicator is a free data retrieval call binding the contract method 0x37c8dab9.
+//
+// Solidity: function getSlashIndicator(address validator) view returns(uint256, uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) GetSlashIndicator(validator common.Address) (*big.Int, *big.Int, error) {
+	return _SlashIndicator.Contract.GetSlashIndicator(&_SlashIndicator.CallOpts, validator)
+}
+
+// GetSlashThresholds is a free data retrieval call binding the contract method 0x8256ace6.
+//
+// Solidity: function getSlashThresholds() view returns(uint256, uint256)
+func (_SlashIndicator *SlashIndicatorCaller) GetSlashThresholds(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "getSlashThresholds")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetSlashThresholds is a free data retrieval call binding the contract method 0x8256ace6.
+//
+// Solidity: function getSlashThresholds() view returns(uint256, uint256)
+func (_SlashIndicator *SlashIndicatorSession) GetSlashThresholds() (*big.Int, *big.Int, error) {
+	return _SlashIndicator.Contract.GetSlashThresholds(&_SlashIndicator.CallOpts)
+}
+
+// GetSlashThresholds is a free data retrieval call binding the contract method 0x8256ace6.
+//
+// Solidity: function getSlashThresholds() view returns(uint256, uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) GetSlashThresholds() (*big.Int, *big.Int, error) {
+	return _SlashIndicator.Contract.GetSlashThresholds(&_SlashIndicator.CallOpts)
+}
+
+// Indicators is a free data retrieval call binding the contract method 0x23bac5a2.
+//
+// Solidity: function indicators(address ) view returns(uint256 height, uint256 count, bool exist)
+func (_SlashIndicator *SlashIndicatorCaller) Indicators(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Height *big.Int
+	Count  *big.Int
+	Exist  bool
+}, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "indicators", arg0)
+
+	outstruct := new(struct {
+		Height *big.Int
+		Count  *big.Int
+		Exist  bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Height = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Count = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Exist = *abi.ConvertType(out[2], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Indicators is a free data retrieval call binding the contract method 0x23bac5a2.
+//
+// Solidity: function indicators(address ) view returns(uint256 height, uint256 count, bool exist)
+func (_SlashIndicator *SlashIndicatorSession) Indicators(arg0 common.Address) (struct {
+	Height *big.Int
+	Count  *big.Int
+	Exist  bool
+}, error) {
+	return _SlashIndicator.Contract.Indicators(&_SlashIndicator.CallOpts, arg0)
+}
+
+// Indicators is a free data retrieval call binding the contract method 0x23bac5a2.
+//
+// Solidity: function indicators(address ) view returns(uint256 height, uint256 count, bool exist)
+func (_SlashIndicator *SlashIndicatorCallerSession) Indicators(arg0 common.Address) (struct {
+	Height *big.Int
+	Count  *big.Int
+	Exist  bool
+}, error) {
+	return _SlashIndicator.Contract.Indicators(&_SlashIndicator.CallOpts, arg0)
+}
+
+// MisdemeanorThreshold is a free data retrieval call binding the contract method 0x567a372d.
+//
+// Solidity: function misdemeanorThreshold() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) MisdemeanorThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "misdemeanorThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MisdemeanorThreshold is a free data retrieval call binding the contract method 0x567a372d.
+//
+// Solidity: function misdemeanorThreshold() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) MisdemeanorThreshold() (*big.Int, error) {
+	return _SlashIndicator.Contract.MisdemeanorThreshold(&_SlashIndicator.CallOpts)
+}
+
+// MisdemeanorThreshold is a free data retrieval call binding the contract method 0x567a372d.
+//
+// Solidity: function misdemeanorThreshold() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) MisdemeanorThreshold() (*big.Int, error) {
+	return _SlashIndicator.Contract.MisdemeanorThreshold(&_SlashIndicator.CallOpts)
+}
+
+// PreviousHeight is a free data retrieval call binding the contract method 0x62b72cf5.
+//
+// Solidity: function previousHeight() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCaller) PreviousHeight(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "previousHeight")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviousHeight is a free data retrieval call binding the contract method 0x62b72cf5.
+//
+// Solidity: function previousHeight() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorSession) PreviousHeight() (*big.Int, error) {
+	return _SlashIndicator.Contract.PreviousHeight(&_SlashIndicator.CallOpts)
+}
+
+// PreviousHeight is a free data retrieval call binding the contract method 0x62b72cf5.
+//
+// Solidity: function previousHeight() view returns(uint256)
+func (_SlashIndicator *SlashIndicatorCallerSession) PreviousHeight() (*big.Int, error) {
+	return _SlashIndicator.Contract.PreviousHeight(&_SlashIndicator.CallOpts)
+}
+
+// Validators is a free data retrieval call binding the contract method 0x35aa2e44.
+//
+// Solidity: function validators(uint256 ) view returns(address)
+func (_SlashIndicator *SlashIndicatorCaller) Validators(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _SlashIndicator.contract.Call(opts, &out, "validators", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Validators is a free data retrieval call binding the contract method 0x35aa2e44.
+//
+// Solidity: function validators(uint256 ) view returns(address)
+func (_SlashIndicator *SlashIndicatorSession) Validators(arg0 *big.Int) (common.Address, error) {
+	return _SlashIndicator.Contract.Validators(&_SlashIndicator.CallOpts, arg0)
+}
+
+// Validators is a free data retrieval call binding the contract method 0x35aa2e44.
+//
+// Solidity: function validators(uint256 ) view returns(address)
+func (_SlashIndicator *SlashIndicatorCallerSession) Validators(arg0 *big.Int) (common.Address, error) {
+	return _SlashIndicator.Contract.Validators(&_SlashIndicator.CallOpts, arg0)
+}
+
+// Clean is a paid mutator transaction binding the contract method 0xfc4333cd.
+//
+// Solidity: function clean() returns()
+func (_SlashIndicator *SlashIndicatorTransactor) Clean(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "clean")
+}
+
+// Clean is a paid mutator transaction binding the contract method 0xfc4333cd.
+//
+// Solidity: function clean() returns()
+func (_SlashIndicator *SlashIndicatorSession) Clean() (*types.Transaction, error) {
+	return _SlashIndicator.Contract.Clean(&_SlashIndicator.TransactOpts)
+}
+
+// Clean is a paid mutator transaction binding the contract method 0xfc4333cd.
+//
+// Solidity: function clean() returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) Clean() (*types.Transaction, error) {
+	return _SlashIndicator.Contract.Clean(&_SlashIndicator.TransactOpts)
+}
+
+// DowntimeSlash is a paid mutator transaction binding the contract method 0x9e89ded8.
+//
+// Solidity: function downtimeSlash(address validator, uint256 count) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) DowntimeSlash(opts *bind.TransactOpts, validator common.Address, count *big.Int) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "downtimeSlash", validator, count)
+}
+
+// DowntimeSlash is a paid mutator transaction binding the contract method 0x9e89ded8.
+//
+// Solidity: function downtimeSlash(address validator, uint256 count) returns()
+func (_SlashIndicator *SlashIndicatorSession) DowntimeSlash(validator common.Address, count *big.Int) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.DowntimeSlash(&_SlashIndicator.TransactOpts, validator, count)
+}
+
+// DowntimeSlash is a paid mutator transaction binding the contract method 0x9e89ded8.
+//
+// Solidity: function downtimeSlash(address validator, uint256 count) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) DowntimeSlash(validator common.Address, count *big.Int) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.DowntimeSlash(&_SlashIndicator.TransactOpts, validator, count)
+}
+
+// HandleAckPackage is a paid mutator transaction binding the contract method 0x831d65d1.
+//
+// Solidity: function handleAckPackage(uint8 , bytes msgBytes) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) HandleAckPackage(opts *bind.TransactOpts, arg0 uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "handleAckPackage", arg0, msgBytes)
+}
+
+// HandleAckPackage is a paid mutator transaction binding the contract method 0x831d65d1.
+//
+// Solidity: function handleAckPackage(uint8 , bytes msgBytes) returns()
+func (_SlashIndicator *SlashIndicatorSession) HandleAckPackage(arg0 uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.HandleAckPackage(&_SlashIndicator.TransactOpts, arg0, msgBytes)
+}
+
+// HandleAckPackage is a paid mutator transaction binding the contract method 0x831d65d1.
+//
+// Solidity: function handleAckPackage(uint8 , bytes msgBytes) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) HandleAckPackage(arg0 uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.HandleAckPackage(&_SlashIndicator.TransactOpts, arg0, msgBytes)
+}
+
+// HandleFailAckPackage is a paid mutator transaction binding the contract method 0xc8509d81.
+//
+// Solidity: function handleFailAckPackage(uint8 , bytes ) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) HandleFailAckPackage(opts *bind.TransactOpts, arg0 uint8, arg1 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "handleFailAckPackage", arg0, arg1)
+}
+
+// HandleFailAckPackage is a paid mutator transaction binding the contract method 0xc8509d81.
+//
+// Solidity: function handleFailAckPackage(uint8 , bytes ) returns()
+func (_SlashIndicator *SlashIndicatorSession) HandleFailAckPackage(arg0 uint8, arg1 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.HandleFailAckPackage(&_SlashIndicator.TransactOpts, arg0, arg1)
+}
+
+// HandleFailAckPackage is a paid mutator transaction binding the contract method 0xc8509d81.
+//
+// Solidity: function handleFailAckPackage(uint8 , bytes ) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) HandleFailAckPackage(arg0 uint8, arg1 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.HandleFailAckPackage(&_SlashIndicator.TransactOpts, arg0, arg1)
+}
+
+// HandleSynPackage is a paid mutator transaction binding the contract method 0x1182b875.
+//
+// Solidity: function handleSynPackage(uint8 , bytes ) returns(bytes)
+func (_SlashIndicator *SlashIndicatorTransactor) HandleSynPackage(opts *bind.TransactOpts, arg0 uint8, arg1 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "handleSynPackage", arg0, arg1)
+}
+
+// HandleSynPackage is a paid mutator transaction binding the contract method 0x1182b875.
+//
+// Solidity: function handleSynPackage(uint8 , bytes ) returns(bytes)
+func (_SlashIndicator *SlashIndicatorSession) HandleSynPackage(arg0 uint8, arg1 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.HandleSynPackage(&_SlashIndicator.TransactOpts, arg0, arg1)
+}
+
+// HandleSynPackage is a paid mutator transaction binding the contract method 0x1182b875.
+//
+// Solidity: function handleSynPackage(uint8 , bytes ) returns(bytes)
+func (_SlashIndicator *SlashIndicatorTransactorSession) HandleSynPackage(arg0 uint8, arg1 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.HandleSynPackage(&_SlashIndicator.TransactOpts, arg0, arg1)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_SlashIndicator *SlashIndicatorTransactor) Init(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "init")
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_SlashIndicator *SlashIndicatorSession) Init() (*types.Transaction, error) {
+	return _SlashIndicator.Contract.Init(&_SlashIndicator.TransactOpts)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) Init() (*types.Transaction, error) {
+	return _SlashIndicator.Contract.Init(&_SlashIndicator.TransactOpts)
+}
+
+// SendFelonyPackage is a paid mutator transaction binding the contract method 0x5bfb4990.
+//
+// Solidity: function sendFelonyPackage(address validator) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) SendFelonyPackage(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "sendFelonyPackage", validator)
+}
+
+// SendFelonyPackage is a paid mutator transaction binding the contract method 0x5bfb4990.
+//
+// Solidity: function sendFelonyPackage(address validator) returns()
+func (_SlashIndicator *SlashIndicatorSession) SendFelonyPackage(validator common.Address) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SendFelonyPackage(&_SlashIndicator.TransactOpts, validator)
+}
+
+// SendFelonyPackage is a paid mutator transaction binding the contract method 0x5bfb4990.
+//
+// Solidity: function sendFelonyPackage(address validator) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) SendFelonyPackage(validator common.Address) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SendFelonyPackage(&_SlashIndicator.TransactOpts, validator)
+}
+
+// Slash is a paid mutator transaction binding the contract method 0xc96be4cb.
+//
+// Solidity: function slash(address validator) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) Slash(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "slash", validator)
+}
+
+// Slash is a paid mutator transaction binding the contract method 0xc96be4cb.
+//
+// Solidity: function slash(address validator) returns()
+func (_SlashIndicator *SlashIndicatorSession) Slash(validator common.Address) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.Slash(&_SlashIndicator.TransactOpts, validator)
+}
+
+// Slash is a paid mutator transaction binding the contract method 0xc96be4cb.
+//
+// Solidity: function slash(address validator) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) Slash(validator common.Address) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.Slash(&_SlashIndicator.TransactOpts, validator)
+}
+
+// SubmitDoubleSignEvidence is a paid mutator transaction binding the contract method 0x27962118.
+//
+// Solidity: function submitDoubleSignEvidence(bytes header1, bytes header2) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) SubmitDoubleSignEvidence(opts *bind.TransactOpts, header1 []byte, header2 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "submitDoubleSignEvidence", header1, header2)
+}
+
+// SubmitDoubleSignEvidence is a paid mutator transaction binding the contract method 0x27962118.
+//
+// Solidity: function submitDoubleSignEvidence(bytes header1, bytes header2) returns()
+func (_SlashIndicator *SlashIndicatorSession) SubmitDoubleSignEvidence(header1 []byte, header2 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SubmitDoubleSignEvidence(&_SlashIndicator.TransactOpts, header1, header2)
+}
+
+// SubmitDoubleSignEvidence is a paid mutator transaction binding the contract method 0x27962118.
+//
+// Solidity: function submitDoubleSignEvidence(bytes header1, bytes header2) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) SubmitDoubleSignEvidence(header1 []byte, header2 []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SubmitDoubleSignEvidence(&_SlashIndicator.TransactOpts, header1, header2)
+}
+
+// SubmitFinalityViolationEvidence is a paid mutator transaction binding the contract method 0xcc844b73.
+//
+// Solidity: function submitFinalityViolationEvidence(((uint256,bytes32,uint256,bytes32,bytes),(uint256,bytes32,uint256,bytes32,bytes),bytes) _evidence) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) SubmitFinalityViolationEvidence(opts *bind.TransactOpts, _evidence SlashIndicatorFinalityEvidence) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "submitFinalityViolationEvidence", _evidence)
+}
+
+// SubmitFinalityViolationEvidence is a paid mutator transaction binding the contract method 0xcc844b73.
+//
+// Solidity: function submitFinalityViolationEvidence(((uint256,bytes32,uint256,bytes32,bytes),(uint256,bytes32,uint256,bytes32,bytes),bytes) _evidence) returns()
+func (_SlashIndicator *SlashIndicatorSession) SubmitFinalityViolationEvidence(_evidence SlashIndicatorFinalityEvidence) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SubmitFinalityViolationEvidence(&_SlashIndicator.TransactOpts, _evidence)
+}
+
+// SubmitFinalityViolationEvidence is a paid mutator transaction binding the contract method 0xcc844b73.
+//
+// Solidity: function submitFinalityViolationEvidence(((uint256,bytes32,uint256,bytes32,bytes),(uint256,bytes32,uint256,bytes32,bytes),bytes) _evidence) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) SubmitFinalityViolationEvidence(_evidence SlashIndicatorFinalityEvidence) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.SubmitFinalityViolationEvidence(&_SlashIndicator.TransactOpts, _evidence)
+}
+
+// UpdateParam is a paid mutator transaction binding the contract method 0xac431751.
+//
+// Solidity: function updateParam(string key, bytes value) returns()
+func (_SlashIndicator *SlashIndicatorTransactor) UpdateParam(opts *bind.TransactOpts, key string, value []byte) (*types.Transaction, error) {
+	return _SlashIndicator.contract.Transact(opts, "updateParam", key, value)
+}
+
+// UpdateParam is a paid mutator transaction binding the contract method 0xac431751.
+//
+// Solidity: function updateParam(string key, bytes value) returns()
+func (_SlashIndicator *SlashIndicatorSession) UpdateParam(key string, value []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.UpdateParam(&_SlashIndicator.TransactOpts, key, value)
+}
+
+// UpdateParam is a paid mutator transaction binding the contract method 0xac431751.
+//
+// Solidity: function updateParam(string key, bytes value) returns()
+func (_SlashIndicator *SlashIndicatorTransactorSession) UpdateParam(key string, value []byte) (*types.Transaction, error) {
+	return _SlashIndicator.Contract.UpdateParam(&_SlashIndicator.TransactOpts, key, value)
+}
+
+// SlashIndicatorCrashResponseIterator is returned from FilterCrashResponse and is used to iterate over the raw logs and unpacked data for CrashResponse events raised by the SlashIndicator contract.
+type SlashIndicatorCrashResponseIterator struct {
+	Event *SlashIndicatorCrashResponse // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorCrashResponseIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorCrashResponse)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorCrashResponse)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorCrashResponseIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorCrashResponseIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorCrashResponse represents a CrashResponse event raised by the SlashIndicator contract.
+type SlashIndicatorCrashResponse struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterCrashResponse is a free log retrieval operation binding the contract event 0x07db600eebe2ac176be8dcebad61858c245a4961bb32ca2aa3d159b09aa0810e.
+//
+// Solidity: event crashResponse()
+func (_SlashIndicator *SlashIndicatorFilterer) FilterCrashResponse(opts *bind.FilterOpts) (*SlashIndicatorCrashResponseIterator, error) {
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "crashResponse")
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorCrashResponseIterator{contract: _SlashIndicator.contract, event: "crashResponse", logs: logs, sub: sub}, nil
+}
+
+// WatchCrashResponse is a free log subscription operation binding the contract event 0x07db600eebe2ac176be8dcebad61858c245a4961bb32ca2aa3d159b09aa0810e.
+//
+// Solidity: event crashResponse()
+func (_SlashIndicator *SlashIndicatorFilterer) WatchCrashResponse(opts *bind.WatchOpts, sink chan<- *SlashIndicatorCrashResponse) (event.Subscription, error) {
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "crashResponse")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorCrashResponse)
+				if err := _SlashIndicator.contract.UnpackLog(event, "crashResponse", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCrashResponse is a log parse operation binding the contract event 0x07db600eebe2ac176be8dcebad61858c245a4961bb32ca2aa3d159b09aa0810e.
+//
+// Solidity: event crashResponse()
+func (_SlashIndicator *SlashIndicatorFilterer) ParseCrashResponse(log types.Log) (*SlashIndicatorCrashResponse, error) {
+	event := new(SlashIndicatorCrashResponse)
+	if err := _SlashIndicator.contract.UnpackLog(event, "crashResponse", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorFailedFelonyIterator is returned from FilterFailedFelony and is used to iterate over the raw logs and unpacked data for FailedFelony events raised by the SlashIndicator contract.
+type SlashIndicatorFailedFelonyIterator struct {
+	Event *SlashIndicatorFailedFelony // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorFailedFelonyIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorFailedFelony)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorFailedFelony)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorFailedFelonyIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorFailedFelonyIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorFailedFelony represents a FailedFelony event raised by the SlashIndicator contract.
+type SlashIndicatorFailedFelony struct {
+	Validator  common.Address
+	SlashCount *big.Int
+	FailReason []byte
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterFailedFelony is a free log retrieval operation binding the contract event 0xd7bc86ff5d08c8ab043edec743302aba2520e6635172a428bc956721db9e2d1c.
+//
+// Solidity: event failedFelony(address indexed validator, uint256 slashCount, bytes failReason)
+func (_SlashIndicator *SlashIndicatorFilterer) FilterFailedFelony(opts *bind.FilterOpts, validator []common.Address) (*SlashIndicatorFailedFelonyIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "failedFelony", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorFailedFelonyIterator{contract: _SlashIndicator.contract, event: "failedFelony", logs: logs, sub: sub}, nil
+}
+
+// WatchFailedFelony is a free log subscription operation binding the contract event 0xd7bc86ff5d08c8ab043edec743302aba2520e6635172a428bc956721db9e2d1c.
+//
+// Solidity: event failedFelony(address indexed validator, uint256 slashCount, bytes failReason)
+func (_SlashIndicator *SlashIndicatorFilterer) WatchFailedFelony(opts *bind.WatchOpts, sink chan<- *SlashIndicatorFailedFelony, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "failedFelony", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorFailedFelony)
+				if err := _SlashIndicator.contract.UnpackLog(event, "failedFelony", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFailedFelony is a log parse operation binding the contract event 0xd7bc86ff5d08c8ab043edec743302aba2520e6635172a428bc956721db9e2d1c.
+//
+// Solidity: event failedFelony(address indexed validator, uint256 slashCount, bytes failReason)
+func (_SlashIndicator *SlashIndicatorFilterer) ParseFailedFelony(log types.Log) (*SlashIndicatorFailedFelony, error) {
+	event := new(SlashIndicatorFailedFelony)
+	if err := _SlashIndicator.contract.UnpackLog(event, "failedFelony", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorIndicatorCleanedIterator is returned from FilterIndicatorCleaned and is used to iterate over the raw logs and unpacked data for IndicatorCleaned events raised by the SlashIndicator contract.
+type SlashIndicatorIndicatorCleanedIterator struct {
+	Event *SlashIndicatorIndicatorCleaned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorIndicatorCleanedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorIndicatorCleaned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorIndicatorCleaned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorIndicatorCleanedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorIndicatorCleanedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorIndicatorCleaned represents a IndicatorCleaned event raised by the SlashIndicator contract.
+type SlashIndicatorIndicatorCleaned struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterIndicatorCleaned is a free log retrieval operation binding the contract event 0xcfdb3b6ccaeccbdc68be3c59c840e3b3c90f0a7c491f5fff1cf56cfda200dd9c.
+//
+// Solidity: event indicatorCleaned()
+func (_SlashIndicator *SlashIndicatorFilterer) FilterIndicatorCleaned(opts *bind.FilterOpts) (*SlashIndicatorIndicatorCleanedIterator, error) {
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "indicatorCleaned")
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorIndicatorCleanedIterator{contract: _SlashIndicator.contract, event: "indicatorCleaned", logs: logs, sub: sub}, nil
+}
+
+// WatchIndicatorCleaned is a free log subscription operation binding the contract event 0xcfdb3b6ccaeccbdc68be3c59c840e3b3c90f0a7c491f5fff1cf56cfda200dd9c.
+//
+// Solidity: event indicatorCleaned()
+func (_SlashIndicator *SlashIndicatorFilterer) WatchIndicatorCleaned(opts *bind.WatchOpts, sink chan<- *SlashIndicatorIndicatorCleaned) (event.Subscription, error) {
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "indicatorCleaned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorIndicatorCleaned)
+				if err := _SlashIndicator.contract.UnpackLog(event, "indicatorCleaned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIndicatorCleaned is a log parse operation binding the contract event 0xcfdb3b6ccaeccbdc68be3c59c840e3b3c90f0a7c491f5fff1cf56cfda200dd9c.
+//
+// Solidity: event indicatorCleaned()
+func (_SlashIndicator *SlashIndicatorFilterer) ParseIndicatorCleaned(log types.Log) (*SlashIndicatorIndicatorCleaned, error) {
+	event := new(SlashIndicatorIndicatorCleaned)
+	if err := _SlashIndicator.contract.UnpackLog(event, "indicatorCleaned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorKnownResponseIterator is returned from FilterKnownResponse and is used to iterate over the raw logs and unpacked data for KnownResponse events raised by the SlashIndicator contract.
+type SlashIndicatorKnownResponseIterator struct {
+	Event *SlashIndicatorKnownResponse // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorKnownResponseIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorKnownResponse)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorKnownResponse)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorKnownResponseIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorKnownResponseIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorKnownResponse represents a KnownResponse event raised by the SlashIndicator contract.
+type SlashIndicatorKnownResponse struct {
+	Code uint32
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterKnownResponse is a free log retrieval operation binding the contract event 0x7f0956d47419b9525356e7111652b653b530ec6f5096dccc04589bc38e629967.
+//
+// Solidity: event knownResponse(uint32 code)
+func (_SlashIndicator *SlashIndicatorFilterer) FilterKnownResponse(opts *bind.FilterOpts) (*SlashIndicatorKnownResponseIterator, error) {
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "knownResponse")
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorKnownResponseIterator{contract: _SlashIndicator.contract, event: "knownResponse", logs: logs, sub: sub}, nil
+}
+
+// WatchKnownResponse is a free log subscription operation binding the contract event 0x7f0956d47419b9525356e7111652b653b530ec6f5096dccc04589bc38e629967.
+//
+// Solidity: event knownResponse(uint32 code)
+func (_SlashIndicator *SlashIndicatorFilterer) WatchKnownResponse(opts *bind.WatchOpts, sink chan<- *SlashIndicatorKnownResponse) (event.Subscription, error) {
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "knownResponse")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorKnownResponse)
+				if err := _SlashIndicator.contract.UnpackLog(event, "knownResponse", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseKnownResponse is a log parse operation binding the contract event 0x7f0956d47419b9525356e7111652b653b530ec6f5096dccc04589bc38e629967.
+//
+// Solidity: event knownResponse(uint32 code)
+func (_SlashIndicator *SlashIndicatorFilterer) ParseKnownResponse(log types.Log) (*SlashIndicatorKnownResponse, error) {
+	event := new(SlashIndicatorKnownResponse)
+	if err := _SlashIndicator.contract.UnpackLog(event, "knownResponse", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorMaliciousVoteSlashedIterator is returned from FilterMaliciousVoteSlashed and is used to iterate over the raw logs and unpacked data for MaliciousVoteSlashed events raised by the SlashIndicator contract.
+type SlashIndicatorMaliciousVoteSlashedIterator struct {
+	Event *SlashIndicatorMaliciousVoteSlashed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorMaliciousVoteSlashedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorMaliciousVoteSlashed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorMaliciousVoteSlashed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorMaliciousVoteSlashedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorMaliciousVoteSlashedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorMaliciousVoteSlashed represents a MaliciousVoteSlashed event raised by the SlashIndicator contract.
+type SlashIndicatorMaliciousVoteSlashed struct {
+	VoteAddrSlice [32]byte
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterMaliciousVoteSlashed is a free log retrieval operation binding the contract event 0x7b78aadacff901d8b63d0dba4f86283d4db8aef27f9ed70413dd860f1c9532b6.
+//
+// Solidity: event maliciousVoteSlashed(bytes32 indexed voteAddrSlice)
+func (_SlashIndicator *SlashIndicatorFilterer) FilterMaliciousVoteSlashed(opts *bind.FilterOpts, voteAddrSlice [][32]byte) (*SlashIndicatorMaliciousVoteSlashedIterator, error) {
+
+	var voteAddrSliceRule []interface{}
+	for _, voteAddrSliceItem := range voteAddrSlice {
+		voteAddrSliceRule = append(voteAddrSliceRule, voteAddrSliceItem)
+	}
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "maliciousVoteSlashed", voteAddrSliceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorMaliciousVoteSlashedIterator{contract: _SlashIndicator.contract, event: "maliciousVoteSlashed", logs: logs, sub: sub}, nil
+}
+
+// WatchMaliciousVoteSlashed is a free log subscription operation binding the contract event 0x7b78aadacff901d8b63d0dba4f86283d4db8aef27f9ed70413dd860f1c9532b6.
+//
+// Solidity: event maliciousVoteSlashed(bytes32 indexed voteAddrSlice)
+func (_SlashIndicator *SlashIndicatorFilterer) WatchMaliciousVoteSlashed(opts *bind.WatchOpts, sink chan<- *SlashIndicatorMaliciousVoteSlashed, voteAddrSlice [][32]byte) (event.Subscription, error) {
+
+	var voteAddrSliceRule []interface{}
+	for _, voteAddrSliceItem := range voteAddrSlice {
+		voteAddrSliceRule = append(voteAddrSliceRule, voteAddrSliceItem)
+	}
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "maliciousVoteSlashed", voteAddrSliceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorMaliciousVoteSlashed)
+				if err := _SlashIndicator.contract.UnpackLog(event, "maliciousVoteSlashed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMaliciousVoteSlashed is a log parse operation binding the contract event 0x7b78aadacff901d8b63d0dba4f86283d4db8aef27f9ed70413dd860f1c9532b6.
+//
+// Solidity: event maliciousVoteSlashed(bytes32 indexed voteAddrSlice)
+func (_SlashIndicator *SlashIndicatorFilterer) ParseMaliciousVoteSlashed(log types.Log) (*SlashIndicatorMaliciousVoteSlashed, error) {
+	event := new(SlashIndicatorMaliciousVoteSlashed)
+	if err := _SlashIndicator.contract.UnpackLog(event, "maliciousVoteSlashed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorParamChangeIterator is returned from FilterParamChange and is used to iterate over the raw logs and unpacked data for ParamChange events raised by the SlashIndicator contract.
+type SlashIndicatorParamChangeIterator struct {
+	Event *SlashIndicatorParamChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorParamChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorParamChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorParamChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorParamChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorParamChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorParamChange represents a ParamChange event raised by the SlashIndicator contract.
+type SlashIndicatorParamChange struct {
+	Key   string
+	Value []byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterParamChange is a free log retrieval operation binding the contract event 0x6cdb0ac70ab7f2e2d035cca5be60d89906f2dede7648ddbd7402189c1eeed17a.
+//
+// Solidity: event paramChange(string key, bytes value)
+func (_SlashIndicator *SlashIndicatorFilterer) FilterParamChange(opts *bind.FilterOpts) (*SlashIndicatorParamChangeIterator, error) {
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "paramChange")
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorParamChangeIterator{contract: _SlashIndicator.contract, event: "paramChange", logs: logs, sub: sub}, nil
+}
+
+// WatchParamChange is a free log subscription operation binding the contract event 0x6cdb0ac70ab7f2e2d035cca5be60d89906f2dede7648ddbd7402189c1eeed17a.
+//
+// Solidity: event paramChange(string key, bytes value)
+func (_SlashIndicator *SlashIndicatorFilterer) WatchParamChange(opts *bind.WatchOpts, sink chan<- *SlashIndicatorParamChange) (event.Subscription, error) {
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "paramChange")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorParamChange)
+				if err := _SlashIndicator.contract.UnpackLog(event, "paramChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParamChange is a log parse operation binding the contract event 0x6cdb0ac70ab7f2e2d035cca5be60d89906f2dede7648ddbd7402189c1eeed17a.
+//
+// Solidity: event paramChange(string key, bytes value)
+func (_SlashIndicator *SlashIndicatorFilterer) ParseParamChange(log types.Log) (*SlashIndicatorParamChange, error) {
+	event := new(SlashIndicatorParamChange)
+	if err := _SlashIndicator.contract.UnpackLog(event, "paramChange", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorUnKnownResponseIterator is returned from FilterUnKnownResponse and is used to iterate over the raw logs and unpacked data for UnKnownResponse events raised by the SlashIndicator contract.
+type SlashIndicatorUnKnownResponseIterator struct {
+	Event *SlashIndicatorUnKnownResponse // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorUnKnownResponseIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorUnKnownResponse)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorUnKnownResponse)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorUnKnownResponseIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorUnKnownResponseIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorUnKnownResponse represents a UnKnownResponse event raised by the SlashIndicator contract.
+type SlashIndicatorUnKnownResponse struct {
+	Code uint32
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnKnownResponse is a free log retrieval operation binding the contract event 0x7d45f62d17443dd4547bca8a8112c60e2385669318dc300ec61a5d2492f262e7.
+//
+// Solidity: event unKnownResponse(uint32 code)
+func (_SlashIndicator *SlashIndicatorFilterer) FilterUnKnownResponse(opts *bind.FilterOpts) (*SlashIndicatorUnKnownResponseIterator, error) {
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "unKnownResponse")
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorUnKnownResponseIterator{contract: _SlashIndicator.contract, event: "unKnownResponse", logs: logs, sub: sub}, nil
+}
+
+// WatchUnKnownResponse is a free log subscription operation binding the contract event 0x7d45f62d17443dd4547bca8a8112c60e2385669318dc300ec61a5d2492f262e7.
+//
+// Solidity: event unKnownResponse(uint32 code)
+func (_SlashIndicator *SlashIndicatorFilterer) WatchUnKnownResponse(opts *bind.WatchOpts, sink chan<- *SlashIndicatorUnKnownResponse) (event.Subscription, error) {
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "unKnownResponse")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorUnKnownResponse)
+				if err := _SlashIndicator.contract.UnpackLog(event, "unKnownResponse", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnKnownResponse is a log parse operation binding the contract event 0x7d45f62d17443dd4547bca8a8112c60e2385669318dc300ec61a5d2492f262e7.
+//
+// Solidity: event unKnownResponse(uint32 code)
+func (_SlashIndicator *SlashIndicatorFilterer) ParseUnKnownResponse(log types.Log) (*SlashIndicatorUnKnownResponse, error) {
+	event := new(SlashIndicatorUnKnownResponse)
+	if err := _SlashIndicator.contract.UnpackLog(event, "unKnownResponse", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SlashIndicatorValidatorSlashedIterator is returned from FilterValidatorSlashed and is used to iterate over the raw logs and unpacked data for ValidatorSlashed events raised by the SlashIndicator contract.
+type SlashIndicatorValidatorSlashedIterator struct {
+	Event *SlashIndicatorValidatorSlashed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SlashIndicatorValidatorSlashedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SlashIndicatorValidatorSlashed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SlashIndicatorValidatorSlashed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SlashIndicatorValidatorSlashedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SlashIndicatorValidatorSlashedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SlashIndicatorValidatorSlashed represents a ValidatorSlashed event raised by the SlashIndicator contract.
+type SlashIndicatorValidatorSlashed struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorSlashed is a free log retrieval operation binding the contract event 0xddb6012116e51abf5436d956a4f0ebd927e92c576ff96d7918290c8782291e3e.
+//
+// Solidity: event validatorSlashed(address indexed validator)
+func (_SlashIndicator *SlashIndicatorFilterer) FilterValidatorSlashed(opts *bind.FilterOpts, validator []common.Address) (*SlashIndicatorValidatorSlashedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _SlashIndicator.contract.FilterLogs(opts, "validatorSlashed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SlashIndicatorValidatorSlashedIterator{contract: _SlashIndicator.contract, event: "validatorSlashed", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorSlashed is a free log subscription operation binding the contract event 0xddb6012116e51abf5436d956a4f0ebd927e92c576ff96d7918290c8782291e3e.
+//
+// Solidity: event validatorSlashed(address indexed validator)
+func (_SlashIndicator *SlashIndicatorFilterer) WatchValidatorSlashed(opts *bind.WatchOpts, sink chan<- *SlashIndicatorValidatorSlashed, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _SlashIndicator.contract.WatchLogs(opts, "validatorSlashed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SlashIndicatorValidatorSlashed)
+				if err := _SlashIndicator.contract.UnpackLog(event, "validatorSlashed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorSlashed is a log parse operation binding the contract event 0xddb6012116e51abf5436d956a4f0ebd927e92c576ff96d7918290c8782291e3e.
+//
+// Solidity: event validatorSlashed(address indexed validator)
+func (_SlashIndicator *SlashIndicatorFilterer) ParseValidatorSlashed(log types.Log) (*SlashIndicatorValidatorSlashed, error) {
+	event := new(SlashIndicatorValidatorSlashed)
+	if err := _SlashIndicator.contract.UnpackLog(event, "validatorSlashed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
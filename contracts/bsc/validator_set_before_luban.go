@@ -0,0 +1,5057 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bsc
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ValidatorSetBeforeLubanMetaData contains all meta data concerning the ValidatorSetBeforeLuban contract.
+var ValidatorSetBeforeLubanMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"batchTransfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"batchTransferFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"reason\",\"type\":\"bytes\"}],\"name\":\"batchTransferLowerFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"deprecatedDeposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"addresspayable\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"directTransfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"addresspayable\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"directTransferFail\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"message\",\"type\":\"string\"}],\"name\":\"failReasonWithStr\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"feeBurned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"value\",\"type\":\"bytes\"}],\"name\":\"paramChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"systemTransfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"channelId\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"msgBytes\",\"type\":\"bytes\"}],\"name\":\"unexpectedPackage\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"validatorDeposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"validatorEmptyJailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"validatorEnterMaintenance\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"validatorExitMaintenance\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"validatorFelony\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"validatorJailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"validatorMisdemeanor\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"validatorSetUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BIND_CHANNELID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BURN_ADDRESS\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BURN_RATIO_SCALE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"CODE_OK\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"CROSS_CHAIN_CONTRACT_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DUSTY_INCOMING\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"EPOCH\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ERROR_FAIL_CHECK_VALIDATORS\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ERROR_FAIL_DECODE\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ERROR_LEN_OF_VAL_MISMATCH\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ERROR_RELAYFEE_TOO_LARGE\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ERROR_UNKNOWN_PACKAGE_TYPE\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"EXPIRE_TIME_SECOND_GAP\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GOV_CHANNELID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GOV_HUB_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INCENTIVIZE_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INIT_BURN_RATIO\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INIT_MAINTAIN_SLASH_SCALE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INIT_MAX_NUM_OF_MAINTAINING\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INIT_NUM_OF_CABINETS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INIT_VALIDATORSET_BYTES\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"JAIL_MESSAGE_TYPE\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"LIGHT_CLIENT_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_NUM_OF_VALIDATORS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RELAYERHUB_CONTRACT_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SLASH_CHANNELID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SLASH_CONTRACT_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"STAKING_CHANNELID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SYSTEM_ADDRESS\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SYSTEM_REWARD_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TOKEN_HUB_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TOKEN_MANAGER_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFER_IN_CHANNELID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFER_OUT_CHANNELID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"VALIDATORS_UPDATE_MESSAGE_TYPE\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"VALIDATOR_CONTRACT_ADDR\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"alreadyInit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"bscChainID\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"burnRatio\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"burnRatioInitialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"currentValidatorSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"consensusAddress\",\"type\":\"address\"},{\"internalType\":\"addresspayable\",\"name\":\"feeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"BBCFeeAddress\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"votingPower\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"jailed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"incoming\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"currentValidatorSetMap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"expireTimeSecondGap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maintainSlashScale\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxNumOfCandidates\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxNumOfMaintaining\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxNumOfWorkingCandidates\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"numOfCabinets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"numOfJailed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"numOfMaintaining\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalInComing\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"valAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"slashAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"rewardAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"lightAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenHubAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"incentivizeAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"relayerHubAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"govHub\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenManagerAddr\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"crossChain\",\"type\":\"address\"}],\"name\":\"updateContractAddr\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"validatorExtraSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"enterMaintenanceHeight\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"isMaintaining\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"},{\"internalType\":\"bytes\",\"name\":\"msgBytes\",\"type\":\"bytes\"}],\"name\":\"handleSynPackage\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"responsePayload\",\"type\":\"bytes\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"channelId\",\"type\":\"uint8\"},{\"internalType\":\"bytes\",\"name\":\"msgBytes\",\"type\":\"bytes\"}],\"name\":\"handleAckPackage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"channelId\",\"type\":\"uint8\"},{\"internalType\":\"bytes\",\"name\":\"msgBytes\",\"type\":\"bytes\"}],\"name\":\"handleFailAckPackage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"valAddr\",\"type\":\"address\"}],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMiningValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"isWorkingValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getIncoming\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"isCurrentValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"misdemeanor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"felony\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"getCurrentValidatorIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"canEnterMaintenance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"enterMaintenance\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"exitMaintenance\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"value\",\"type\":\"bytes\"}],\"name\":\"updateParam\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"isValidatorExist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaintainingValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"maintainingValidators\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ValidatorSetBeforeLubanABI is the input ABI used to generate the binding from.
+// Deprecated: Use ValidatorSetBeforeLubanMetaData.ABI instead.
+var ValidatorSetBeforeLubanABI = ValidatorSetBeforeLubanMetaData.ABI
+
+// ValidatorSetBeforeLuban is an auto generated Go binding around an Ethereum contract.
+type ValidatorSetBeforeLuban struct {
+	ValidatorSetBeforeLubanCaller     // Read-only binding to the contract
+	ValidatorSetBeforeLubanTransactor // Write-only binding to the contract
+	ValidatorSetBeforeLubanFilterer   // Log filterer for contract events
+}
+
+// ValidatorSetBeforeLubanCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ValidatorSetBeforeLubanCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorSetBeforeLubanTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ValidatorSetBeforeLubanTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorSetBeforeLubanFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ValidatorSetBeforeLubanFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorSetBeforeLubanSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ValidatorSetBeforeLubanSession struct {
+	Contract     *ValidatorSetBeforeLuban // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts            // Call options to use throughout this session
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ValidatorSetBeforeLubanCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ValidatorSetBeforeLubanCallerSession struct {
+	Contract *ValidatorSetBeforeLubanCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                  // Call options to use throughout this session
+}
+
+// ValidatorSetBeforeLubanTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ValidatorSetBeforeLubanTransactorSession struct {
+	Contract     *ValidatorSetBeforeLubanTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                  // Transaction auth options to use throughout this session
+}
+
+// ValidatorSetBeforeLubanRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ValidatorSetBeforeLubanRaw struct {
+	Contract *ValidatorSetBeforeLuban // Generic contract binding to access the raw methods on
+}
+
+// ValidatorSetBeforeLubanCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ValidatorSetBeforeLubanCallerRaw struct {
+	Contract *ValidatorSetBeforeLubanCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ValidatorSetBeforeLubanTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ValidatorSetBeforeLubanTransactorRaw struct {
+	Contract *ValidatorSetBeforeLubanTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewValidatorSetBeforeLuban creates a new instance of ValidatorSetBeforeLuban, bound to a specific deployed contract.
+func NewValidatorSetBeforeLuban(address common.Address, backend bind.ContractBackend) (*ValidatorSetBeforeLuban, error) {
+	contract, err := bindValidatorSetBeforeLuban(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLuban{ValidatorSetBeforeLubanCaller: ValidatorSetBeforeLubanCaller{contract: contract}, ValidatorSetBeforeLubanTransactor: ValidatorSetBeforeLubanTransactor{contract: contract}, ValidatorSetBeforeLubanFilterer: ValidatorSetBeforeLubanFilterer{contract: contract}}, nil
+}
+
+// NewValidatorSetBeforeLubanCaller creates a new read-only instance of ValidatorSetBeforeLuban, bound to a specific deployed contract.
+func NewValidatorSetBeforeLubanCaller(address common.Address, caller bind.ContractCaller) (*ValidatorSetBeforeLubanCaller, error) {
+	contract, err := bindValidatorSetBeforeLuban(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanCaller{contract: contract}, nil
+}
+
+// NewValidatorSetBeforeLubanTransactor creates a new write-only instance of ValidatorSetBeforeLuban, bound to a specific deployed contract.
+func NewValidatorSetBeforeLubanTransactor(address common.Address, transactor bind.ContractTransactor) (*ValidatorSetBeforeLubanTransactor, error) {
+	contract, err := bindValidatorSetBeforeLuban(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanTransactor{contract: contract}, nil
+}
+
+// NewValidatorSetBeforeLubanFilterer creates a new log filterer instance of ValidatorSetBeforeLuban, bound to a specific deployed contract.
+func NewValidatorSetBeforeLubanFilterer(address common.Address, filterer bind.ContractFilterer) (*ValidatorSetBeforeLubanFilterer, error) {
+	contract, err := bindValidatorSetBeforeLuban(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanFilterer{contract: contract}, nil
+}
+
+// bindValidatorSetBeforeLuban binds a generic wrapper to an already deployed contract.
+func bindValidatorSetBeforeLuban(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ValidatorSetBeforeLubanMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ValidatorSetBeforeLuban.Contract.ValidatorSetBeforeLubanCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.ValidatorSetBeforeLubanTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.ValidatorSetBeforeLubanTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ValidatorSetBeforeLuban.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.contract.Transact(opts, method, params...)
+}
+
+// BINDCHANNELID is a free data retrieval call binding the contract method 0x3dffc387.
+//
+// Solidity: function BIND_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) BINDCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "BIND_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// BINDCHANNELID is a free data retrieval call binding the contract method 0x3dffc387.
+//
+// Solidity: function BIND_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) BINDCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.BINDCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BINDCHANNELID is a free data retrieval call binding the contract method 0x3dffc387.
+//
+// Solidity: function BIND_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) BINDCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.BINDCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BURNADDRESS is a free data retrieval call binding the contract method 0xfccc2813.
+//
+// Solidity: function BURN_ADDRESS() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) BURNADDRESS(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "BURN_ADDRESS")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BURNADDRESS is a free data retrieval call binding the contract method 0xfccc2813.
+//
+// Solidity: function BURN_ADDRESS() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) BURNADDRESS() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.BURNADDRESS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BURNADDRESS is a free data retrieval call binding the contract method 0xfccc2813.
+//
+// Solidity: function BURN_ADDRESS() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) BURNADDRESS() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.BURNADDRESS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BURNRATIOSCALE is a free data retrieval call binding the contract method 0x3de0f0d8.
+//
+// Solidity: function BURN_RATIO_SCALE() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) BURNRATIOSCALE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "BURN_RATIO_SCALE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BURNRATIOSCALE is a free data retrieval call binding the contract method 0x3de0f0d8.
+//
+// Solidity: function BURN_RATIO_SCALE() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) BURNRATIOSCALE() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.BURNRATIOSCALE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BURNRATIOSCALE is a free data retrieval call binding the contract method 0x3de0f0d8.
+//
+// Solidity: function BURN_RATIO_SCALE() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) BURNRATIOSCALE() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.BURNRATIOSCALE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// CODEOK is a free data retrieval call binding the contract method 0xab51bb96.
+//
+// Solidity: function CODE_OK() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) CODEOK(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "CODE_OK")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// CODEOK is a free data retrieval call binding the contract method 0xab51bb96.
+//
+// Solidity: function CODE_OK() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) CODEOK() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.CODEOK(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// CODEOK is a free data retrieval call binding the contract method 0xab51bb96.
+//
+// Solidity: function CODE_OK() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) CODEOK() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.CODEOK(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// CROSSCHAINCONTRACTADDR is a free data retrieval call binding the contract method 0x51e80672.
+//
+// Solidity: function CROSS_CHAIN_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) CROSSCHAINCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "CROSS_CHAIN_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CROSSCHAINCONTRACTADDR is a free data retrieval call binding the contract method 0x51e80672.
+//
+// Solidity: function CROSS_CHAIN_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) CROSSCHAINCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.CROSSCHAINCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// CROSSCHAINCONTRACTADDR is a free data retrieval call binding the contract method 0x51e80672.
+//
+// Solidity: function CROSS_CHAIN_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) CROSSCHAINCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.CROSSCHAINCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// DUSTYINCOMING is a free data retrieval call binding the contract method 0xd86222d5.
+//
+// Solidity: function DUSTY_INCOMING() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) DUSTYINCOMING(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "DUSTY_INCOMING")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DUSTYINCOMING is a free data retrieval call binding the contract method 0xd86222d5.
+//
+// Solidity: function DUSTY_INCOMING() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) DUSTYINCOMING() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.DUSTYINCOMING(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// DUSTYINCOMING is a free data retrieval call binding the contract method 0xd86222d5.
+//
+// Solidity: function DUSTY_INCOMING() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) DUSTYINCOMING() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.DUSTYINCOMING(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// EPOCH is a free data retrieval call binding the contract method 0xa0dc2758.
+//
+// Solidity: function EPOCH() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) EPOCH(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "EPOCH")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EPOCH is a free data retrieval call binding the contract method 0xa0dc2758.
+//
+// Solidity: function EPOCH() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) EPOCH() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.EPOCH(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// EPOCH is a free data retrieval call binding the contract method 0xa0dc2758.
+//
+// Solidity: function EPOCH() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) EPOCH() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.EPOCH(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORFAILCHECKVALIDATORS is a free data retrieval call binding the contract method 0x81650b62.
+//
+// Solidity: function ERROR_FAIL_CHECK_VALIDATORS() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ERRORFAILCHECKVALIDATORS(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "ERROR_FAIL_CHECK_VALIDATORS")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// ERRORFAILCHECKVALIDATORS is a free data retrieval call binding the contract method 0x81650b62.
+//
+// Solidity: function ERROR_FAIL_CHECK_VALIDATORS() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ERRORFAILCHECKVALIDATORS() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORFAILCHECKVALIDATORS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORFAILCHECKVALIDATORS is a free data retrieval call binding the contract method 0x81650b62.
+//
+// Solidity: function ERROR_FAIL_CHECK_VALIDATORS() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ERRORFAILCHECKVALIDATORS() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORFAILCHECKVALIDATORS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORFAILDECODE is a free data retrieval call binding the contract method 0x0bee7a67.
+//
+// Solidity: function ERROR_FAIL_DECODE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ERRORFAILDECODE(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "ERROR_FAIL_DECODE")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// ERRORFAILDECODE is a free data retrieval call binding the contract method 0x0bee7a67.
+//
+// Solidity: function ERROR_FAIL_DECODE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ERRORFAILDECODE() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORFAILDECODE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORFAILDECODE is a free data retrieval call binding the contract method 0x0bee7a67.
+//
+// Solidity: function ERROR_FAIL_DECODE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ERRORFAILDECODE() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORFAILDECODE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORLENOFVALMISMATCH is a free data retrieval call binding the contract method 0x5d77156c.
+//
+// Solidity: function ERROR_LEN_OF_VAL_MISMATCH() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ERRORLENOFVALMISMATCH(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "ERROR_LEN_OF_VAL_MISMATCH")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// ERRORLENOFVALMISMATCH is a free data retrieval call binding the contract method 0x5d77156c.
+//
+// Solidity: function ERROR_LEN_OF_VAL_MISMATCH() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ERRORLENOFVALMISMATCH() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORLENOFVALMISMATCH(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORLENOFVALMISMATCH is a free data retrieval call binding the contract method 0x5d77156c.
+//
+// Solidity: function ERROR_LEN_OF_VAL_MISMATCH() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ERRORLENOFVALMISMATCH() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORLENOFVALMISMATCH(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORRELAYFEETOOLARGE is a free data retrieval call binding the contract method 0x219f22d5.
+//
+// Solidity: function ERROR_RELAYFEE_TOO_LARGE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ERRORRELAYFEETOOLARGE(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "ERROR_RELAYFEE_TOO_LARGE")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// ERRORRELAYFEETOOLARGE is a free data retrieval call binding the contract method 0x219f22d5.
+//
+// Solidity: function ERROR_RELAYFEE_TOO_LARGE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ERRORRELAYFEETOOLARGE() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORRELAYFEETOOLARGE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORRELAYFEETOOLARGE is a free data retrieval call binding the contract method 0x219f22d5.
+//
+// Solidity: function ERROR_RELAYFEE_TOO_LARGE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ERRORRELAYFEETOOLARGE() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORRELAYFEETOOLARGE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORUNKNOWNPACKAGETYPE is a free data retrieval call binding the contract method 0xeda5868c.
+//
+// Solidity: function ERROR_UNKNOWN_PACKAGE_TYPE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ERRORUNKNOWNPACKAGETYPE(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "ERROR_UNKNOWN_PACKAGE_TYPE")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// ERRORUNKNOWNPACKAGETYPE is a free data retrieval call binding the contract method 0xeda5868c.
+//
+// Solidity: function ERROR_UNKNOWN_PACKAGE_TYPE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ERRORUNKNOWNPACKAGETYPE() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORUNKNOWNPACKAGETYPE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ERRORUNKNOWNPACKAGETYPE is a free data retrieval call binding the contract method 0xeda5868c.
+//
+// Solidity: function ERROR_UNKNOWN_PACKAGE_TYPE() view returns(uint32)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ERRORUNKNOWNPACKAGETYPE() (uint32, error) {
+	return _ValidatorSetBeforeLuban.Contract.ERRORUNKNOWNPACKAGETYPE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// EXPIRETIMESECONDGAP is a free data retrieval call binding the contract method 0x853230aa.
+//
+// Solidity: function EXPIRE_TIME_SECOND_GAP() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) EXPIRETIMESECONDGAP(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "EXPIRE_TIME_SECOND_GAP")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EXPIRETIMESECONDGAP is a free data retrieval call binding the contract method 0x853230aa.
+//
+// Solidity: function EXPIRE_TIME_SECOND_GAP() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) EXPIRETIMESECONDGAP() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.EXPIRETIMESECONDGAP(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// EXPIRETIMESECONDGAP is a free data retrieval call binding the contract method 0x853230aa.
+//
+// Solidity: function EXPIRE_TIME_SECOND_GAP() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) EXPIRETIMESECONDGAP() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.EXPIRETIMESECONDGAP(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GOVCHANNELID is a free data retrieval call binding the contract method 0x96713da9.
+//
+// Solidity: function GOV_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GOVCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "GOV_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GOVCHANNELID is a free data retrieval call binding the contract method 0x96713da9.
+//
+// Solidity: function GOV_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GOVCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.GOVCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GOVCHANNELID is a free data retrieval call binding the contract method 0x96713da9.
+//
+// Solidity: function GOV_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GOVCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.GOVCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GOVHUBADDR is a free data retrieval call binding the contract method 0x9dc09262.
+//
+// Solidity: function GOV_HUB_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GOVHUBADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "GOV_HUB_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GOVHUBADDR is a free data retrieval call binding the contract method 0x9dc09262.
+//
+// Solidity: function GOV_HUB_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GOVHUBADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GOVHUBADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GOVHUBADDR is a free data retrieval call binding the contract method 0x9dc09262.
+//
+// Solidity: function GOV_HUB_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GOVHUBADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GOVHUBADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INCENTIVIZEADDR is a free data retrieval call binding the contract method 0x6e47b482.
+//
+// Solidity: function INCENTIVIZE_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) INCENTIVIZEADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "INCENTIVIZE_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// INCENTIVIZEADDR is a free data retrieval call binding the contract method 0x6e47b482.
+//
+// Solidity: function INCENTIVIZE_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) INCENTIVIZEADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.INCENTIVIZEADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INCENTIVIZEADDR is a free data retrieval call binding the contract method 0x6e47b482.
+//
+// Solidity: function INCENTIVIZE_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) INCENTIVIZEADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.INCENTIVIZEADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITBURNRATIO is a free data retrieval call binding the contract method 0x78dfed4a.
+//
+// Solidity: function INIT_BURN_RATIO() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) INITBURNRATIO(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "INIT_BURN_RATIO")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// INITBURNRATIO is a free data retrieval call binding the contract method 0x78dfed4a.
+//
+// Solidity: function INIT_BURN_RATIO() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) INITBURNRATIO() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITBURNRATIO(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITBURNRATIO is a free data retrieval call binding the contract method 0x78dfed4a.
+//
+// Solidity: function INIT_BURN_RATIO() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) INITBURNRATIO() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITBURNRATIO(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITMAINTAINSLASHSCALE is a free data retrieval call binding the contract method 0xc6d33945.
+//
+// Solidity: function INIT_MAINTAIN_SLASH_SCALE() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) INITMAINTAINSLASHSCALE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "INIT_MAINTAIN_SLASH_SCALE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// INITMAINTAINSLASHSCALE is a free data retrieval call binding the contract method 0xc6d33945.
+//
+// Solidity: function INIT_MAINTAIN_SLASH_SCALE() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) INITMAINTAINSLASHSCALE() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITMAINTAINSLASHSCALE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITMAINTAINSLASHSCALE is a free data retrieval call binding the contract method 0xc6d33945.
+//
+// Solidity: function INIT_MAINTAIN_SLASH_SCALE() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) INITMAINTAINSLASHSCALE() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITMAINTAINSLASHSCALE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITMAXNUMOFMAINTAINING is a free data retrieval call binding the contract method 0x9fe0f816.
+//
+// Solidity: function INIT_MAX_NUM_OF_MAINTAINING() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) INITMAXNUMOFMAINTAINING(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "INIT_MAX_NUM_OF_MAINTAINING")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// INITMAXNUMOFMAINTAINING is a free data retrieval call binding the contract method 0x9fe0f816.
+//
+// Solidity: function INIT_MAX_NUM_OF_MAINTAINING() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) INITMAXNUMOFMAINTAINING() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITMAXNUMOFMAINTAINING(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITMAXNUMOFMAINTAINING is a free data retrieval call binding the contract method 0x9fe0f816.
+//
+// Solidity: function INIT_MAX_NUM_OF_MAINTAINING() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) INITMAXNUMOFMAINTAINING() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITMAXNUMOFMAINTAINING(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITNUMOFCABINETS is a free data retrieval call binding the contract method 0xb8cf4ef1.
+//
+// Solidity: function INIT_NUM_OF_CABINETS() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) INITNUMOFCABINETS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "INIT_NUM_OF_CABINETS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// INITNUMOFCABINETS is a free data retrieval call binding the contract method 0xb8cf4ef1.
+//
+// Solidity: function INIT_NUM_OF_CABINETS() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) INITNUMOFCABINETS() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITNUMOFCABINETS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITNUMOFCABINETS is a free data retrieval call binding the contract method 0xb8cf4ef1.
+//
+// Solidity: function INIT_NUM_OF_CABINETS() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) INITNUMOFCABINETS() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITNUMOFCABINETS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITVALIDATORSETBYTES is a free data retrieval call binding the contract method 0xa5422d5c.
+//
+// Solidity: function INIT_VALIDATORSET_BYTES() view returns(bytes)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) INITVALIDATORSETBYTES(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "INIT_VALIDATORSET_BYTES")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// INITVALIDATORSETBYTES is a free data retrieval call binding the contract method 0xa5422d5c.
+//
+// Solidity: function INIT_VALIDATORSET_BYTES() view returns(bytes)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) INITVALIDATORSETBYTES() ([]byte, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITVALIDATORSETBYTES(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// INITVALIDATORSETBYTES is a free data retrieval call binding the contract method 0xa5422d5c.
+//
+// Solidity: function INIT_VALIDATORSET_BYTES() view returns(bytes)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) INITVALIDATORSETBYTES() ([]byte, error) {
+	return _ValidatorSetBeforeLuban.Contract.INITVALIDATORSETBYTES(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// JAILMESSAGETYPE is a free data retrieval call binding the contract method 0xbf9f4995.
+//
+// Solidity: function JAIL_MESSAGE_TYPE() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) JAILMESSAGETYPE(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "JAIL_MESSAGE_TYPE")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// JAILMESSAGETYPE is a free data retrieval call binding the contract method 0xbf9f4995.
+//
+// Solidity: function JAIL_MESSAGE_TYPE() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) JAILMESSAGETYPE() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.JAILMESSAGETYPE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// JAILMESSAGETYPE is a free data retrieval call binding the contract method 0xbf9f4995.
+//
+// Solidity: function JAIL_MESSAGE_TYPE() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) JAILMESSAGETYPE() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.JAILMESSAGETYPE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// LIGHTCLIENTADDR is a free data retrieval call binding the contract method 0xdc927faf.
+//
+// Solidity: function LIGHT_CLIENT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) LIGHTCLIENTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "LIGHT_CLIENT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LIGHTCLIENTADDR is a free data retrieval call binding the contract method 0xdc927faf.
+//
+// Solidity: function LIGHT_CLIENT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) LIGHTCLIENTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.LIGHTCLIENTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// LIGHTCLIENTADDR is a free data retrieval call binding the contract method 0xdc927faf.
+//
+// Solidity: function LIGHT_CLIENT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) LIGHTCLIENTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.LIGHTCLIENTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MAXNUMOFVALIDATORS is a free data retrieval call binding the contract method 0xe086c7b1.
+//
+// Solidity: function MAX_NUM_OF_VALIDATORS() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) MAXNUMOFVALIDATORS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "MAX_NUM_OF_VALIDATORS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXNUMOFVALIDATORS is a free data retrieval call binding the contract method 0xe086c7b1.
+//
+// Solidity: function MAX_NUM_OF_VALIDATORS() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) MAXNUMOFVALIDATORS() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MAXNUMOFVALIDATORS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MAXNUMOFVALIDATORS is a free data retrieval call binding the contract method 0xe086c7b1.
+//
+// Solidity: function MAX_NUM_OF_VALIDATORS() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) MAXNUMOFVALIDATORS() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MAXNUMOFVALIDATORS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// PRECISION is a free data retrieval call binding the contract method 0xaaf5eb68.
+//
+// Solidity: function PRECISION() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) PRECISION(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "PRECISION")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PRECISION is a free data retrieval call binding the contract method 0xaaf5eb68.
+//
+// Solidity: function PRECISION() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) PRECISION() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.PRECISION(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// PRECISION is a free data retrieval call binding the contract method 0xaaf5eb68.
+//
+// Solidity: function PRECISION() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) PRECISION() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.PRECISION(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// RELAYERHUBCONTRACTADDR is a free data retrieval call binding the contract method 0xa1a11bf5.
+//
+// Solidity: function RELAYERHUB_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) RELAYERHUBCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "RELAYERHUB_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RELAYERHUBCONTRACTADDR is a free data retrieval call binding the contract method 0xa1a11bf5.
+//
+// Solidity: function RELAYERHUB_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) RELAYERHUBCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.RELAYERHUBCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// RELAYERHUBCONTRACTADDR is a free data retrieval call binding the contract method 0xa1a11bf5.
+//
+// Solidity: function RELAYERHUB_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) RELAYERHUBCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.RELAYERHUBCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SLASHCHANNELID is a free data retrieval call binding the contract method 0x7942fd05.
+//
+// Solidity: function SLASH_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) SLASHCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "SLASH_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// SLASHCHANNELID is a free data retrieval call binding the contract method 0x7942fd05.
+//
+// Solidity: function SLASH_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) SLASHCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.SLASHCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SLASHCHANNELID is a free data retrieval call binding the contract method 0x7942fd05.
+//
+// Solidity: function SLASH_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) SLASHCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.SLASHCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SLASHCONTRACTADDR is a free data retrieval call binding the contract method 0x43756e5c.
+//
+// Solidity: function SLASH_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) SLASHCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "SLASH_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SLASHCONTRACTADDR is a free data retrieval call binding the contract method 0x43756e5c.
+//
+// Solidity: function SLASH_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) SLASHCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.SLASHCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SLASHCONTRACTADDR is a free data retrieval call binding the contract method 0x43756e5c.
+//
+// Solidity: function SLASH_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) SLASHCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.SLASHCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// STAKINGCHANNELID is a free data retrieval call binding the contract method 0x4bf6c882.
+//
+// Solidity: function STAKING_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) STAKINGCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "STAKING_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// STAKINGCHANNELID is a free data retrieval call binding the contract method 0x4bf6c882.
+//
+// Solidity: function STAKING_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) STAKINGCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.STAKINGCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// STAKINGCHANNELID is a free data retrieval call binding the contract method 0x4bf6c882.
+//
+// Solidity: function STAKING_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) STAKINGCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.STAKINGCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SYSTEMADDRESS is a free data retrieval call binding the contract method 0x3434735f.
+//
+// Solidity: function SYSTEM_ADDRESS() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) SYSTEMADDRESS(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "SYSTEM_ADDRESS")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SYSTEMADDRESS is a free data retrieval call binding the contract method 0x3434735f.
+//
+// Solidity: function SYSTEM_ADDRESS() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) SYSTEMADDRESS() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.SYSTEMADDRESS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SYSTEMADDRESS is a free data retrieval call binding the contract method 0x3434735f.
+//
+// Solidity: function SYSTEM_ADDRESS() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) SYSTEMADDRESS() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.SYSTEMADDRESS(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SYSTEMREWARDADDR is a free data retrieval call binding the contract method 0xc81b1662.
+//
+// Solidity: function SYSTEM_REWARD_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) SYSTEMREWARDADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "SYSTEM_REWARD_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SYSTEMREWARDADDR is a free data retrieval call binding the contract method 0xc81b1662.
+//
+// Solidity: function SYSTEM_REWARD_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) SYSTEMREWARDADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.SYSTEMREWARDADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// SYSTEMREWARDADDR is a free data retrieval call binding the contract method 0xc81b1662.
+//
+// Solidity: function SYSTEM_REWARD_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) SYSTEMREWARDADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.SYSTEMREWARDADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TOKENHUBADDR is a free data retrieval call binding the contract method 0xfd6a6879.
+//
+// Solidity: function TOKEN_HUB_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) TOKENHUBADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "TOKEN_HUB_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TOKENHUBADDR is a free data retrieval call binding the contract method 0xfd6a6879.
+//
+// Solidity: function TOKEN_HUB_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) TOKENHUBADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.TOKENHUBADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TOKENHUBADDR is a free data retrieval call binding the contract method 0xfd6a6879.
+//
+// Solidity: function TOKEN_HUB_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) TOKENHUBADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.TOKENHUBADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TOKENMANAGERADDR is a free data retrieval call binding the contract method 0x75d47a0a.
+//
+// Solidity: function TOKEN_MANAGER_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) TOKENMANAGERADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "TOKEN_MANAGER_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TOKENMANAGERADDR is a free data retrieval call binding the contract method 0x75d47a0a.
+//
+// Solidity: function TOKEN_MANAGER_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) TOKENMANAGERADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.TOKENMANAGERADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TOKENMANAGERADDR is a free data retrieval call binding the contract method 0x75d47a0a.
+//
+// Solidity: function TOKEN_MANAGER_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) TOKENMANAGERADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.TOKENMANAGERADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TRANSFERINCHANNELID is a free data retrieval call binding the contract method 0x70fd5bad.
+//
+// Solidity: function TRANSFER_IN_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) TRANSFERINCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "TRANSFER_IN_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// TRANSFERINCHANNELID is a free data retrieval call binding the contract method 0x70fd5bad.
+//
+// Solidity: function TRANSFER_IN_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) TRANSFERINCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.TRANSFERINCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TRANSFERINCHANNELID is a free data retrieval call binding the contract method 0x70fd5bad.
+//
+// Solidity: function TRANSFER_IN_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) TRANSFERINCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.TRANSFERINCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TRANSFEROUTCHANNELID is a free data retrieval call binding the contract method 0xfc3e5908.
+//
+// Solidity: function TRANSFER_OUT_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) TRANSFEROUTCHANNELID(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "TRANSFER_OUT_CHANNELID")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// TRANSFEROUTCHANNELID is a free data retrieval call binding the contract method 0xfc3e5908.
+//
+// Solidity: function TRANSFER_OUT_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) TRANSFEROUTCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.TRANSFEROUTCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TRANSFEROUTCHANNELID is a free data retrieval call binding the contract method 0xfc3e5908.
+//
+// Solidity: function TRANSFER_OUT_CHANNELID() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) TRANSFEROUTCHANNELID() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.TRANSFEROUTCHANNELID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// VALIDATORSUPDATEMESSAGETYPE is a free data retrieval call binding the contract method 0x5667515a.
+//
+// Solidity: function VALIDATORS_UPDATE_MESSAGE_TYPE() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) VALIDATORSUPDATEMESSAGETYPE(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "VALIDATORS_UPDATE_MESSAGE_TYPE")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// VALIDATORSUPDATEMESSAGETYPE is a free data retrieval call binding the contract method 0x5667515a.
+//
+// Solidity: function VALIDATORS_UPDATE_MESSAGE_TYPE() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) VALIDATORSUPDATEMESSAGETYPE() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.VALIDATORSUPDATEMESSAGETYPE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// VALIDATORSUPDATEMESSAGETYPE is a free data retrieval call binding the contract method 0x5667515a.
+//
+// Solidity: function VALIDATORS_UPDATE_MESSAGE_TYPE() view returns(uint8)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) VALIDATORSUPDATEMESSAGETYPE() (uint8, error) {
+	return _ValidatorSetBeforeLuban.Contract.VALIDATORSUPDATEMESSAGETYPE(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// VALIDATORCONTRACTADDR is a free data retrieval call binding the contract method 0xf9a2bbc7.
+//
+// Solidity: function VALIDATOR_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) VALIDATORCONTRACTADDR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "VALIDATOR_CONTRACT_ADDR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VALIDATORCONTRACTADDR is a free data retrieval call binding the contract method 0xf9a2bbc7.
+//
+// Solidity: function VALIDATOR_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) VALIDATORCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.VALIDATORCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// VALIDATORCONTRACTADDR is a free data retrieval call binding the contract method 0xf9a2bbc7.
+//
+// Solidity: function VALIDATOR_CONTRACT_ADDR() view returns(address)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) VALIDATORCONTRACTADDR() (common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.VALIDATORCONTRACTADDR(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// AlreadyInit is a free data retrieval call binding the contract method 0xa78abc16.
+//
+// Solidity: function alreadyInit() view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) AlreadyInit(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "alreadyInit")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AlreadyInit is a free data retrieval call binding the contract method 0xa78abc16.
+//
+// Solidity: function alreadyInit() view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) AlreadyInit() (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.AlreadyInit(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// AlreadyInit is a free data retrieval call binding the contract method 0xa78abc16.
+//
+// Solidity: function alreadyInit() view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) AlreadyInit() (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.AlreadyInit(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BscChainID is a free data retrieval call binding the contract method 0x493279b1.
+//
+// Solidity: function bscChainID() view returns(uint16)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) BscChainID(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "bscChainID")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// BscChainID is a free data retrieval call binding the contract method 0x493279b1.
+//
+// Solidity: function bscChainID() view returns(uint16)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) BscChainID() (uint16, error) {
+	return _ValidatorSetBeforeLuban.Contract.BscChainID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BscChainID is a free data retrieval call binding the contract method 0x493279b1.
+//
+// Solidity: function bscChainID() view returns(uint16)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) BscChainID() (uint16, error) {
+	return _ValidatorSetBeforeLuban.Contract.BscChainID(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BurnRatio is a free data retrieval call binding the contract method 0x5192c82c.
+//
+// Solidity: function burnRatio() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) BurnRatio(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "burnRatio")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BurnRatio is a free data retrieval call binding the contract method 0x5192c82c.
+//
+// Solidity: function burnRatio() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) BurnRatio() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.BurnRatio(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BurnRatio is a free data retrieval call binding the contract method 0x5192c82c.
+//
+// Solidity: function burnRatio() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) BurnRatio() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.BurnRatio(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BurnRatioInitialized is a free data retrieval call binding the contract method 0x152ad3b8.
+//
+// Solidity: function burnRatioInitialized() view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) BurnRatioInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "burnRatioInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// BurnRatioInitialized is a free data retrieval call binding the contract method 0x152ad3b8.
+//
+// Solidity: function burnRatioInitialized() view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) BurnRatioInitialized() (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.BurnRatioInitialized(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// BurnRatioInitialized is a free data retrieval call binding the contract method 0x152ad3b8.
+//
+// Solidity: function burnRatioInitialized() view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) BurnRatioInitialized() (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.BurnRatioInitialized(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// CanEnterMaintenance is a free data retrieval call binding the contract method 0x321d398a.
+//
+// Solidity: function canEnterMaintenance(uint256 index) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) CanEnterMaintenance(opts *bind.CallOpts, index *big.Int) (bool, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "canEnterMaintenance", index)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CanEnterMaintenance is a free data retrieval call binding the contract method 0x321d398a.
+//
+// Solidity: function canEnterMaintenance(uint256 index) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) CanEnterMaintenance(index *big.Int) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.CanEnterMaintenance(&_ValidatorSetBeforeLuban.CallOpts, index)
+}
+
+// CanEnterMaintenance is a free data retrieval call binding the contract method 0x321d398a.
+//
+// Solidity: function canEnterMaintenance(uint256 index) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) CanEnterMaintenance(index *big.Int) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.CanEnterMaintenance(&_ValidatorSetBeforeLuban.CallOpts, index)
+}
+
+// CurrentValidatorSet is a free data retrieval call binding the contract method 0x6969a25c.
+//
+// Solidity: function currentValidatorSet(uint256 ) view returns(address consensusAddress, address feeAddress, address BBCFeeAddress, uint64 votingPower, bool jailed, uint256 incoming)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) CurrentValidatorSet(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	ConsensusAddress common.Address
+	FeeAddress       common.Address
+	BBCFeeAddress    common.Address
+	VotingPower      uint64
+	Jailed           bool
+	Incoming         *big.Int
+}, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "currentValidatorSet", arg0)
+
+	outstruct := new(struct {
+		ConsensusAddress common.Address
+		FeeAddress       common.Address
+		BBCFeeAddress    common.Address
+		VotingPower      uint64
+		Jailed           bool
+		Incoming         *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ConsensusAddress = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.FeeAddress = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.BBCFeeAddress = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.VotingPower = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+	outstruct.Jailed = *abi.ConvertType(out[4], new(bool)).(*bool)
+	outstruct.Incoming = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// CurrentValidatorSet is a free data retrieval call binding the contract method 0x6969a25c.
+//
+// Solidity: function currentValidatorSet(uint256 ) view returns(address consensusAddress, address feeAddress, address BBCFeeAddress, uint64 votingPower, bool jailed, uint256 incoming)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) CurrentValidatorSet(arg0 *big.Int) (struct {
+	ConsensusAddress common.Address
+	FeeAddress       common.Address
+	BBCFeeAddress    common.Address
+	VotingPower      uint64
+	Jailed           bool
+	Incoming         *big.Int
+}, error) {
+	return _ValidatorSetBeforeLuban.Contract.CurrentValidatorSet(&_ValidatorSetBeforeLuban.CallOpts, arg0)
+}
+
+// CurrentValidatorSet is a free data retrieval call binding the contract method 0x6969a25c.
+//
+// Solidity: function currentValidatorSet(uint256 ) view returns(address consensusAddress, address feeAddress, address BBCFeeAddress, uint64 votingPower, bool jailed, uint256 incoming)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) CurrentValidatorSet(arg0 *big.Int) (struct {
+	ConsensusAddress common.Address
+	FeeAddress       common.Address
+	BBCFeeAddress    common.Address
+	VotingPower      uint64
+	Jailed           bool
+	Incoming         *big.Int
+}, error) {
+	return _ValidatorSetBeforeLuban.Contract.CurrentValidatorSet(&_ValidatorSetBeforeLuban.CallOpts, arg0)
+}
+
+// CurrentValidatorSetMap is a free data retrieval call binding the contract method 0xad3c9da6.
+//
+// Solidity: function currentValidatorSetMap(address ) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) CurrentValidatorSetMap(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "currentValidatorSetMap", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentValidatorSetMap is a free data retrieval call binding the contract method 0xad3c9da6.
+//
+// Solidity: function currentValidatorSetMap(address ) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) CurrentValidatorSetMap(arg0 common.Address) (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.CurrentValidatorSetMap(&_ValidatorSetBeforeLuban.CallOpts, arg0)
+}
+
+// CurrentValidatorSetMap is a free data retrieval call binding the contract method 0xad3c9da6.
+//
+// Solidity: function currentValidatorSetMap(address ) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) CurrentValidatorSetMap(arg0 common.Address) (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.CurrentValidatorSetMap(&_ValidatorSetBeforeLuban.CallOpts, arg0)
+}
+
+// ExpireTimeSecondGap is a free data retrieval call binding the contract method 0x86249882.
+//
+// Solidity: function expireTimeSecondGap() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ExpireTimeSecondGap(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "expireTimeSecondGap")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExpireTimeSecondGap is a free data retrieval call binding the contract method 0x86249882.
+//
+// Solidity: function expireTimeSecondGap() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ExpireTimeSecondGap() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.ExpireTimeSecondGap(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ExpireTimeSecondGap is a free data retrieval call binding the contract method 0x86249882.
+//
+// Solidity: function expireTimeSecondGap() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ExpireTimeSecondGap() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.ExpireTimeSecondGap(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GetCurrentValidatorIndex is a free data retrieval call binding the contract method 0x8d19a410.
+//
+// Solidity: function getCurrentValidatorIndex(address _validator) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GetCurrentValidatorIndex(opts *bind.CallOpts, _validator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "getCurrentValidatorIndex", _validator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCurrentValidatorIndex is a free data retrieval call binding the contract method 0x8d19a410.
+//
+// Solidity: function getCurrentValidatorIndex(address _validator) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GetCurrentValidatorIndex(_validator common.Address) (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetCurrentValidatorIndex(&_ValidatorSetBeforeLuban.CallOpts, _validator)
+}
+
+// GetCurrentValidatorIndex is a free data retrieval call binding the contract method 0x8d19a410.
+//
+// Solidity: function getCurrentValidatorIndex(address _validator) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GetCurrentValidatorIndex(_validator common.Address) (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetCurrentValidatorIndex(&_ValidatorSetBeforeLuban.CallOpts, _validator)
+}
+
+// GetIncoming is a free data retrieval call binding the contract method 0x565c56b3.
+//
+// Solidity: function getIncoming(address validator) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GetIncoming(opts *bind.CallOpts, validator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "getIncoming", validator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetIncoming is a free data retrieval call binding the contract method 0x565c56b3.
+//
+// Solidity: function getIncoming(address validator) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GetIncoming(validator common.Address) (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetIncoming(&_ValidatorSetBeforeLuban.CallOpts, validator)
+}
+
+// GetIncoming is a free data retrieval call binding the contract method 0x565c56b3.
+//
+// Solidity: function getIncoming(address validator) view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GetIncoming(validator common.Address) (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetIncoming(&_ValidatorSetBeforeLuban.CallOpts, validator)
+}
+
+// GetMaintainingValidators is a free data retrieval call binding the contract method 0xf65e5528.
+//
+// Solidity: function getMaintainingValidators() view returns(address[] maintainingValidators)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GetMaintainingValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "getMaintainingValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetMaintainingValidators is a free data retrieval call binding the contract method 0xf65e5528.
+//
+// Solidity: function getMaintainingValidators() view returns(address[] maintainingValidators)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GetMaintainingValidators() ([]common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetMaintainingValidators(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GetMaintainingValidators is a free data retrieval call binding the contract method 0xf65e5528.
+//
+// Solidity: function getMaintainingValidators() view returns(address[] maintainingValidators)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GetMaintainingValidators() ([]common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetMaintainingValidators(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GetMiningValidators is a free data retrieval call binding the contract method 0x4df6e0c3.
+//
+// Solidity: function getMiningValidators() view returns(address[])
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GetMiningValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "getMiningValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetMiningValidators is a free data retrieval call binding the contract method 0x4df6e0c3.
+//
+// Solidity: function getMiningValidators() view returns(address[])
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GetMiningValidators() ([]common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetMiningValidators(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GetMiningValidators is a free data retrieval call binding the contract method 0x4df6e0c3.
+//
+// Solidity: function getMiningValidators() view returns(address[])
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GetMiningValidators() ([]common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetMiningValidators(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) GetValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "getValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) GetValidators() ([]common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetValidators(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) GetValidators() ([]common.Address, error) {
+	return _ValidatorSetBeforeLuban.Contract.GetValidators(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// IsCurrentValidator is a free data retrieval call binding the contract method 0x55614fcc.
+//
+// Solidity: function isCurrentValidator(address validator) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) IsCurrentValidator(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "isCurrentValidator", validator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsCurrentValidator is a free data retrieval call binding the contract method 0x55614fcc.
+//
+// Solidity: function isCurrentValidator(address validator) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) IsCurrentValidator(validator common.Address) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.IsCurrentValidator(&_ValidatorSetBeforeLuban.CallOpts, validator)
+}
+
+// IsCurrentValidator is a free data retrieval call binding the contract method 0x55614fcc.
+//
+// Solidity: function isCurrentValidator(address validator) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) IsCurrentValidator(validator common.Address) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.IsCurrentValidator(&_ValidatorSetBeforeLuban.CallOpts, validator)
+}
+
+// IsValidatorExist is a free data retrieval call binding the contract method 0x8990597a.
+//
+// Solidity: function isValidatorExist(address validator) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) IsValidatorExist(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "isValidatorExist", validator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsValidatorExist is a free data retrieval call binding the contract method 0x8990597a.
+//
+// Solidity: function isValidatorExist(address validator) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) IsValidatorExist(validator common.Address) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.IsValidatorExist(&_ValidatorSetBeforeLuban.CallOpts, validator)
+}
+
+// IsValidatorExist is a free data retrieval call binding the contract method 0x8990597a.
+//
+// Solidity: function isValidatorExist(address validator) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) IsValidatorExist(validator common.Address) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.IsValidatorExist(&_ValidatorSetBeforeLuban.CallOpts, validator)
+}
+
+// IsWorkingValidator is a free data retrieval call binding the contract method 0x3365af3a.
+//
+// Solidity: function isWorkingValidator(uint256 index) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) IsWorkingValidator(opts *bind.CallOpts, index *big.Int) (bool, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "isWorkingValidator", index)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsWorkingValidator is a free data retrieval call binding the contract method 0x3365af3a.
+//
+// Solidity: function isWorkingValidator(uint256 index) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) IsWorkingValidator(index *big.Int) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.IsWorkingValidator(&_ValidatorSetBeforeLuban.CallOpts, index)
+}
+
+// IsWorkingValidator is a free data retrieval call binding the contract method 0x3365af3a.
+//
+// Solidity: function isWorkingValidator(uint256 index) view returns(bool)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) IsWorkingValidator(index *big.Int) (bool, error) {
+	return _ValidatorSetBeforeLuban.Contract.IsWorkingValidator(&_ValidatorSetBeforeLuban.CallOpts, index)
+}
+
+// MaintainSlashScale is a free data retrieval call binding the contract method 0x8b5ad0c9.
+//
+// Solidity: function maintainSlashScale() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) MaintainSlashScale(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "maintainSlashScale")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaintainSlashScale is a free data retrieval call binding the contract method 0x8b5ad0c9.
+//
+// Solidity: function maintainSlashScale() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) MaintainSlashScale() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaintainSlashScale(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaintainSlashScale is a free data retrieval call binding the contract method 0x8b5ad0c9.
+//
+// Solidity: function maintainSlashScale() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) MaintainSlashScale() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaintainSlashScale(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaxNumOfCandidates is a free data retrieval call binding the contract method 0xe40716a1.
+//
+// Solidity: function maxNumOfCandidates() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) MaxNumOfCandidates(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "maxNumOfCandidates")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxNumOfCandidates is a free data retrieval call binding the contract method 0xe40716a1.
+//
+// Solidity: function maxNumOfCandidates() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) MaxNumOfCandidates() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaxNumOfCandidates(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaxNumOfCandidates is a free data retrieval call binding the contract method 0xe40716a1.
+//
+// Solidity: function maxNumOfCandidates() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) MaxNumOfCandidates() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaxNumOfCandidates(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaxNumOfMaintaining is a free data retrieval call binding the contract method 0x45cf9daf.
+//
+// Solidity: function maxNumOfMaintaining() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) MaxNumOfMaintaining(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "maxNumOfMaintaining")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxNumOfMaintaining is a free data retrieval call binding the contract method 0x45cf9daf.
+//
+// Solidity: function maxNumOfMaintaining() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) MaxNumOfMaintaining() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaxNumOfMaintaining(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaxNumOfMaintaining is a free data retrieval call binding the contract method 0x45cf9daf.
+//
+// Solidity: function maxNumOfMaintaining() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) MaxNumOfMaintaining() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaxNumOfMaintaining(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaxNumOfWorkingCandidates is a free data retrieval call binding the contract method 0xf92eb86b.
+//
+// Solidity: function maxNumOfWorkingCandidates() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) MaxNumOfWorkingCandidates(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "maxNumOfWorkingCandidates")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxNumOfWorkingCandidates is a free data retrieval call binding the contract method 0xf92eb86b.
+//
+// Solidity: function maxNumOfWorkingCandidates() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) MaxNumOfWorkingCandidates() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaxNumOfWorkingCandidates(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// MaxNumOfWorkingCandidates is a free data retrieval call binding the contract method 0xf92eb86b.
+//
+// Solidity: function maxNumOfWorkingCandidates() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) MaxNumOfWorkingCandidates() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.MaxNumOfWorkingCandidates(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// NumOfCabinets is a free data retrieval call binding the contract method 0x7a84ca2a.
+//
+// Solidity: function numOfCabinets() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) NumOfCabinets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "numOfCabinets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumOfCabinets is a free data retrieval call binding the contract method 0x7a84ca2a.
+//
+// Solidity: function numOfCabinets() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) NumOfCabinets() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.NumOfCabinets(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// NumOfCabinets is a free data retrieval call binding the contract method 0x7a84ca2a.
+//
+// Solidity: function numOfCabinets() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) NumOfCabinets() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.NumOfCabinets(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// NumOfJailed is a free data retrieval call binding the contract method 0xdaacdb66.
+//
+// Solidity: function numOfJailed() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) NumOfJailed(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "numOfJailed")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumOfJailed is a free data retrieval call binding the contract method 0xdaacdb66.
+//
+// Solidity: function numOfJailed() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) NumOfJailed() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.NumOfJailed(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// NumOfJailed is a free data retrieval call binding the contract method 0xdaacdb66.
+//
+// Solidity: function numOfJailed() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) NumOfJailed() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.NumOfJailed(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// NumOfMaintaining is a free data retrieval call binding the contract method 0x07a56847.
+//
+// Solidity: function numOfMaintaining() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) NumOfMaintaining(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "numOfMaintaining")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumOfMaintaining is a free data retrieval call binding the contract method 0x07a56847.
+//
+// Solidity: function numOfMaintaining() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) NumOfMaintaining() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.NumOfMaintaining(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// NumOfMaintaining is a free data retrieval call binding the contract method 0x07a56847.
+//
+// Solidity: function numOfMaintaining() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) NumOfMaintaining() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.NumOfMaintaining(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TotalInComing is a free data retrieval call binding the contract method 0x1ff18069.
+//
+// Solidity: function totalInComing() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) TotalInComing(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "totalInComing")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalInComing is a free data retrieval call binding the contract method 0x1ff18069.
+//
+// Solidity: function totalInComing() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) TotalInComing() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.TotalInComing(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// TotalInComing is a free data retrieval call binding the contract method 0x1ff18069.
+//
+// Solidity: function totalInComing() view returns(uint256)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) TotalInComing() (*big.Int, error) {
+	return _ValidatorSetBeforeLuban.Contract.TotalInComing(&_ValidatorSetBeforeLuban.CallOpts)
+}
+
+// ValidatorExtraSet is a free data retrieval call binding the contract method 0xfd4ad81f.
+//
+// Solidity: function validatorExtraSet(uint256 ) view returns(uint256 enterMaintenanceHeight, bool isMaintaining)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCaller) ValidatorExtraSet(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	EnterMaintenanceHeight *big.Int
+	IsMaintaining          bool
+}, error) {
+	var out []interface{}
+	err := _ValidatorSetBeforeLuban.contract.Call(opts, &out, "validatorExtraSet", arg0)
+
+	outstruct := new(struct {
+		EnterMaintenanceHeight *big.Int
+		IsMaintaining          bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.EnterMaintenanceHeight = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.IsMaintaining = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// ValidatorExtraSet is a free data retrieval call binding the contract method 0xfd4ad81f.
+//
+// Solidity: function validatorExtraSet(uint256 ) view returns(uint256 enterMaintenanceHeight, bool isMaintaining)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ValidatorExtraSet(arg0 *big.Int) (struct {
+	EnterMaintenanceHeight *big.Int
+	IsMaintaining          bool
+}, error) {
+	return _ValidatorSetBeforeLuban.Contract.ValidatorExtraSet(&_ValidatorSetBeforeLuban.CallOpts, arg0)
+}
+
+// ValidatorExtraSet is a free data retrieval call binding the contract method 0xfd4ad81f.
+//
+// Solidity: function validatorExtraSet(uint256 ) view returns(uint256 enterMaintenanceHeight, bool isMaintaining)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanCallerSession) ValidatorExtraSet(arg0 *big.Int) (struct {
+	EnterMaintenanceHeight *big.Int
+	IsMaintaining          bool
+}, error) {
+	return _ValidatorSetBeforeLuban.Contract.ValidatorExtraSet(&_ValidatorSetBeforeLuban.CallOpts, arg0)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address valAddr) payable returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) Deposit(opts *bind.TransactOpts, valAddr common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "deposit", valAddr)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address valAddr) payable returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) Deposit(valAddr common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Deposit(&_ValidatorSetBeforeLuban.TransactOpts, valAddr)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xf340fa01.
+//
+// Solidity: function deposit(address valAddr) payable returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) Deposit(valAddr common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Deposit(&_ValidatorSetBeforeLuban.TransactOpts, valAddr)
+}
+
+// EnterMaintenance is a paid mutator transaction binding the contract method 0x9369d7de.
+//
+// Solidity: function enterMaintenance() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) EnterMaintenance(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "enterMaintenance")
+}
+
+// EnterMaintenance is a paid mutator transaction binding the contract method 0x9369d7de.
+//
+// Solidity: function enterMaintenance() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) EnterMaintenance() (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.EnterMaintenance(&_ValidatorSetBeforeLuban.TransactOpts)
+}
+
+// EnterMaintenance is a paid mutator transaction binding the contract method 0x9369d7de.
+//
+// Solidity: function enterMaintenance() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) EnterMaintenance() (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.EnterMaintenance(&_ValidatorSetBeforeLuban.TransactOpts)
+}
+
+// ExitMaintenance is a paid mutator transaction binding the contract method 0x04c4fec6.
+//
+// Solidity: function exitMaintenance() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) ExitMaintenance(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "exitMaintenance")
+}
+
+// ExitMaintenance is a paid mutator transaction binding the contract method 0x04c4fec6.
+//
+// Solidity: function exitMaintenance() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) ExitMaintenance() (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.ExitMaintenance(&_ValidatorSetBeforeLuban.TransactOpts)
+}
+
+// ExitMaintenance is a paid mutator transaction binding the contract method 0x04c4fec6.
+//
+// Solidity: function exitMaintenance() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) ExitMaintenance() (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.ExitMaintenance(&_ValidatorSetBeforeLuban.TransactOpts)
+}
+
+// Felony is a paid mutator transaction binding the contract method 0x35409f7f.
+//
+// Solidity: function felony(address validator) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) Felony(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "felony", validator)
+}
+
+// Felony is a paid mutator transaction binding the contract method 0x35409f7f.
+//
+// Solidity: function felony(address validator) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) Felony(validator common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Felony(&_ValidatorSetBeforeLuban.TransactOpts, validator)
+}
+
+// Felony is a paid mutator transaction binding the contract method 0x35409f7f.
+//
+// Solidity: function felony(address validator) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) Felony(validator common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Felony(&_ValidatorSetBeforeLuban.TransactOpts, validator)
+}
+
+// HandleAckPackage is a paid mutator transaction binding the contract method 0x831d65d1.
+//
+// Solidity: function handleAckPackage(uint8 channelId, bytes msgBytes) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) HandleAckPackage(opts *bind.TransactOpts, channelId uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "handleAckPackage", channelId, msgBytes)
+}
+
+// HandleAckPackage is a paid mutator transaction binding the contract method 0x831d65d1.
+//
+// Solidity: function handleAckPackage(uint8 channelId, bytes msgBytes) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) HandleAckPackage(channelId uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.HandleAckPackage(&_ValidatorSetBeforeLuban.TransactOpts, channelId, msgBytes)
+}
+
+// HandleAckPackage is a paid mutator transaction binding the contract method 0x831d65d1.
+//
+// Solidity: function handleAckPackage(uint8 channelId, bytes msgBytes) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) HandleAckPackage(channelId uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.HandleAckPackage(&_ValidatorSetBeforeLuban.TransactOpts, channelId, msgBytes)
+}
+
+// HandleFailAckPackage is a paid mutator transaction binding the contract method 0xc8509d81.
+//
+// Solidity: function handleFailAckPackage(uint8 channelId, bytes msgBytes) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) HandleFailAckPackage(opts *bind.TransactOpts, channelId uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "handleFailAckPackage", channelId, msgBytes)
+}
+
+// HandleFailAckPackage is a paid mutator transaction binding the contract method 0xc8509d81.
+//
+// Solidity: function handleFailAckPackage(uint8 channelId, bytes msgBytes) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) HandleFailAckPackage(channelId uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.HandleFailAckPackage(&_ValidatorSetBeforeLuban.TransactOpts, channelId, msgBytes)
+}
+
+// HandleFailAckPackage is a paid mutator transaction binding the contract method 0xc8509d81.
+//
+// Solidity: function handleFailAckPackage(uint8 channelId, bytes msgBytes) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) HandleFailAckPackage(channelId uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.HandleFailAckPackage(&_ValidatorSetBeforeLuban.TransactOpts, channelId, msgBytes)
+}
+
+// HandleSynPackage is a paid mutator transaction binding the contract method 0x1182b875.
+//
+// Solidity: function handleSynPackage(uint8 , bytes msgBytes) returns(bytes responsePayload)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) HandleSynPackage(opts *bind.TransactOpts, arg0 uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "handleSynPackage", arg0, msgBytes)
+}
+
+// HandleSynPackage is a paid mutator transaction binding the contract method 0x1182b875.
+//
+// Solidity: function handleSynPackage(uint8 , bytes msgBytes) returns(bytes responsePayload)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) HandleSynPackage(arg0 uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.HandleSynPackage(&_ValidatorSetBeforeLuban.TransactOpts, arg0, msgBytes)
+}
+
+// HandleSynPackage is a paid mutator transaction binding the contract method 0x1182b875.
+//
+// Solidity: function handleSynPackage(uint8 , bytes msgBytes) returns(bytes responsePayload)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) HandleSynPackage(arg0 uint8, msgBytes []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.HandleSynPackage(&_ValidatorSetBeforeLuban.TransactOpts, arg0, msgBytes)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) Init(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "init")
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) Init() (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Init(&_ValidatorSetBeforeLuban.TransactOpts)
+}
+
+// Init is a paid mutator transaction binding the contract method 0xe1c7392a.
+//
+// Solidity: function init() returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) Init() (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Init(&_ValidatorSetBeforeLuban.TransactOpts)
+}
+
+// Misdemeanor is a paid mutator transaction binding the contract method 0xeb57e202.
+//
+// Solidity: function misdemeanor(address validator) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) Misdemeanor(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "misdemeanor", validator)
+}
+
+// Misdemeanor is a paid mutator transaction binding the contract method 0xeb57e202.
+//
+// Solidity: function misdemeanor(address validator) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) Misdemeanor(validator common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Misdemeanor(&_ValidatorSetBeforeLuban.TransactOpts, validator)
+}
+
+// Misdemeanor is a paid mutator transaction binding the contract method 0xeb57e202.
+//
+// Solidity: function misdemeanor(address validator) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) Misdemeanor(validator common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.Misdemeanor(&_ValidatorSetBeforeLuban.TransactOpts, validator)
+}
+
+// UpdateContractAddr is a paid mutator transaction binding the contract method 0x00b456b5.
+//
+// Solidity: function updateContractAddr(address valAddr, address slashAddr, address rewardAddr, address lightAddr, address tokenHubAddr, address incentivizeAddr, address relayerHubAddr, address govHub, address tokenManagerAddr, address crossChain) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) UpdateContractAddr(opts *bind.TransactOpts, valAddr common.Address, slashAddr common.Address, rewardAddr common.Address, lightAddr common.Address, tokenHubAddr common.Address, incentivizeAddr common.Address, relayerHubAddr common.Address, govHub common.Address, tokenManagerAddr common.Address, crossChain common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "updateContractAddr", valAddr, slashAddr, rewardAddr, lightAddr, tokenHubAddr, incentivizeAddr, relayerHubAddr, govHub, tokenManagerAddr, crossChain)
+}
+
+// UpdateContractAddr is a paid mutator transaction binding the contract method 0x00b456b5.
+//
+// Solidity: function updateContractAddr(address valAddr, address slashAddr, address rewardAddr, address lightAddr, address tokenHubAddr, address incentivizeAddr, address relayerHubAddr, address govHub, address tokenManagerAddr, address crossChain) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) UpdateContractAddr(valAddr common.Address, slashAddr common.Address, rewardAddr common.Address, lightAddr common.Address, tokenHubAddr common.Address, incentivizeAddr common.Address, relayerHubAddr common.Address, govHub common.Address, tokenManagerAddr common.Address, crossChain common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.UpdateContractAddr(&_ValidatorSetBeforeLuban.TransactOpts, valAddr, slashAddr, rewardAddr, lightAddr, tokenHubAddr, incentivizeAddr, relayerHubAddr, govHub, tokenManagerAddr, crossChain)
+}
+
+// UpdateContractAddr is a paid mutator transaction binding the contract method 0x00b456b5.
+//
+// Solidity: function updateContractAddr(address valAddr, address slashAddr, address rewardAddr, address lightAddr, address tokenHubAddr, address incentivizeAddr, address relayerHubAddr, address govHub, address tokenManagerAddr, address crossChain) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) UpdateContractAddr(valAddr common.Address, slashAddr common.Address, rewardAddr common.Address, lightAddr common.Address, tokenHubAddr common.Address, incentivizeAddr common.Address, relayerHubAddr common.Address, govHub common.Address, tokenManagerAddr common.Address, crossChain common.Address) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.UpdateContractAddr(&_ValidatorSetBeforeLuban.TransactOpts, valAddr, slashAddr, rewardAddr, lightAddr, tokenHubAddr, incentivizeAddr, relayerHubAddr, govHub, tokenManagerAddr, crossChain)
+}
+
+// UpdateParam is a paid mutator transaction binding the contract method 0xac431751.
+//
+// Solidity: function updateParam(string key, bytes value) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactor) UpdateParam(opts *bind.TransactOpts, key string, value []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.contract.Transact(opts, "updateParam", key, value)
+}
+
+// UpdateParam is a paid mutator transaction binding the contract method 0xac431751.
+//
+// Solidity: function updateParam(string key, bytes value) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanSession) UpdateParam(key string, value []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.UpdateParam(&_ValidatorSetBeforeLuban.TransactOpts, key, value)
+}
+
+// UpdateParam is a paid mutator transaction binding the contract method 0xac431751.
+//
+// Solidity: function updateParam(string key, bytes value) returns()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanTransactorSession) UpdateParam(key string, value []byte) (*types.Transaction, error) {
+	return _ValidatorSetBeforeLuban.Contract.UpdateParam(&_ValidatorSetBeforeLuban.TransactOpts, key, value)
+}
+
+// ValidatorSetBeforeLubanBatchTransferIterator is returned from FilterBatchTransfer and is used to iterate over the raw logs and unpacked data for BatchTransfer events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanBatchTransferIterator struct {
+	Event *ValidatorSetBeforeLubanBatchTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanBatchTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanBatchTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanBatchTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanBatchTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanBatchTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanBatchTransfer represents a BatchTransfer event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanBatchTransfer struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchTransfer is a free log retrieval operation binding the contract event 0xa217d08e65f80c73121cd9db834d81652d544bfbf452f6d04922b16c90a37b70.
+//
+// Solidity: event batchTransfer(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterBatchTransfer(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanBatchTransferIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "batchTransfer")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanBatchTransferIterator{contract: _ValidatorSetBeforeLuban.contract, event: "batchTransfer", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchTransfer is a free log subscription operation binding the contract event 0xa217d08e65f80c73121cd9db834d81652d544bfbf452f6d04922b16c90a37b70.
+//
+// Solidity: event batchTransfer(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchBatchTransfer(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanBatchTransfer) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "batchTransfer")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanBatchTransfer)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "batchTransfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchTransfer is a log parse operation binding the contract event 0xa217d08e65f80c73121cd9db834d81652d544bfbf452f6d04922b16c90a37b70.
+//
+// Solidity: event batchTransfer(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseBatchTransfer(log types.Log) (*ValidatorSetBeforeLubanBatchTransfer, error) {
+	event := new(ValidatorSetBeforeLubanBatchTransfer)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "batchTransfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanBatchTransferFailedIterator is returned from FilterBatchTransferFailed and is used to iterate over the raw logs and unpacked data for BatchTransferFailed events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanBatchTransferFailedIterator struct {
+	Event *ValidatorSetBeforeLubanBatchTransferFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanBatchTransferFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanBatchTransferFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanBatchTransferFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanBatchTransferFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanBatchTransferFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanBatchTransferFailed represents a BatchTransferFailed event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanBatchTransferFailed struct {
+	Amount *big.Int
+	Reason string
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchTransferFailed is a free log retrieval operation binding the contract event 0xa7cdeed7d0db45e3219a6e5d60838824c16f1d39991fcfe3f963029c844bf280.
+//
+// Solidity: event batchTransferFailed(uint256 indexed amount, string reason)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterBatchTransferFailed(opts *bind.FilterOpts, amount []*big.Int) (*ValidatorSetBeforeLubanBatchTransferFailedIterator, error) {
+
+	var amountRule []interface{}
+	for _, amountItem := range amount {
+		amountRule = append(amountRule, amountItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "batchTransferFailed", amountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanBatchTransferFailedIterator{contract: _ValidatorSetBeforeLuban.contract, event: "batchTransferFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchTransferFailed is a free log subscription operation binding the contract event 0xa7cdeed7d0db45e3219a6e5d60838824c16f1d39991fcfe3f963029c844bf280.
+//
+// Solidity: event batchTransferFailed(uint256 indexed amount, string reason)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchBatchTransferFailed(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanBatchTransferFailed, amount []*big.Int) (event.Subscription, error) {
+
+	var amountRule []interface{}
+	for _, amountItem := range amount {
+		amountRule = append(amountRule, amountItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "batchTransferFailed", amountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanBatchTransferFailed)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "batchTransferFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchTransferFailed is a log parse operation binding the contract event 0xa7cdeed7d0db45e3219a6e5d60838824c16f1d39991fcfe3f963029c844bf280.
+//
+// Solidity: event batchTransferFailed(uint256 indexed amount, string reason)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseBatchTransferFailed(log types.Log) (*ValidatorSetBeforeLubanBatchTransferFailed, error) {
+	event := new(ValidatorSetBeforeLubanBatchTransferFailed)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "batchTransferFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanBatchTransferLowerFailedIterator is returned from FilterBatchTransferLowerFailed and is used to iterate over the raw logs and unpacked data for BatchTransferLowerFailed events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanBatchTransferLowerFailedIterator struct {
+	Event *ValidatorSetBeforeLubanBatchTransferLowerFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanBatchTransferLowerFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanBatchTransferLowerFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanBatchTransferLowerFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanBatchTransferLowerFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanBatchTransferLowerFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanBatchTransferLowerFailed represents a BatchTransferLowerFailed event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanBatchTransferLowerFailed struct {
+	Amount *big.Int
+	Reason []byte
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchTransferLowerFailed is a free log retrieval operation binding the contract event 0xbfa884552dd8921b6ce90bfe906952ae5b3b29be0cc1a951d4f62697635a3a45.
+//
+// Solidity: event batchTransferLowerFailed(uint256 indexed amount, bytes reason)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterBatchTransferLowerFailed(opts *bind.FilterOpts, amount []*big.Int) (*ValidatorSetBeforeLubanBatchTransferLowerFailedIterator, error) {
+
+	var amountRule []interface{}
+	for _, amountItem := range amount {
+		amountRule = append(amountRule, amountItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "batchTransferLowerFailed", amountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanBatchTransferLowerFailedIterator{contract: _ValidatorSetBeforeLuban.contract, event: "batchTransferLowerFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchTransferLowerFailed is a free log subscription operation binding the contract event 0xbfa884552dd8921b6ce90bfe906952ae5b3b29be0cc1a951d4f62697635a3a45.
+//
+// Solidity: event batchTransferLowerFailed(uint256 indexed amount, bytes reason)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchBatchTransferLowerFailed(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanBatchTransferLowerFailed, amount []*big.Int) (event.Subscription, error) {
+
+	var amountRule []interface{}
+	for _, amountItem := range amount {
+		amountRule = append(amountRule, amountItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "batchTransferLowerFailed", amountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanBatchTransferLowerFailed)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "batchTransferLowerFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchTransferLowerFailed is a log parse operation binding the contract event 0xbfa884552dd8921b6ce90bfe906952ae5b3b29be0cc1a951d4f62697635a3a45.
+//
+// Solidity: event batchTransferLowerFailed(uint256 indexed amount, bytes reason)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseBatchTransferLowerFailed(log types.Log) (*ValidatorSetBeforeLubanBatchTransferLowerFailed, error) {
+	event := new(ValidatorSetBeforeLubanBatchTransferLowerFailed)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "batchTransferLowerFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanDeprecatedDepositIterator is returned from FilterDeprecatedDeposit and is used to iterate over the raw logs and unpacked data for DeprecatedDeposit events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanDeprecatedDepositIterator struct {
+	Event *ValidatorSetBeforeLubanDeprecatedDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanDeprecatedDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanDeprecatedDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanDeprecatedDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanDeprecatedDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanDeprecatedDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanDeprecatedDeposit represents a DeprecatedDeposit event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanDeprecatedDeposit struct {
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeprecatedDeposit is a free log retrieval operation binding the contract event 0xf177e5d6c5764d79c32883ed824111d9b13f5668cf6ab1cc12dd36791dd955b4.
+//
+// Solidity: event deprecatedDeposit(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterDeprecatedDeposit(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanDeprecatedDepositIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "deprecatedDeposit", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanDeprecatedDepositIterator{contract: _ValidatorSetBeforeLuban.contract, event: "deprecatedDeposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeprecatedDeposit is a free log subscription operation binding the contract event 0xf177e5d6c5764d79c32883ed824111d9b13f5668cf6ab1cc12dd36791dd955b4.
+//
+// Solidity: event deprecatedDeposit(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchDeprecatedDeposit(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanDeprecatedDeposit, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "deprecatedDeposit", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanDeprecatedDeposit)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "deprecatedDeposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeprecatedDeposit is a log parse operation binding the contract event 0xf177e5d6c5764d79c32883ed824111d9b13f5668cf6ab1cc12dd36791dd955b4.
+//
+// Solidity: event deprecatedDeposit(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseDeprecatedDeposit(log types.Log) (*ValidatorSetBeforeLubanDeprecatedDeposit, error) {
+	event := new(ValidatorSetBeforeLubanDeprecatedDeposit)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "deprecatedDeposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanDirectTransferIterator is returned from FilterDirectTransfer and is used to iterate over the raw logs and unpacked data for DirectTransfer events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanDirectTransferIterator struct {
+	Event *ValidatorSetBeforeLubanDirectTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanDirectTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanDirectTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanDirectTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanDirectTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanDirectTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanDirectTransfer represents a DirectTransfer event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanDirectTransfer struct {
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDirectTransfer is a free log retrieval operation binding the contract event 0x6c61d60f69a7beb3e1c80db7f39f37b208537cbb19da3174511b477812b2fc7d.
+//
+// Solidity: event directTransfer(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterDirectTransfer(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanDirectTransferIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "directTransfer", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanDirectTransferIterator{contract: _ValidatorSetBeforeLuban.contract, event: "directTransfer", logs: logs, sub: sub}, nil
+}
+
+// WatchDirectTransfer is a free log subscription operation binding the contract event 0x6c61d60f69a7beb3e1c80db7f39f37b208537cbb19da3174511b477812b2fc7d.
+//
+// Solidity: event directTransfer(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchDirectTransfer(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanDirectTransfer, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "directTransfer", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanDirectTransfer)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "directTransfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDirectTransfer is a log parse operation binding the contract event 0x6c61d60f69a7beb3e1c80db7f39f37b208537cbb19da3174511b477812b2fc7d.
+//
+// Solidity: event directTransfer(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseDirectTransfer(log types.Log) (*ValidatorSetBeforeLubanDirectTransfer, error) {
+	event := new(ValidatorSetBeforeLubanDirectTransfer)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "directTransfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanDirectTransferFailIterator is returned from FilterDirectTransferFail and is used to iterate over the raw logs and unpacked data for DirectTransferFail events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanDirectTransferFailIterator struct {
+	Event *ValidatorSetBeforeLubanDirectTransferFail // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanDirectTransferFailIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanDirectTransferFail)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanDirectTransferFail)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanDirectTransferFailIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanDirectTransferFailIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanDirectTransferFail represents a DirectTransferFail event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanDirectTransferFail struct {
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDirectTransferFail is a free log retrieval operation binding the contract event 0x25d0ce7d2f0cec669a8c17efe49d195c13455bb8872b65fa610ac7f53fe4ca7d.
+//
+// Solidity: event directTransferFail(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterDirectTransferFail(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanDirectTransferFailIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "directTransferFail", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanDirectTransferFailIterator{contract: _ValidatorSetBeforeLuban.contract, event: "directTransferFail", logs: logs, sub: sub}, nil
+}
+
+// WatchDirectTransferFail is a free log subscription operation binding the contract event 0x25d0ce7d2f0cec669a8c17efe49d195c13455bb8872b65fa610ac7f53fe4ca7d.
+//
+// Solidity: event directTransferFail(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchDirectTransferFail(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanDirectTransferFail, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "directTransferFail", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanDirectTransferFail)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "directTransferFail", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDirectTransferFail is a log parse operation binding the contract event 0x25d0ce7d2f0cec669a8c17efe49d195c13455bb8872b65fa610ac7f53fe4ca7d.
+//
+// Solidity: event directTransferFail(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseDirectTransferFail(log types.Log) (*ValidatorSetBeforeLubanDirectTransferFail, error) {
+	event := new(ValidatorSetBeforeLubanDirectTransferFail)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "directTransferFail", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanFailReasonWithStrIterator is returned from FilterFailReasonWithStr and is used to iterate over the raw logs and unpacked data for FailReasonWithStr events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanFailReasonWithStrIterator struct {
+	Event *ValidatorSetBeforeLubanFailReasonWithStr // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanFailReasonWithStrIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanFailReasonWithStr)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanFailReasonWithStr)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanFailReasonWithStrIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanFailReasonWithStrIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanFailReasonWithStr represents a FailReasonWithStr event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanFailReasonWithStr struct {
+	Message string
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterFailReasonWithStr is a free log retrieval operation binding the contract event 0x70e72399380dcfb0338abc03dc8d47f9f470ada8e769c9a78d644ea97385ecb2.
+//
+// Solidity: event failReasonWithStr(string message)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterFailReasonWithStr(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanFailReasonWithStrIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "failReasonWithStr")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanFailReasonWithStrIterator{contract: _ValidatorSetBeforeLuban.contract, event: "failReasonWithStr", logs: logs, sub: sub}, nil
+}
+
+// WatchFailReasonWithStr is a free log subscription operation binding the contract event 0x70e72399380dcfb0338abc03dc8d47f9f470ada8e769c9a78d644ea97385ecb2.
+//
+// Solidity: event failReasonWithStr(string message)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchFailReasonWithStr(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanFailReasonWithStr) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "failReasonWithStr")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanFailReasonWithStr)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "failReasonWithStr", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFailReasonWithStr is a log parse operation binding the contract event 0x70e72399380dcfb0338abc03dc8d47f9f470ada8e769c9a78d644ea97385ecb2.
+//
+// Solidity: event failReasonWithStr(string message)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseFailReasonWithStr(log types.Log) (*ValidatorSetBeforeLubanFailReasonWithStr, error) {
+	event := new(ValidatorSetBeforeLubanFailReasonWithStr)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "failReasonWithStr", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanFeeBurnedIterator is returned from FilterFeeBurned and is used to iterate over the raw logs and unpacked data for FeeBurned events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanFeeBurnedIterator struct {
+	Event *ValidatorSetBeforeLubanFeeBurned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanFeeBurnedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanFeeBurned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanFeeBurned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanFeeBurnedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanFeeBurnedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanFeeBurned represents a FeeBurned event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanFeeBurned struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeBurned is a free log retrieval operation binding the contract event 0x627059660ea01c4733a328effb2294d2f86905bf806da763a89cee254de8bee5.
+//
+// Solidity: event feeBurned(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterFeeBurned(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanFeeBurnedIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "feeBurned")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanFeeBurnedIterator{contract: _ValidatorSetBeforeLuban.contract, event: "feeBurned", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeBurned is a free log subscription operation binding the contract event 0x627059660ea01c4733a328effb2294d2f86905bf806da763a89cee254de8bee5.
+//
+// Solidity: event feeBurned(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchFeeBurned(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanFeeBurned) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "feeBurned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanFeeBurned)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "feeBurned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeBurned is a log parse operation binding the contract event 0x627059660ea01c4733a328effb2294d2f86905bf806da763a89cee254de8bee5.
+//
+// Solidity: event feeBurned(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseFeeBurned(log types.Log) (*ValidatorSetBeforeLubanFeeBurned, error) {
+	event := new(ValidatorSetBeforeLubanFeeBurned)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "feeBurned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanParamChangeIterator is returned from FilterParamChange and is used to iterate over the raw logs and unpacked data for ParamChange events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanParamChangeIterator struct {
+	Event *ValidatorSetBeforeLubanParamChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanParamChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanParamChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanParamChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanParamChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanParamChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanParamChange represents a ParamChange event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanParamChange struct {
+	Key   string
+	Value []byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterParamChange is a free log retrieval operation binding the contract event 0x6cdb0ac70ab7f2e2d035cca5be60d89906f2dede7648ddbd7402189c1eeed17a.
+//
+// Solidity: event paramChange(string key, bytes value)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterParamChange(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanParamChangeIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "paramChange")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanParamChangeIterator{contract: _ValidatorSetBeforeLuban.contract, event: "paramChange", logs: logs, sub: sub}, nil
+}
+
+// WatchParamChange is a free log subscription operation binding the contract event 0x6cdb0ac70ab7f2e2d035cca5be60d89906f2dede7648ddbd7402189c1eeed17a.
+//
+// Solidity: event paramChange(string key, bytes value)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchParamChange(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanParamChange) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "paramChange")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanParamChange)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "paramChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParamChange is a log parse operation binding the contract event 0x6cdb0ac70ab7f2e2d035cca5be60d89906f2dede7648ddbd7402189c1eeed17a.
+//
+// Solidity: event paramChange(string key, bytes value)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseParamChange(log types.Log) (*ValidatorSetBeforeLubanParamChange, error) {
+	event := new(ValidatorSetBeforeLubanParamChange)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "paramChange", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanSystemTransferIterator is returned from FilterSystemTransfer and is used to iterate over the raw logs and unpacked data for SystemTransfer events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanSystemTransferIterator struct {
+	Event *ValidatorSetBeforeLubanSystemTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanSystemTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanSystemTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanSystemTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanSystemTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanSystemTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanSystemTransfer represents a SystemTransfer event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanSystemTransfer struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSystemTransfer is a free log retrieval operation binding the contract event 0x6ecc855f9440a9282c90913bbc91619fd44f5ec0b462af28d127b116f130aa4d.
+//
+// Solidity: event systemTransfer(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterSystemTransfer(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanSystemTransferIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "systemTransfer")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanSystemTransferIterator{contract: _ValidatorSetBeforeLuban.contract, event: "systemTransfer", logs: logs, sub: sub}, nil
+}
+
+// WatchSystemTransfer is a free log subscription operation binding the contract event 0x6ecc855f9440a9282c90913bbc91619fd44f5ec0b462af28d127b116f130aa4d.
+//
+// Solidity: event systemTransfer(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchSystemTransfer(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanSystemTransfer) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "systemTransfer")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanSystemTransfer)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "systemTransfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSystemTransfer is a log parse operation binding the contract event 0x6ecc855f9440a9282c90913bbc91619fd44f5ec0b462af28d127b116f130aa4d.
+//
+// Solidity: event systemTransfer(uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseSystemTransfer(log types.Log) (*ValidatorSetBeforeLubanSystemTransfer, error) {
+	event := new(ValidatorSetBeforeLubanSystemTransfer)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "systemTransfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanUnexpectedPackageIterator is returned from FilterUnexpectedPackage and is used to iterate over the raw logs and unpacked data for UnexpectedPackage events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanUnexpectedPackageIterator struct {
+	Event *ValidatorSetBeforeLubanUnexpectedPackage // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanUnexpectedPackageIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanUnexpectedPackage)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanUnexpectedPackage)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanUnexpectedPackageIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanUnexpectedPackageIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanUnexpectedPackage represents a UnexpectedPackage event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanUnexpectedPackage struct {
+	ChannelId uint8
+	MsgBytes  []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnexpectedPackage is a free log retrieval operation binding the contract event 0x41ce201247b6ceb957dcdb217d0b8acb50b9ea0e12af9af4f5e7f38902101605.
+//
+// Solidity: event unexpectedPackage(uint8 channelId, bytes msgBytes)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterUnexpectedPackage(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanUnexpectedPackageIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "unexpectedPackage")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanUnexpectedPackageIterator{contract: _ValidatorSetBeforeLuban.contract, event: "unexpectedPackage", logs: logs, sub: sub}, nil
+}
+
+// WatchUnexpectedPackage is a free log subscription operation binding the contract event 0x41ce201247b6ceb957dcdb217d0b8acb50b9ea0e12af9af4f5e7f38902101605.
+//
+// Solidity: event unexpectedPackage(uint8 channelId, bytes msgBytes)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchUnexpectedPackage(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanUnexpectedPackage) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "unexpectedPackage")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanUnexpectedPackage)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "unexpectedPackage", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnexpectedPackage is a log parse operation binding the contract event 0x41ce201247b6ceb957dcdb217d0b8acb50b9ea0e12af9af4f5e7f38902101605.
+//
+// Solidity: event unexpectedPackage(uint8 channelId, bytes msgBytes)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseUnexpectedPackage(log types.Log) (*ValidatorSetBeforeLubanUnexpectedPackage, error) {
+	event := new(ValidatorSetBeforeLubanUnexpectedPackage)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "unexpectedPackage", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorDepositIterator is returned from FilterValidatorDeposit and is used to iterate over the raw logs and unpacked data for ValidatorDeposit events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorDepositIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorDeposit represents a ValidatorDeposit event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorDeposit struct {
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorDeposit is a free log retrieval operation binding the contract event 0x93a090ecc682c002995fad3c85b30c5651d7fd29b0be5da9d784a3302aedc055.
+//
+// Solidity: event validatorDeposit(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorDeposit(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorDepositIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorDeposit", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorDepositIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorDeposit", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorDeposit is a free log subscription operation binding the contract event 0x93a090ecc682c002995fad3c85b30c5651d7fd29b0be5da9d784a3302aedc055.
+//
+// Solidity: event validatorDeposit(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorDeposit(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorDeposit, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorDeposit", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorDeposit)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorDeposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorDeposit is a log parse operation binding the contract event 0x93a090ecc682c002995fad3c85b30c5651d7fd29b0be5da9d784a3302aedc055.
+//
+// Solidity: event validatorDeposit(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorDeposit(log types.Log) (*ValidatorSetBeforeLubanValidatorDeposit, error) {
+	event := new(ValidatorSetBeforeLubanValidatorDeposit)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorDeposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorEmptyJailedIterator is returned from FilterValidatorEmptyJailed and is used to iterate over the raw logs and unpacked data for ValidatorEmptyJailed events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorEmptyJailedIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorEmptyJailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorEmptyJailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorEmptyJailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorEmptyJailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorEmptyJailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorEmptyJailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorEmptyJailed represents a ValidatorEmptyJailed event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorEmptyJailed struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorEmptyJailed is a free log retrieval operation binding the contract event 0xe209c46bebf57cf265d5d9009a00870e256d9150f3ed5281ab9d9eb3cec6e4be.
+//
+// Solidity: event validatorEmptyJailed(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorEmptyJailed(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorEmptyJailedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorEmptyJailed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorEmptyJailedIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorEmptyJailed", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorEmptyJailed is a free log subscription operation binding the contract event 0xe209c46bebf57cf265d5d9009a00870e256d9150f3ed5281ab9d9eb3cec6e4be.
+//
+// Solidity: event validatorEmptyJailed(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorEmptyJailed(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorEmptyJailed, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorEmptyJailed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorEmptyJailed)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorEmptyJailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorEmptyJailed is a log parse operation binding the contract event 0xe209c46bebf57cf265d5d9009a00870e256d9150f3ed5281ab9d9eb3cec6e4be.
+//
+// Solidity: event validatorEmptyJailed(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorEmptyJailed(log types.Log) (*ValidatorSetBeforeLubanValidatorEmptyJailed, error) {
+	event := new(ValidatorSetBeforeLubanValidatorEmptyJailed)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorEmptyJailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator is returned from FilterValidatorEnterMaintenance and is used to iterate over the raw logs and unpacked data for ValidatorEnterMaintenance events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorEnterMaintenance // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorEnterMaintenance)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorEnterMaintenance)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorEnterMaintenance represents a ValidatorEnterMaintenance event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorEnterMaintenance struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorEnterMaintenance is a free log retrieval operation binding the contract event 0xf62981a567ec3cec866c6fa93c55bcdf841d6292d18b8d522ececa769375d82d.
+//
+// Solidity: event validatorEnterMaintenance(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorEnterMaintenance(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorEnterMaintenance", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorEnterMaintenanceIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorEnterMaintenance", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorEnterMaintenance is a free log subscription operation binding the contract event 0xf62981a567ec3cec866c6fa93c55bcdf841d6292d18b8d522ececa769375d82d.
+//
+// Solidity: event validatorEnterMaintenance(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorEnterMaintenance(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorEnterMaintenance, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorEnterMaintenance", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorEnterMaintenance)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorEnterMaintenance", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorEnterMaintenance is a log parse operation binding the contract event 0xf62981a567ec3cec866c6fa93c55bcdf841d6292d18b8d522ececa769375d82d.
+//
+// Solidity: event validatorEnterMaintenance(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorEnterMaintenance(log types.Log) (*ValidatorSetBeforeLubanValidatorEnterMaintenance, error) {
+	event := new(ValidatorSetBeforeLubanValidatorEnterMaintenance)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorEnterMaintenance", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorExitMaintenanceIterator is returned from FilterValidatorExitMaintenance and is used to iterate over the raw logs and unpacked data for ValidatorExitMaintenance events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorExitMaintenanceIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorExitMaintenance // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorExitMaintenanceIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorExitMaintenance)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorExitMaintenance)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorExitMaintenanceIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorExitMaintenanceIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorExitMaintenance represents a ValidatorExitMaintenance event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorExitMaintenance struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorExitMaintenance is a free log retrieval operation binding the contract event 0xb9d38178dc641ff1817967a63c9078cbcd955a9f1fcd75e0e3636de615d44d3b.
+//
+// Solidity: event validatorExitMaintenance(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorExitMaintenance(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorExitMaintenanceIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorExitMaintenance", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorExitMaintenanceIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorExitMaintenance", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorExitMaintenance is a free log subscription operation binding the contract event 0xb9d38178dc641ff1817967a63c9078cbcd955a9f1fcd75e0e3636de615d44d3b.
+//
+// Solidity: event validatorExitMaintenance(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorExitMaintenance(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorExitMaintenance, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorExitMaintenance", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorExitMaintenance)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorExitMaintenance", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorExitMaintenance is a log parse operation binding the contract event 0xb9d38178dc641ff1817967a63c9078cbcd955a9f1fcd75e0e3636de615d44d3b.
+//
+// Solidity: event validatorExitMaintenance(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorExitMaintenance(log types.Log) (*ValidatorSetBeforeLubanValidatorExitMaintenance, error) {
+	event := new(ValidatorSetBeforeLubanValidatorExitMaintenance)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorExitMaintenance", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorFelonyIterator is returned from FilterValidatorFelony and is used to iterate over the raw logs and unpacked data for ValidatorFelony events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorFelonyIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorFelony // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorFelonyIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorFelony)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorFelony)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorFelonyIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorFelonyIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorFelony represents a ValidatorFelony event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorFelony struct {
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorFelony is a free log retrieval operation binding the contract event 0x3b6f9ef90462b512a1293ecec018670bf7b7f1876fb727590a8a6d7643130a70.
+//
+// Solidity: event validatorFelony(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorFelony(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorFelonyIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorFelony", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorFelonyIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorFelony", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorFelony is a free log subscription operation binding the contract event 0x3b6f9ef90462b512a1293ecec018670bf7b7f1876fb727590a8a6d7643130a70.
+//
+// Solidity: event validatorFelony(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorFelony(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorFelony, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorFelony", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorFelony)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorFelony", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorFelony is a log parse operation binding the contract event 0x3b6f9ef90462b512a1293ecec018670bf7b7f1876fb727590a8a6d7643130a70.
+//
+// Solidity: event validatorFelony(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorFelony(log types.Log) (*ValidatorSetBeforeLubanValidatorFelony, error) {
+	event := new(ValidatorSetBeforeLubanValidatorFelony)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorFelony", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorJailedIterator is returned from FilterValidatorJailed and is used to iterate over the raw logs and unpacked data for ValidatorJailed events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorJailedIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorJailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorJailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorJailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorJailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorJailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorJailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorJailed represents a ValidatorJailed event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorJailed struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorJailed is a free log retrieval operation binding the contract event 0xf226e7d8f547ff903d9d419cf5f54e0d7d07efa9584135a53a057c5f1f27f49a.
+//
+// Solidity: event validatorJailed(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorJailed(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorJailedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorJailed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorJailedIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorJailed", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorJailed is a free log subscription operation binding the contract event 0xf226e7d8f547ff903d9d419cf5f54e0d7d07efa9584135a53a057c5f1f27f49a.
+//
+// Solidity: event validatorJailed(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorJailed(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorJailed, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorJailed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorJailed)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorJailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorJailed is a log parse operation binding the contract event 0xf226e7d8f547ff903d9d419cf5f54e0d7d07efa9584135a53a057c5f1f27f49a.
+//
+// Solidity: event validatorJailed(address indexed validator)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorJailed(log types.Log) (*ValidatorSetBeforeLubanValidatorJailed, error) {
+	event := new(ValidatorSetBeforeLubanValidatorJailed)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorJailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorMisdemeanorIterator is returned from FilterValidatorMisdemeanor and is used to iterate over the raw logs and unpacked data for ValidatorMisdemeanor events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorMisdemeanorIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorMisdemeanor // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorMisdemeanorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorMisdemeanor)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorMisdemeanor)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorMisdemeanorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorMisdemeanorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorMisdemeanor represents a ValidatorMisdemeanor event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorMisdemeanor struct {
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorMisdemeanor is a free log retrieval operation binding the contract event 0x8cd4e147d8af98a9e3b6724021b8bf6aed2e5dac71c38f2dce8161b82585b25d.
+//
+// Solidity: event validatorMisdemeanor(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorMisdemeanor(opts *bind.FilterOpts, validator []common.Address) (*ValidatorSetBeforeLubanValidatorMisdemeanorIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorMisdemeanor", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorMisdemeanorIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorMisdemeanor", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorMisdemeanor is a free log subscription operation binding the contract event 0x8cd4e147d8af98a9e3b6724021b8bf6aed2e5dac71c38f2dce8161b82585b25d.
+//
+// Solidity: event validatorMisdemeanor(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorMisdemeanor(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorMisdemeanor, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorMisdemeanor", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorMisdemeanor)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorMisdemeanor", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorMisdemeanor is a log parse operation binding the contract event 0x8cd4e147d8af98a9e3b6724021b8bf6aed2e5dac71c38f2dce8161b82585b25d.
+//
+// Solidity: event validatorMisdemeanor(address indexed validator, uint256 amount)
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorMisdemeanor(log types.Log) (*ValidatorSetBeforeLubanValidatorMisdemeanor, error) {
+	event := new(ValidatorSetBeforeLubanValidatorMisdemeanor)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorMisdemeanor", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorSetBeforeLubanValidatorSetUpdatedIterator is returned from FilterValidatorSetUpdated and is used to iterate over the raw logs and unpacked data for ValidatorSetUpdated events raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorSetUpdatedIterator struct {
+	Event *ValidatorSetBeforeLubanValidatorSetUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorSetBeforeLubanValidatorSetUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorSetBeforeLubanValidatorSetUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorSetBeforeLubanValidatorSetUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorSetBeforeLubanValidatorSetUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorSetBeforeLubanValidatorSetUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorSetBeforeLubanValidatorSetUpdated represents a ValidatorSetUpdated event raised by the ValidatorSetBeforeLuban contract.
+type ValidatorSetBeforeLubanValidatorSetUpdated struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorSetUpdated is a free log retrieval operation binding the contract event 0xedd8d7296956dd970ab4de3f2fc03be2b0ffc615d20cd4c72c6e44f928630ebf.
+//
+// Solidity: event validatorSetUpdated()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) FilterValidatorSetUpdated(opts *bind.FilterOpts) (*ValidatorSetBeforeLubanValidatorSetUpdatedIterator, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.FilterLogs(opts, "validatorSetUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorSetBeforeLubanValidatorSetUpdatedIterator{contract: _ValidatorSetBeforeLuban.contract, event: "validatorSetUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorSetUpdated is a free log subscription operation binding the contract event 0xedd8d7296956dd970ab4de3f2fc03be2b0ffc615d20cd4c72c6e44f928630ebf.
+//
+// Solidity: event validatorSetUpdated()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) WatchValidatorSetUpdated(opts *bind.WatchOpts, sink chan<- *ValidatorSetBeforeLubanValidatorSetUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorSetBeforeLuban.contract.WatchLogs(opts, "validatorSetUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorSetBeforeLubanValidatorSetUpdated)
+				if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorSetUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorSetUpdated is a log parse operation binding the contract event 0xedd8d7296956dd970ab4de3f2fc03be2b0ffc615d20cd4c72c6e44f928630ebf.
+//
+// Solidity: event validatorSetUpdated()
+func (_ValidatorSetBeforeLuban *ValidatorSetBeforeLubanFilterer) ParseValidatorSetUpdated(log types.Log) (*ValidatorSetBeforeLubanValidatorSetUpdated, error) {
+	event := new(ValidatorSetBeforeLubanValidatorSetUpdated)
+	if err := _ValidatorSetBeforeLuban.contract.UnpackLog(event, "validatorSetUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
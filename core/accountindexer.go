@@ -0,0 +1,175 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// errAccountIndexDisabled is returned if account changes are requested from a
+	// chain which doesn't index them.
+	errAccountIndexDisabled = errors.New("account change indexer is not enabled")
+
+	// ErrTooManyAccountChanges is returned if an account changed in more blocks of
+	// the requested range than allowed to be returned.
+	ErrTooManyAccountChanges = errors.New("too many account changes, narrow the block range")
+)
+
+// accountIndexer is the module responsible for maintaining the index of the
+// blocks changing the balance or nonce of every account.
+//
+// Unlike transactions, the changes can't be derived from the block bodies. They
+// are recorded from the state diffs of the blocks processed by the chain, hence
+// the index only starts at the head the indexer was first enabled at. The
+// indexer is in charge of unindexing the changes falling out of the configured
+// range.
+type accountIndexer struct {
+	// limit is the maximum number of blocks from head whose account changes
+	// are reserved:
+	//  * 0: means all the changes recorded are kept
+	//  * N: means the changes of the latest N blocks [HEAD-N+1, HEAD] are
+	//       kept and all others are removed.
+	limit  uint64
+	db     ethdb.Database
+	term   chan chan struct{}
+	closed chan struct{}
+}
+
+// newAccountIndexer initializes the account change indexer.
+func newAccountIndexer(limit uint64, chain *BlockChain) *accountIndexer {
+	indexer := &accountIndexer{
+		limit:  limit,
+		db:     chain.db,
+		term:   make(chan chan struct{}),
+		closed: make(chan struct{}),
+	}
+	indexer.repair(chain.CurrentBlock().Number.Uint64())
+	go indexer.loop(chain)
+
+	var msg string
+	if limit == 0 {
+		msg = "all blocks"
+	} else {
+		msg = fmt.Sprintf("last %d blocks", limit)
+	}
+	log.Info("Initialized account change indexer", "range", msg, "tail", *rawdb.ReadAccountChangeIndexTail(indexer.db))
+
+	return indexer
+}
+
+// repair moves the index tail past the given chain head if the blocks up to it
+// were not all indexed, which happens on first start or if the indexer was
+// disabled for a while. Changes are only recorded from now on, the blocks
+// before are marked as missing and their stale changes are removed.
+//
+// A head behind the chain doesn't mean a gap by itself, the canonical blocks
+// past it might have been recorded before the chain was reorganised onto them.
+func (indexer *accountIndexer) repair(current uint64) {
+	tail, head := rawdb.ReadAccountChangeIndexTail(indexer.db), rawdb.ReadAccountChangeIndexHead(indexer.db)
+	if tail != nil && head != nil {
+		number := *head + 1
+		for ; number <= current; number++ {
+			if !rawdb.HasAccountChanges(indexer.db, number, rawdb.ReadCanonicalHash(indexer.db, number)) {
+				break
+			}
+		}
+		if number > current {
+			if *head < current {
+				rawdb.WriteAccountChangeIndexHead(indexer.db, current)
+			}
+			return
+		}
+	}
+	if tail != nil {
+		log.Warn("Account change index has a gap, restarting it from head", "tail", *tail, "number", current+1)
+		rawdb.UnindexAccountChanges(indexer.db, *tail, current+1, nil)
+	}
+	rawdb.WriteAccountChangeIndexTail(indexer.db, current+1)
+	rawdb.WriteAccountChangeIndexHead(indexer.db, current)
+}
+
+// advance moves the index head to the new canonical head block if it directly
+// follows the head and its changes have been recorded. The head is never moved
+// backwards, the changes are kept per block hash and a reorg onto a shorter
+// chain doesn't unindex the blocks above the new head.
+func (indexer *accountIndexer) advance(db ethdb.KeyValueWriter, block *types.Block) {
+	number := block.NumberU64()
+	if head := rawdb.ReadAccountChangeIndexHead(indexer.db); head == nil || number != *head+1 {
+		return
+	}
+	if rawdb.HasAccountChanges(indexer.db, number, block.Hash()) {
+		rawdb.WriteAccountChangeIndexHead(db, number)
+	}
+}
+
+// run removes the account changes falling out of the configured range. If the
+// stop channel is closed, the task should be terminated as soon as possible,
+// the done channel will be closed once the task is finished.
+func (indexer *accountIndexer) run(head uint64, stop chan struct{}, done chan struct{}) {
+	defer func() { close(done) }()
+
+	if indexer.limit == 0 || head < indexer.limit {
+		return
+	}
+	tail := rawdb.ReadAccountChangeIndexTail(indexer.db)
+	if tail == nil {
+		return
+	}
+	if from, to := *tail, head-indexer.limit+1; from < to {
+		rawdb.UnindexAccountChanges(indexer.db, from, to, stop)
+	}
+}
+
+// loop is the scheduler of the indexer, assigning unindexing tasks depending
+// on the received chain event.
+func (indexer *accountIndexer) loop(chain *BlockChain) {
+	defer close(indexer.closed)
+
+	var (
+		stop chan struct{} // Non-nil if background routine is active.
+		done chan struct{} // Non-nil if background routine is active.
+
+		headCh = make(chan ChainHeadEvent)
+		sub    = chain.SubscribeChainHeadEvent(headCh)
+	)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-headCh:
+			if done == nil {
+				stop = make(chan struct{})
+				done = make(chan struct{})
+				go indexer.run(head.Block.NumberU64(), stop, done)
+			}
+		case <-done:
+			stop = nil
+			done = nil
+		case ch := <-indexer.term:
+			if stop != nil {
+				close(stop)
+			}
+			if done != nil {
+				log.Info("Waiting background account indexer to exit")
+				<-done
+			}
+			close(ch)
+			return
+		}
+	}
+}
+
+// close shutdown the indexer. Safe to be called for multiple times.
+func (indexer *accountIndexer) close() {
+	ch := make(chan struct{})
+	select {
+	case indexer.term <- ch:
+		<-ch
+	case <-indexer.closed:
+	}
+}
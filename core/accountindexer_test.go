@@ -0,0 +1,196 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the blocks changing the balance or nonce of accounts are indexed
+// while processed, including the changes not made by transactions.
+func TestAccountIndexer(t *testing.T) {
+	var (
+		testBankKey, _  = crypto.GenerateKey()
+		testBankAddress = crypto.PubkeyToAddress(testBankKey.PublicKey)
+		testBankFunds   = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+		recipient       = common.HexToAddress("0xdeadbeef")
+		miner           = common.HexToAddress("0xc0ffee")
+
+		gspec = &Genesis{
+			Config:  params.TestChainConfig,
+			Alloc:   types.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+		engine = ethash.NewFaker()
+		nonce  = uint64(0)
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 8, func(i int, gen *BlockGen) {
+		gen.SetCoinbase(miner)
+		// Transfer in every other block
+		if i%2 == 0 {
+			tx, _ := types.SignTx(types.NewTransaction(nonce, recipient, big.NewInt(1000), params.TxGas, big.NewInt(10*params.InitialBaseFee), nil), types.HomesteadSigner{}, testBankKey)
+			gen.AddTx(tx)
+			nonce += 1
+		}
+	})
+	db := rawdb.NewMemoryDatabase()
+	chain, err := NewBlockChain(db, nil, gspec, nil, engine, vm.Config{}, nil, nil, EnableAccountChangeIndex(0))
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	state, _ := chain.State()
+
+	// The sender and recipient change in the blocks with transfers
+	changes, err := chain.GetAccountChanges(recipient, 1, 8, 100)
+	if err != nil {
+		t.Fatalf("failed to retrieve account changes: %v", err)
+	}
+	if len(changes) != 4 {
+		t.Fatalf("recipient change count mismatch: have %d, want %d", len(changes), 4)
+	}
+	for i, change := range changes {
+		if change.Number != uint64(2*i+1) || change.Hash != blocks[2*i].Hash() {
+			t.Errorf("change %d: block mismatch: have %d", i, change.Number)
+		}
+		if change.Balance.Cmp(big.NewInt(int64(1000*(i+1)))) != 0 || change.Nonce != 0 {
+			t.Errorf("change %d: balance or nonce mismatch: have %v, %d", i, change.Balance, change.Nonce)
+		}
+	}
+	changes, _ = chain.GetAccountChanges(testBankAddress, 1, 8, 100)
+	if len(changes) != 4 || changes[3].Nonce != 4 || changes[3].Balance.Cmp(state.GetBalance(testBankAddress).ToBig()) != 0 {
+		t.Fatalf("sender changes mismatch: %v", changes)
+	}
+	// The miner is rewarded in every block
+	changes, _ = chain.GetAccountChanges(miner, 3, 6, 100)
+	if len(changes) != 4 || changes[0].Number != 3 {
+		t.Fatalf("miner changes mismatch: %v", changes)
+	}
+	if _, err := chain.GetAccountChanges(miner, 1, 8, 7); err != ErrTooManyAccountChanges {
+		t.Fatalf("limit error mismatch: have %v, want %v", err, ErrTooManyAccountChanges)
+	}
+	// Changes of blocks which are not canonical are skipped
+	rawdb.WriteAccountChanges(db, 2, common.Hash{0x1}, []types.AccountChange{{Address: recipient, Balance: common.Big1}})
+	if changes, _ := chain.GetAccountChanges(recipient, 2, 2, 100); len(changes) != 0 {
+		t.Fatalf("non-canonical changes returned: %v", changes)
+	}
+	// Unindexed changes are reported as missing
+	rawdb.UnindexAccountChanges(db, 1, 5, nil)
+	if tail := rawdb.ReadAccountChangeIndexTail(db); tail == nil || *tail != 5 {
+		t.Fatalf("index tail mismatch: have %v, want %d", tail, 5)
+	}
+	if _, err := chain.GetAccountChanges(recipient, 1, 8, 100); err == nil {
+		t.Fatal("expected error for unindexed blocks")
+	}
+	if changes, _ := chain.GetAccountChanges(recipient, 5, 8, 100); len(changes) != 2 || changes[0].Number != 5 {
+		t.Fatalf("changes after unindexing mismatch: %v", changes)
+	}
+	if changes := rawdb.ReadAccountChanges(db, recipient, 0, 4, 0); len(changes) != 0 {
+		t.Fatalf("unindexed changes left: %v", changes)
+	}
+}
+
+// Tests that the index restarts from head if blocks were processed while the
+// indexer was disabled, instead of reporting the missing blocks as indexed.
+func TestAccountIndexerGap(t *testing.T) {
+	var (
+		miner  = common.HexToAddress("0xc0ffee")
+		gspec  = &Genesis{Config: params.TestChainConfig, BaseFee: big.NewInt(params.InitialBaseFee)}
+		engine = ethash.NewFaker()
+		db     = rawdb.NewMemoryDatabase()
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 8, func(i int, gen *BlockGen) {
+		gen.SetCoinbase(miner)
+	})
+	insert := func(blocks []*types.Block, options ...BlockChainOption) {
+		chain, err := NewBlockChain(db, nil, gspec, nil, engine, vm.Config{}, nil, nil, options...)
+		if err != nil {
+			t.Fatalf("failed to create chain: %v", err)
+		}
+		defer chain.Stop()
+
+		if _, err := chain.InsertChain(blocks); err != nil {
+			t.Fatalf("failed to insert chain: %v", err)
+		}
+	}
+	insert(blocks[:4], EnableAccountChangeIndex(0))
+	if head := rawdb.ReadAccountChangeIndexHead(db); head == nil || *head != 4 {
+		t.Fatalf("index head mismatch: have %v, want %d", head, 4)
+	}
+	insert(blocks[4:6])
+	insert(blocks[6:], EnableAccountChangeIndex(0))
+
+	if tail := rawdb.ReadAccountChangeIndexTail(db); tail == nil || *tail != 7 {
+		t.Fatalf("index tail mismatch: have %v, want %d", tail, 7)
+	}
+	if head := rawdb.ReadAccountChangeIndexHead(db); head == nil || *head != 8 {
+		t.Fatalf("index head mismatch: have %v, want %d", head, 8)
+	}
+	if changes := rawdb.ReadAccountChanges(db, miner, 0, 8, 0); len(changes) != 2 || changes[0].Number != 7 {
+		t.Fatalf("changes after the gap mismatch: %v", changes)
+	}
+}
+
+// Tests that side blocks imported below the head don't move the index head back,
+// and that the index survives a restart with a head behind the recorded blocks.
+func TestAccountIndexerSideBlock(t *testing.T) {
+	var (
+		miner  = common.HexToAddress("0xc0ffee")
+		gspec  = &Genesis{Config: params.TestChainConfig, BaseFee: big.NewInt(params.InitialBaseFee)}
+		engine = ethash.NewFaker()
+		db     = rawdb.NewMemoryDatabase()
+	)
+	genDb, blocks, _ := GenerateChainWithGenesis(gspec, engine, 8, func(i int, gen *BlockGen) {
+		gen.SetCoinbase(miner)
+	})
+	side, _ := GenerateChain(gspec.Config, blocks[2], engine, genDb, 1, func(i int, gen *BlockGen) {
+		gen.SetCoinbase(common.HexToAddress("0xdeadbeef"))
+	})
+	open := func() *BlockChain {
+		chain, err := NewBlockChain(db, nil, gspec, nil, engine, vm.Config{}, nil, nil, EnableAccountChangeIndex(0))
+		if err != nil {
+			t.Fatalf("failed to create chain: %v", err)
+		}
+		return chain
+	}
+	check := func(head uint64) {
+		t.Helper()
+		if tail := rawdb.ReadAccountChangeIndexTail(db); tail == nil || *tail != 1 {
+			t.Fatalf("index tail mismatch: have %v, want %d", tail, 1)
+		}
+		if have := rawdb.ReadAccountChangeIndexHead(db); have == nil || *have != head {
+			t.Fatalf("index head mismatch: have %v, want %d", have, head)
+		}
+		if changes := rawdb.ReadAccountChanges(db, miner, 0, 8, 0); len(changes) != 8 {
+			t.Fatalf("miner change count mismatch: have %d, want %d", len(changes), 8)
+		}
+	}
+	chain := open()
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	if _, err := chain.InsertChain(side); err != nil {
+		t.Fatalf("failed to insert side block: %v", err)
+	}
+	if !rawdb.HasAccountChanges(db, 4, side[0].Hash()) {
+		t.Fatal("side block changes not recorded")
+	}
+	check(8)
+	chain.Stop()
+
+	// A head left behind the recorded canonical blocks is caught up on restart
+	rawdb.WriteAccountChangeIndexHead(db, 4)
+	open().Stop()
+	check(8)
+}
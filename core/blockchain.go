@@ -272,6 +272,9 @@ type BlockChain struct {
 	triesInMemory uint64
 	txIndexer     *txIndexer // Transaction indexer, might be nil if not enabled
 
+	accountIndexer *accountIndexer // Account change indexer, might be nil if not enabled
+	addressSigner  types.Signer    // Signer deriving the addresses transactions are indexed by, nil if not enabled
+
 	hc                       *HeaderChain
 	rmLogsFeed               event.Feed
 	chainFeed                event.Feed
@@ -1328,6 +1331,13 @@ func (bc *BlockChain) writeHeadBlock(block *types.Block) {
 
 		batch := bc.db.NewBatch()
 		rawdb.WriteTxLookupEntriesByBlock(batch, block)
+		if bc.addressSigner != nil {
+			contracts := rawdb.ReadCreatedContracts(bc.db, block.NumberU64(), block.Hash())
+			rawdb.WriteAddressTxEntriesByBlock(batch, bc.addressSigner, block, contracts)
+		}
+		if bc.accountIndexer != nil {
+			bc.accountIndexer.advance(batch, block)
+		}
 
 		// Flush the whole batch into the disk, exit the node if failed
 		if err := batch.Write(); err != nil {
@@ -1361,6 +1371,9 @@ func (bc *BlockChain) stopWithoutSaving() {
 	if bc.txIndexer != nil {
 		bc.txIndexer.close()
 	}
+	if bc.accountIndexer != nil {
+		bc.accountIndexer.close()
+	}
 	// Unsubscribe all subscriptions registered from blockchain.
 	bc.scope.Close()
 
@@ -1791,6 +1804,19 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	// Make sure no inconsistent state is leaked during insertion
 	externTd := new(big.Int).Add(block.Difficulty(), ptd)
 
+	// Collect the account changes and the created contracts before the state is
+	// committed, the statedb is not safe to read concurrently with the commit.
+	var accountChanges []types.AccountChange
+	if bc.accountIndexer != nil {
+		accountChanges = state.AccountChanges()
+	}
+	var contracts [][]common.Address
+	if bc.addressSigner != nil {
+		contracts = make([][]common.Address, len(block.Transactions()))
+		for i, tx := range block.Transactions() {
+			contracts[i] = state.CreatedContracts(tx.Hash())
+		}
+	}
 	// Irrelevant of the canonical status, write the block itself to the database.
 	//
 	// Note all the components of block(td, hash->number map, header, body, receipts)
@@ -1798,6 +1824,16 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
+		if bc.accountIndexer != nil {
+			indexBatch := bc.db.NewBatch()
+			rawdb.WriteAccountChanges(indexBatch, block.NumberU64(), block.Hash(), accountChanges)
+			if err := indexBatch.Write(); err != nil {
+				log.Crit("Failed to write account changes into disk", "err", err)
+			}
+		}
+		if bc.addressSigner != nil {
+			rawdb.WriteCreatedContracts(bc.db, block.NumberU64(), block.Hash(), contracts)
+		}
 		blockBatch := bc.db.BlockStore().NewBatch()
 		rawdb.WriteTd(blockBatch, block.Hash(), block.NumberU64(), externTd)
 		rawdb.WriteBlock(blockBatch, block)
@@ -2744,6 +2780,18 @@ func (bc *BlockChain) reorg(oldHead *types.Header, newHead *types.Block) error {
 	// stale lookups are still cached.
 	bc.txLookupCache.Purge()
 
+	// Drop the address indexes of the old chain before the new one is written,
+	// since the positions of the transactions of both chains may collide.
+	if bc.addressSigner != nil {
+		batch := bc.db.NewBatch()
+		for _, block := range oldChain {
+			contracts := rawdb.ReadCreatedContracts(bc.db, block.NumberU64(), block.Hash())
+			rawdb.DeleteAddressTxEntriesByBlock(batch, bc.addressSigner, block, contracts)
+		}
+		if err := batch.Write(); err != nil {
+			log.Crit("Failed to delete address indexes", "err", err)
+		}
+	}
 	// Insert the new chain(except the head block(reverse order)),
 	// taking care of the proper incremental order.
 	for i := len(newChain) - 1; i >= 1; i-- {
@@ -3139,6 +3187,26 @@ func EnablePersistDiff(limit uint64) BlockChainOption {
 	}
 }
 
+// EnableAccountChangeIndex records the blocks changing the balance or nonce of
+// every account, keeping the changes of the latest limit blocks or all of them
+// if limit is zero.
+func EnableAccountChangeIndex(limit uint64) BlockChainOption {
+	return func(bc *BlockChain) (*BlockChain, error) {
+		bc.accountIndexer = newAccountIndexer(limit, bc)
+		return bc, nil
+	}
+}
+
+// EnableAddressIndex indexes the transactions by the addresses sending and
+// receiving them, along with the transaction lookup entries. It must be applied
+// before the transaction indexer is started.
+func EnableAddressIndex() BlockChainOption {
+	return func(bc *BlockChain) (*BlockChain, error) {
+		bc.addressSigner = types.LatestSigner(bc.chainConfig)
+		return bc, nil
+	}
+}
+
 func EnableBlockValidator(chainConfig *params.ChainConfig, engine consensus.Engine, mode VerifyMode, peers verifyPeers) BlockChainOption {
 	return func(bc *BlockChain) (*BlockChain, error) {
 		if mode.NeedRemoteVerify() {
@@ -18,6 +18,7 @@ package core
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -470,6 +471,57 @@ func (bc *BlockChain) TxIndexProgress() (TxIndexProgress, error) {
 	return bc.txIndexer.txIndexProgress()
 }
 
+// GetAccountChanges retrieves the canonical blocks in the inclusive range
+// [from, to] which changed the balance or nonce of the given account, along with
+// the values after each block. At most limit changes are returned, if there are
+// more ErrTooManyAccountChanges is returned.
+func (bc *BlockChain) GetAccountChanges(address common.Address, from uint64, to uint64, limit int) ([]rawdb.AccountChangeEntry, error) {
+	if bc.accountIndexer == nil {
+		return nil, errAccountIndexDisabled
+	}
+	if tail := rawdb.ReadAccountChangeIndexTail(bc.db); tail != nil && from < *tail {
+		return nil, fmt.Errorf("account changes before block %d are not indexed", *tail)
+	}
+	changes := rawdb.ReadAccountChanges(bc.db, address, from, to, limit+1)
+	if len(changes) > limit {
+		return nil, ErrTooManyAccountChanges
+	}
+	return changes, nil
+}
+
+// GetAddressTransactions retrieves the positions of the canonical transactions
+// sent from or to an address, starting at the given transaction index of block
+// from up to block to included. At most limit entries are returned.
+func (bc *BlockChain) GetAddressTransactions(address common.Address, from uint64, index uint64, to uint64, limit int) ([]rawdb.AddressTxEntry, error) {
+	if bc.addressSigner == nil {
+		return nil, errors.New("address indexer is not enabled")
+	}
+	tail := rawdb.ReadAddressTxIndexTail(bc.db)
+	if tail == nil {
+		return nil, errors.New("address indexing still in progress")
+	}
+	if from < *tail {
+		return nil, fmt.Errorf("transactions before block %d are not indexed", *tail)
+	}
+	var entries []rawdb.AddressTxEntry
+	for len(entries) < limit {
+		want := limit - len(entries)
+		batch := rawdb.ReadAddressTxEntries(bc.db, address, from, index, to, want)
+		for _, entry := range batch {
+			// Skip the stale entries left by reorgs
+			if number := rawdb.ReadTxLookupEntry(bc.db, entry.Hash); number != nil && *number == entry.Number {
+				entries = append(entries, entry)
+			}
+		}
+		if len(batch) < want {
+			break
+		}
+		last := batch[len(batch)-1]
+		from, index = last.Number, last.Index+1
+	}
+	return entries, nil
+}
+
 // TrieDB retrieves the low level trie database used for data storage.
 func (bc *BlockChain) TrieDB() *triedb.Database {
 	return bc.triedb
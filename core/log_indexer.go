@@ -0,0 +1,124 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/logindex"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+const (
+	// logIndexThrottling is the time to wait between processing two consecutive
+	// log index sections.
+	logIndexThrottling = 100 * time.Millisecond
+)
+
+// LogIndexer implements a core.ChainIndexer, building up an index of the
+// positions of the logs by address and topic for every section of the chain,
+// permitting to filter logs without scanning candidate blocks.
+type LogIndexer struct {
+	size    uint64              // section size to index the logs for
+	db      ethdb.Database      // database instance to write index data and metadata into
+	gen     *logindex.Generator // generator collecting the positions of the logs of the section
+	section uint64              // Section is the section number being processed currently
+	head    common.Hash         // Head is the hash of the last header processed
+}
+
+// NewLogIndexer returns a chain indexer that generates the log index of the
+// canonical chain for fast logs filtering.
+//
+// The receipts of the blocks pruned from the ancient store can't be indexed, so
+// the index of a pruned chain starts at the first section above the history
+// tail, stored as the log index tail for the filters to fall back below it.
+func NewLogIndexer(db ethdb.Database, size, confirms uint64) *ChainIndexer {
+	backend := &LogIndexer{
+		db:   db,
+		size: size,
+	}
+	table := rawdb.NewTable(db, string(rawdb.LogIndexIndexPrefix))
+
+	indexer := NewChainIndexer(db, table, backend, size, confirms, logIndexThrottling, "logindex")
+	if tail := logHistoryTail(db); tail > 0 {
+		section := tail / size
+		head := rawdb.ReadCanonicalHash(db, (section+1)*size-1)
+		if stored, _, _ := indexer.Sections(); stored <= section && head != (common.Hash{}) {
+			indexer.AddCheckpoint(section, head)
+			if section+1 > rawdb.ReadLogIndexTail(db) {
+				rawdb.WriteLogIndexTail(db, section+1)
+			}
+		}
+	}
+	return indexer
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new log index section.
+func (l *LogIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	l.gen, l.section, l.head = logindex.NewGenerator(l.size), section, common.Hash{}
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, adding the logs of a new header
+// into the index.
+func (l *LogIndexer) Process(ctx context.Context, header *types.Header) error {
+	var (
+		number = header.Number.Uint64()
+		hash   = header.Hash()
+		logs   []*types.Log
+	)
+	if header.Bloom != (types.Bloom{}) {
+		receipts := rawdb.ReadLogs(l.db, hash, number)
+		if receipts == nil {
+			if number < logHistoryTail(l.db) {
+				return fmt.Errorf("receipts of block #%d pruned, indexing resumes from the history tail on restart", number)
+			}
+			return fmt.Errorf("receipts of block #%d (%x) not found", number, hash)
+		}
+		for _, receiptLogs := range receipts {
+			logs = append(logs, receiptLogs...)
+		}
+	}
+	if err := l.gen.AddLogs(number-l.section*l.size, logs); err != nil {
+		return err
+	}
+	l.head = hash
+	return nil
+}
+
+// logHistoryTail returns the number of the first block whose receipts are kept,
+// the older ones having been pruned from the ancient store.
+func logHistoryTail(db ethdb.Database) uint64 {
+	tail, err := db.BlockStore().Tail()
+	if err != nil {
+		tail = 0
+	}
+	if offset := db.BlockStore().AncientOffSet(); offset > tail {
+		tail = offset
+	}
+	return tail
+}
+
+// Commit implements core.ChainIndexerBackend, finalizing the log index section
+// and writing it out into the database.
+func (l *LogIndexer) Commit() error {
+	batch := l.db.NewBatch()
+	for term, positions := range l.gen.Postings() {
+		rawdb.WriteLogIndex(batch, term[:], l.section, l.head, positions)
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	return batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (l *LogIndexer) Prune(threshold uint64) error {
+	return nil
+}
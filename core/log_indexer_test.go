@@ -0,0 +1,71 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/logindex"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Tests that the log index of a chain whose oldest receipts were pruned starts
+// above the history tail and keeps indexing the sections after it.
+func TestLogIndexerPrunedHistory(t *testing.T) {
+	// The blocks before #6 were pruned from the ancient store by offsetting it
+	kvdb := rawdb.NewMemoryDatabase()
+	rawdb.WriteOffSetOfCurrentAncientFreezer(kvdb, 6)
+	db, err := rawdb.NewDatabaseWithFreezer(kvdb, t.TempDir(), "", false, true, false, false, false)
+	if err != nil {
+		t.Fatalf("failed to create database with ancient backend: %v", err)
+	}
+	defer db.Close()
+
+	// Every block logs from the same address
+	address := common.HexToAddress("0xdeadbeef")
+	var (
+		blocks   []*types.Block
+		receipts []types.Receipts
+		parent   common.Hash
+	)
+	for number := uint64(0); number < 24; number++ {
+		receipt := &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 1,
+			Logs:              []*types.Log{{Address: address}},
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		header := &types.Header{Number: new(big.Int).SetUint64(number), ParentHash: parent, Bloom: receipt.Bloom}
+		block := types.NewBlockWithHeader(header)
+		blocks, receipts, parent = append(blocks, block), append(receipts, types.Receipts{receipt}), block.Hash()
+	}
+	for _, block := range blocks[6:] {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[block.NumberU64()])
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+	}
+	indexer := NewLogIndexer(db, 4, 0)
+	defer indexer.Close()
+
+	indexer.newHead(23, false)
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		if sections, _, _ := indexer.Sections(); sections == 6 {
+			break
+		}
+		if time.Since(start) > 3*time.Second {
+			t.Fatalf("sections not indexed")
+		}
+	}
+	if tail := rawdb.ReadLogIndexTail(db); tail != 2 {
+		t.Fatalf("log index tail mismatch: have %d, want %d", tail, 2)
+	}
+	term := logindex.AddressTerm(address)
+	for section := uint64(0); section < 6; section++ {
+		positions := rawdb.ReadLogIndex(db, term[:], section, blocks[(section+1)*4-1].Hash())
+		if indexed := positions != nil; indexed != (section >= 2) {
+			t.Errorf("section %d: indexed mismatch: have %v, want %v", section, indexed, section >= 2)
+		}
+	}
+}
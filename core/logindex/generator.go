@@ -0,0 +1,61 @@
+package logindex
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// errSectionOutOfBounds is returned if the user tried to add the logs of more
+// blocks than the section holds.
+var errSectionOutOfBounds = errors.New("section out of bounds")
+
+// Generator collects the positions of the logs of a section of blocks for every
+// term, producing the postings of the section.
+type Generator struct {
+	size      uint64              // Number of blocks in a section
+	postings  map[Term][]Position // Positions of the logs of every term, sorted
+	lastBlock *uint64             // Offset of the last block added, nil if none
+}
+
+// NewGenerator creates a generator of the postings of a section of the given
+// number of blocks.
+func NewGenerator(size uint64) *Generator {
+	return &Generator{
+		size:     size,
+		postings: make(map[Term][]Position),
+	}
+}
+
+// AddLogs adds the logs of the block at the given offset in the section, which
+// must be added in ascending order.
+func (g *Generator) AddLogs(offset uint64, logs []*types.Log) error {
+	if offset >= g.size {
+		return errSectionOutOfBounds
+	}
+	if g.lastBlock != nil && offset <= *g.lastBlock {
+		return errors.New("blocks not added in order")
+	}
+	g.lastBlock = &offset
+
+	for i, log := range logs {
+		position := NewPosition(offset, uint(i))
+
+		term := AddressTerm(log.Address)
+		g.postings[term] = append(g.postings[term], position)
+		for j, topic := range log.Topics {
+			term := TopicTerm(j, topic)
+			g.postings[term] = append(g.postings[term], position)
+		}
+	}
+	return nil
+}
+
+// Postings returns the encoded positions of the logs of every term.
+func (g *Generator) Postings() map[Term][]byte {
+	postings := make(map[Term][]byte, len(g.postings))
+	for term, positions := range g.postings {
+		postings[term] = Encode(positions)
+	}
+	return postings
+}
@@ -0,0 +1,230 @@
+// Package logindex implements an inverted index of the logs, mapping their
+// addresses and topics to their positions within sections of the chain.
+package logindex
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// errInvalidPostings is returned when decoding corrupted postings.
+var errInvalidPostings = errors.New("invalid log index postings")
+
+// TermLength is the length of the terms indexed.
+const TermLength = 1 + common.HashLength
+
+// Term is an indexed value of the logs, either their address or one of their
+// topics. Topics are indexed along with their position, as the filters match
+// them positionally.
+type Term [TermLength]byte
+
+// AddressTerm returns the term indexing the logs emitted by an address.
+func AddressTerm(address common.Address) Term {
+	var term Term
+	copy(term[1:], address.Bytes())
+	return term
+}
+
+// TopicTerm returns the term indexing the logs carrying a topic at the given
+// position.
+func TopicTerm(position int, topic common.Hash) Term {
+	var term Term
+	term[0] = byte(1 + position)
+	copy(term[1:], topic.Bytes())
+	return term
+}
+
+// Position is the position of a log within a section, made of the offset of
+// its block in the section and of its index within the block.
+type Position uint64
+
+// NewPosition returns the position of the log with the given index, in the
+// block with the given offset in the section.
+func NewPosition(offset uint64, index uint) Position {
+	return Position(offset<<32 | uint64(uint32(index)))
+}
+
+// Offset returns the offset of the block of the log in the section.
+func (p Position) Offset() uint64 {
+	return uint64(p) >> 32
+}
+
+// Index returns the index of the log within its block.
+func (p Position) Index() uint {
+	return uint(uint32(p))
+}
+
+// Encode compresses sorted positions, storing for every one of them the delta
+// from the previous block and either its log index if in a new block or the
+// delta from the previous log index otherwise.
+func Encode(positions []Position) []byte {
+	var (
+		enc  = make([]byte, 0, 2*len(positions))
+		prev Position
+	)
+	for i, p := range positions {
+		if i == 0 {
+			enc = binary.AppendUvarint(enc, p.Offset())
+			enc = binary.AppendUvarint(enc, uint64(p.Index()))
+		} else if delta := p.Offset() - prev.Offset(); delta > 0 {
+			enc = binary.AppendUvarint(enc, delta)
+			enc = binary.AppendUvarint(enc, uint64(p.Index()))
+		} else {
+			enc = binary.AppendUvarint(enc, 0)
+			enc = binary.AppendUvarint(enc, uint64(p.Index()-prev.Index()))
+		}
+		prev = p
+	}
+	return enc
+}
+
+// Decode decompresses the positions encoded by Encode.
+func Decode(enc []byte) ([]Position, error) {
+	var (
+		positions []Position
+		offset    uint64
+		index     uint64
+	)
+	for len(enc) > 0 {
+		delta, n := binary.Uvarint(enc)
+		if n <= 0 {
+			return nil, errInvalidPostings
+		}
+		enc = enc[n:]
+		value, n := binary.Uvarint(enc)
+		if n <= 0 {
+			return nil, errInvalidPostings
+		}
+		enc = enc[n:]
+
+		if len(positions) > 0 && delta == 0 {
+			if value == 0 {
+				return nil, errInvalidPostings
+			}
+			index += value
+		} else {
+			offset, index = offset+delta, value
+		}
+		positions = append(positions, NewPosition(offset, uint(index)))
+	}
+	return positions, nil
+}
+
+// Union merges sorted position lists.
+func Union(lists ...[]Position) []Position {
+	if len(lists) == 1 {
+		return lists[0]
+	}
+	var union []Position
+	for _, list := range lists {
+		union = append(union, list...)
+	}
+	sort.Slice(union, func(i, j int) bool { return union[i] < union[j] })
+
+	// Drop the duplicates
+	n := 0
+	for i, p := range union {
+		if i == 0 || p != union[n-1] {
+			union[n] = p
+			n++
+		}
+	}
+	return union[:n]
+}
+
+// Intersect returns the positions present in both sorted lists.
+func Intersect(a, b []Position) []Position {
+	var (
+		inter []Position
+		i, j  int
+	)
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			inter = append(inter, a[i])
+			i, j = i+1, j+1
+		}
+	}
+	return inter
+}
+
+// narrowingRatio is the number of bytes of encoded postings that are worth
+// decoding to narrow the matches by a single position, beyond which the logs of
+// the matched blocks are cheaper to filter directly.
+const narrowingRatio = 1024
+
+// Match returns the positions of the logs matching the filter criteria within
+// a section, retrieving the encoded positions of the logs of every term with the
+// given function. Criteria restricting neither the addresses nor the topics can
+// not be matched with the index, in which case ok is false.
+//
+// The clauses are intersected from the most selective one, and the ones too
+// large to be worth decoding are skipped, so the matches are a superset of the
+// logs satisfying the criteria, which must still be filtered.
+func Match(addresses []common.Address, topics [][]common.Hash, postings func(Term) ([]byte, error)) (matches []Position, ok bool, err error) {
+	var clauses [][]Term
+	if len(addresses) > 0 {
+		clause := make([]Term, len(addresses))
+		for i, address := range addresses {
+			clause[i] = AddressTerm(address)
+		}
+		clauses = append(clauses, clause)
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue // empty rule set == wildcard
+		}
+		clause := make([]Term, len(sub))
+		for j, topic := range sub {
+			clause[j] = TopicTerm(i, topic)
+		}
+		clauses = append(clauses, clause)
+	}
+	if len(clauses) == 0 {
+		return nil, false, nil
+	}
+	// Retrieve the encoded postings of every clause, sized to order them
+	type encodedClause struct {
+		lists [][]byte
+		size  int
+	}
+	encoded := make([]encodedClause, len(clauses))
+	for i, clause := range clauses {
+		encoded[i].lists = make([][]byte, len(clause))
+		for j, term := range clause {
+			if encoded[i].lists[j], err = postings(term); err != nil {
+				return nil, true, err
+			}
+			encoded[i].size += len(encoded[i].lists[j])
+		}
+	}
+	sort.SliceStable(encoded, func(i, j int) bool { return encoded[i].size < encoded[j].size })
+
+	for i, clause := range encoded {
+		if i > 0 && clause.size > narrowingRatio*len(matches) {
+			break
+		}
+		lists := make([][]Position, len(clause.lists))
+		for j, enc := range clause.lists {
+			if lists[j], err = Decode(enc); err != nil {
+				return nil, true, err
+			}
+		}
+		if i == 0 {
+			matches = Union(lists...)
+		} else {
+			matches = Intersect(matches, Union(lists...))
+		}
+		if len(matches) == 0 {
+			break
+		}
+	}
+	return matches, true, nil
+}
@@ -0,0 +1,89 @@
+package logindex
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Tests that positions survive an encoding round trip.
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 2, 10, 1000} {
+		positions := make([]Position, n)
+		for i := range positions {
+			positions[i] = NewPosition(uint64(rand.Intn(4096)), uint(rand.Intn(1<<20)))
+		}
+		positions = Union(positions)
+
+		decoded, err := Decode(Encode(positions))
+		if err != nil {
+			t.Fatalf("failed to decode %d positions: %v", n, err)
+		}
+		if len(decoded) != len(positions) || (len(positions) > 0 && !reflect.DeepEqual(decoded, positions)) {
+			t.Fatalf("positions mismatch: have %v, want %v", decoded, positions)
+		}
+	}
+	for _, enc := range [][]byte{{0x80}, {0x01}, {0x01, 0x01, 0x00, 0x00}} {
+		if _, err := Decode(enc); err == nil {
+			t.Errorf("expected error decoding %x", enc)
+		}
+	}
+}
+
+// Tests that the logs are matched positionally by address and topics.
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tokenA, tokenB = common.Address{0xa}, common.Address{0xb}
+		topic1, topic2 = common.Hash{0x1}, common.Hash{0x2}
+		gen            = NewGenerator(16)
+	)
+	gen.AddLogs(0, []*types.Log{
+		{Address: tokenA, Topics: []common.Hash{topic1, topic2}},
+		{Address: tokenB, Topics: []common.Hash{topic2}},
+	})
+	gen.AddLogs(3, []*types.Log{
+		{Address: tokenB, Topics: []common.Hash{topic1}},
+	})
+	if err := gen.AddLogs(2, nil); err == nil {
+		t.Fatal("expected error adding blocks out of order")
+	}
+	if err := gen.AddLogs(16, nil); err == nil {
+		t.Fatal("expected error adding blocks out of the section")
+	}
+	postings := gen.Postings()
+	fetch := func(term Term) ([]byte, error) {
+		return postings[term], nil
+	}
+	for i, tc := range []struct {
+		addresses []common.Address
+		topics    [][]common.Hash
+		want      []Position
+	}{
+		{addresses: []common.Address{tokenA}, want: []Position{NewPosition(0, 0)}},
+		{addresses: []common.Address{tokenA, tokenB}, want: []Position{NewPosition(0, 0), NewPosition(0, 1), NewPosition(3, 0)}},
+		{topics: [][]common.Hash{{topic2}}, want: []Position{NewPosition(0, 1)}},
+		{topics: [][]common.Hash{{}, {topic2}}, want: []Position{NewPosition(0, 0)}},
+		{addresses: []common.Address{tokenB}, topics: [][]common.Hash{{topic1, topic2}}, want: []Position{NewPosition(0, 1), NewPosition(3, 0)}},
+		{addresses: []common.Address{tokenA}, topics: [][]common.Hash{{}, {topic1}}},
+	} {
+		have, ok, err := Match(tc.addresses, tc.topics, fetch)
+		if err != nil || !ok {
+			t.Fatalf("test %d: failed to match: ok %v, err %v", i, ok, err)
+		}
+		sort.Slice(have, func(i, j int) bool { return have[i] < have[j] })
+		if len(have) != len(tc.want) || (len(have) > 0 && !reflect.DeepEqual(have, tc.want)) {
+			t.Errorf("test %d: matches mismatch: have %v, want %v", i, have, tc.want)
+		}
+	}
+	if _, ok, _ := Match(nil, [][]common.Hash{{}, {}}, fetch); ok {
+		t.Error("expected unrestricted criteria not to be matched")
+	}
+}
@@ -0,0 +1,185 @@
+package rawdb
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// AccountChangeEntry is a canonical block which changed the balance or nonce of
+// an account, along with the values after the block.
+type AccountChangeEntry struct {
+	Number  uint64
+	Hash    common.Hash
+	Balance *big.Int
+	Nonce   uint64
+}
+
+// storedAccountChange is the RLP encoding of an indexed account change.
+type storedAccountChange struct {
+	Balance *big.Int
+	Nonce   uint64
+}
+
+// ReadAccountChangeIndexTail retrieves the number of the oldest block whose
+// account changes have been indexed.
+func ReadAccountChangeIndexTail(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(accountChangeIndexTailKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteAccountChangeIndexTail stores the number of the oldest block whose account
+// changes have been indexed.
+func WriteAccountChangeIndexTail(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(accountChangeIndexTailKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the account change index tail", "err", err)
+	}
+}
+
+// ReadAccountChangeIndexHead retrieves the number of the latest block whose
+// account changes have been indexed.
+func ReadAccountChangeIndexHead(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(accountChangeIndexHeadKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteAccountChangeIndexHead stores the number of the latest block whose account
+// changes have been indexed.
+func WriteAccountChangeIndexHead(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(accountChangeIndexHeadKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the account change index head", "err", err)
+	}
+}
+
+// WriteAccountChanges stores the account changes of a block, along with the list
+// of changed addresses needed to unindex the block.
+func WriteAccountChanges(db ethdb.KeyValueWriter, number uint64, hash common.Hash, changes []types.AccountChange) {
+	addresses := make([]common.Address, 0, len(changes))
+	for _, change := range changes {
+		enc, err := rlp.EncodeToBytes(&storedAccountChange{Balance: change.Balance, Nonce: change.Nonce})
+		if err != nil {
+			log.Crit("Failed to encode account change", "err", err)
+		}
+		if err := db.Put(accountChangeKey(change.Address, number, hash), enc); err != nil {
+			log.Crit("Failed to store account change", "err", err)
+		}
+		addresses = append(addresses, change.Address)
+	}
+	enc, err := rlp.EncodeToBytes(addresses)
+	if err != nil {
+		log.Crit("Failed to encode changed addresses", "err", err)
+	}
+	if err := db.Put(accountChangeBlockKey(number, hash), enc); err != nil {
+		log.Crit("Failed to store changed addresses", "err", err)
+	}
+}
+
+// HasAccountChanges checks if the account changes of a block have been recorded.
+func HasAccountChanges(db ethdb.KeyValueReader, number uint64, hash common.Hash) bool {
+	has, _ := db.Has(accountChangeBlockKey(number, hash))
+	return has
+}
+
+// ReadAccountChanges retrieves the changes of an account made by the canonical
+// blocks in the inclusive range [from, to], in ascending block order. Changes of
+// blocks which are not canonical anymore are skipped. At most limit entries are
+// returned, zero means no limit.
+func ReadAccountChanges(db ethdb.Database, address common.Address, from uint64, to uint64, limit int) []AccountChangeEntry {
+	prefix := append(append([]byte{}, accountChangePrefix...), address.Bytes()...)
+	it := db.NewIterator(prefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	var entries []AccountChangeEntry
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+8+common.HashLength {
+			continue
+		}
+		number := binary.BigEndian.Uint64(key[len(prefix):])
+		if number > to {
+			break
+		}
+		hash := common.BytesToHash(key[len(prefix)+8:])
+		if ReadCanonicalHash(db, number) != hash {
+			continue
+		}
+		var change storedAccountChange
+		if err := rlp.DecodeBytes(it.Value(), &change); err != nil {
+			log.Error("Invalid account change RLP", "address", address, "number", number, "hash", hash, "err", err)
+			continue
+		}
+		entries = append(entries, AccountChangeEntry{Number: number, Hash: hash, Balance: change.Balance, Nonce: change.Nonce})
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+	}
+	return entries
+}
+
+// UnindexAccountChanges removes the account changes of all blocks, canonical or
+// not, in the range [from, to) and moves the index tail to the first block left.
+// The removal can be interrupted by closing the interrupt channel.
+func UnindexAccountChanges(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}) {
+	if from >= to {
+		return
+	}
+	var (
+		it    = db.NewIterator(accountChangeBlockPrefix, encodeBlockNumber(from))
+		batch = db.NewBatch()
+		tail  = to
+	)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(accountChangeBlockPrefix)+8+common.HashLength {
+			continue
+		}
+		number := binary.BigEndian.Uint64(key[len(accountChangeBlockPrefix):])
+		if number >= to {
+			break
+		}
+		select {
+		case <-interrupt:
+			tail = number
+		default:
+		}
+		if tail != to {
+			break
+		}
+		hash := common.BytesToHash(key[len(accountChangeBlockPrefix)+8:])
+		var addresses []common.Address
+		if err := rlp.DecodeBytes(it.Value(), &addresses); err != nil {
+			log.Error("Invalid changed addresses RLP", "number", number, "hash", hash, "err", err)
+		}
+		for _, address := range addresses {
+			batch.Delete(accountChangeKey(address, number, hash))
+		}
+		batch.Delete(accountChangeBlockKey(number, hash))
+
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to unindex account changes", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	WriteAccountChangeIndexTail(batch, tail)
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to unindex account changes", "err", err)
+	}
+	log.Debug("Unindexed account changes", "from", from, "tail", tail)
+}
@@ -0,0 +1,202 @@
+package rawdb
+
+import (
+	"encoding/binary"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// AddressTxEntry is the position of a transaction sent from or to an address.
+type AddressTxEntry struct {
+	Number uint64
+	Index  uint64
+	Hash   common.Hash
+}
+
+// TransactionAddresses returns the addresses a transaction is indexed under: its
+// sender, its recipient and the contract it creates, if any. Contracts created by
+// internal calls are not known without executing the transaction, they are
+// recorded at insertion with WriteCreatedContracts.
+func TransactionAddresses(signer types.Signer, tx *types.Transaction) []common.Address {
+	addresses := make([]common.Address, 0, 2)
+	from, err := types.Sender(signer, tx)
+	if err == nil {
+		addresses = append(addresses, from)
+	}
+	if to := tx.To(); to != nil {
+		if err != nil || *to != from {
+			addresses = append(addresses, *to)
+		}
+	} else if err == nil {
+		addresses = append(addresses, crypto.CreateAddress(from, tx.Nonce()))
+	}
+	return addresses
+}
+
+// WriteCreatedContracts stores the contracts created by every transaction of a
+// block, including the ones created by internal calls. Nothing is stored if the
+// block doesn't create any contract.
+func WriteCreatedContracts(db ethdb.KeyValueWriter, number uint64, hash common.Hash, contracts [][]common.Address) {
+	created := false
+	for _, addresses := range contracts {
+		created = created || len(addresses) > 0
+	}
+	if !created {
+		return
+	}
+	enc, err := rlp.EncodeToBytes(contracts)
+	if err != nil {
+		log.Crit("Failed to encode created contracts", "err", err)
+	}
+	if err := db.Put(createdContractsKey(number, hash), enc); err != nil {
+		log.Crit("Failed to store created contracts", "err", err)
+	}
+}
+
+// ReadCreatedContracts retrieves the contracts created by every transaction of a
+// block, nil if none were recorded.
+func ReadCreatedContracts(db ethdb.KeyValueReader, number uint64, hash common.Hash) [][]common.Address {
+	data, _ := db.Get(createdContractsKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+	var contracts [][]common.Address
+	if err := rlp.DecodeBytes(data, &contracts); err != nil {
+		log.Error("Invalid created contracts RLP", "number", number, "hash", hash, "err", err)
+		return nil
+	}
+	return contracts
+}
+
+// deleteCreatedContracts removes the created contracts of all blocks, canonical
+// or not, at the given height.
+func deleteCreatedContracts(db ethdb.Iteratee, batch ethdb.KeyValueWriter, number uint64) {
+	prefix := append(append([]byte{}, createdContractsPrefix...), encodeBlockNumber(number)...)
+	it := db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			log.Crit("Failed to delete created contracts", "err", err)
+		}
+	}
+}
+
+// ReadAddressTxIndexTail retrieves the number of the oldest block whose
+// transactions have been indexed by address.
+func ReadAddressTxIndexTail(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(addressTxIndexTailKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteAddressTxIndexTail stores the number of the oldest block whose
+// transactions have been indexed by address.
+func WriteAddressTxIndexTail(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(addressTxIndexTailKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the address transaction index tail", "err", err)
+	}
+}
+
+// writeAddressTxEntries stores the address index entries of the transactions of
+// a block, given the addresses of every transaction.
+func writeAddressTxEntries(db ethdb.KeyValueWriter, number uint64, hashes []common.Hash, addresses [][]common.Address) {
+	for i, hash := range hashes {
+		for _, address := range addresses[i] {
+			if err := db.Put(addressTxKey(address, number, uint32(i)), hash.Bytes()); err != nil {
+				log.Crit("Failed to store address transaction entry", "err", err)
+			}
+		}
+	}
+}
+
+// deleteAddressTxEntries removes the address index entries of the transactions
+// of a block, given the addresses of every transaction.
+func deleteAddressTxEntries(db ethdb.KeyValueWriter, number uint64, addresses [][]common.Address) {
+	for i := range addresses {
+		for _, address := range addresses[i] {
+			if err := db.Delete(addressTxKey(address, number, uint32(i))); err != nil {
+				log.Crit("Failed to delete address transaction entry", "err", err)
+			}
+		}
+	}
+}
+
+// blockAddresses returns the indexed addresses of every transaction of a block,
+// along with the contracts created by every transaction if they were recorded.
+func blockAddresses(signer types.Signer, txs types.Transactions, contracts [][]common.Address) [][]common.Address {
+	addresses := make([][]common.Address, len(txs))
+	for i, tx := range txs {
+		addresses[i] = TransactionAddresses(signer, tx)
+		if i >= len(contracts) {
+			continue
+		}
+		for _, contract := range contracts[i] {
+			if !slices.Contains(addresses[i], contract) {
+				addresses[i] = append(addresses[i], contract)
+			}
+		}
+	}
+	return addresses
+}
+
+// WriteAddressTxEntriesByBlock stores the address index entries of all the
+// transactions of a block, given the contracts created by every transaction.
+func WriteAddressTxEntriesByBlock(db ethdb.KeyValueWriter, signer types.Signer, block *types.Block, contracts [][]common.Address) {
+	txs := block.Transactions()
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+	}
+	writeAddressTxEntries(db, block.NumberU64(), hashes, blockAddresses(signer, txs, contracts))
+}
+
+// DeleteAddressTxEntriesByBlock removes the address index entries of all the
+// transactions of a block, given the contracts created by every transaction.
+func DeleteAddressTxEntriesByBlock(db ethdb.KeyValueWriter, signer types.Signer, block *types.Block, contracts [][]common.Address) {
+	deleteAddressTxEntries(db, block.NumberU64(), blockAddresses(signer, block.Transactions(), contracts))
+}
+
+// ReadAddressTxEntries retrieves the positions of the transactions sent from or
+// to an address, starting at the given transaction index of block from up to
+// block to included, in ascending order. At most limit entries are returned,
+// zero means no limit.
+//
+// Entries may be stale after a reorg, the position of the transactions must be
+// checked against the transaction lookup entries.
+func ReadAddressTxEntries(db ethdb.Iteratee, address common.Address, from uint64, index uint64, to uint64, limit int) []AddressTxEntry {
+	prefix := append(append([]byte{}, addressTxPrefix...), address.Bytes()...)
+	start := addressTxKey(address, from, uint32(index))[len(prefix):]
+	it := db.NewIterator(prefix, start)
+	defer it.Release()
+
+	var entries []AddressTxEntry
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+8+4 || len(it.Value()) != common.HashLength {
+			continue
+		}
+		number := binary.BigEndian.Uint64(key[len(prefix):])
+		if number > to {
+			break
+		}
+		entries = append(entries, AddressTxEntry{
+			Number: number,
+			Index:  uint64(binary.BigEndian.Uint32(key[len(prefix)+8:])),
+			Hash:   common.BytesToHash(it.Value()),
+		})
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+	}
+	return entries
+}
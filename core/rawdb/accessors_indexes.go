@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -159,6 +160,39 @@ func WriteBloomBits(db ethdb.KeyValueWriter, bit uint, section uint64, head comm
 	}
 }
 
+// ReadLogIndex retrieves the compressed positions of the logs of a term in the
+// given section, nil if the term is absent from the section.
+func ReadLogIndex(db ethdb.KeyValueReader, term []byte, section uint64, head common.Hash) []byte {
+	data, _ := db.Get(logIndexKey(term, section, head))
+	return data
+}
+
+// WriteLogIndex stores the compressed positions of the logs of a term in the
+// given section.
+func WriteLogIndex(db ethdb.KeyValueWriter, term []byte, section uint64, head common.Hash, positions []byte) {
+	if err := db.Put(logIndexKey(term, section, head), positions); err != nil {
+		log.Crit("Failed to store log index", "err", err)
+	}
+}
+
+// ReadLogIndexTail retrieves the number of the oldest section whose logs have
+// been indexed, the older sections being skipped as their receipts were pruned.
+func ReadLogIndexTail(db ethdb.KeyValueReader) uint64 {
+	data, _ := db.Get(logIndexTailKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+// WriteLogIndexTail stores the number of the oldest section whose logs have been
+// indexed.
+func WriteLogIndexTail(db ethdb.KeyValueWriter, section uint64) {
+	if err := db.Put(logIndexTailKey, encodeBlockNumber(section)); err != nil {
+		log.Crit("Failed to store the log index tail", "err", err)
+	}
+}
+
 // DeleteBloombits removes all compressed bloom bits vector belonging to the
 // given section range and bit index.
 func DeleteBloombits(db ethdb.Database, bit uint, from uint64, to uint64) {
@@ -86,8 +86,9 @@ func InitDatabaseFromFreezer(db ethdb.Database) {
 }
 
 type blockTxHashes struct {
-	number uint64
-	hashes []common.Hash
+	number    uint64
+	hashes    []common.Hash
+	addresses [][]common.Address // Addresses of every transaction, nil if not indexed by address
 }
 
 // iterateTransactions iterates over all transactions in the (canon) block
@@ -95,6 +96,12 @@ type blockTxHashes struct {
 // received from interrupt channel, the iteration will be aborted and result
 // channel will be closed.
 func iterateTransactions(db ethdb.Database, from uint64, to uint64, reverse bool, interrupt chan struct{}) chan *blockTxHashes {
+	return iterateBlockTransactions(db, from, to, reverse, nil, interrupt)
+}
+
+// iterateBlockTransactions is iterateTransactions, additionally yielding the
+// addresses of every transaction if a signer is given.
+func iterateBlockTransactions(db ethdb.Database, from uint64, to uint64, reverse bool, signer types.Signer, interrupt chan struct{}) chan *blockTxHashes {
 	// One thread sequentially reads data from db
 	type numberRlp struct {
 		number uint64
@@ -160,6 +167,10 @@ func iterateTransactions(db ethdb.Database, from uint64, to uint64, reverse bool
 				hashes: hashes,
 				number: data.number,
 			}
+			if signer != nil {
+				contracts := ReadCreatedContracts(db, data.number, ReadCanonicalHash(db, data.number))
+				result.addresses = blockAddresses(signer, body.Transactions, contracts)
+			}
 			// Feed the block to the aggregator, or abort on interrupt
 			select {
 			case hashesCh <- result:
@@ -185,7 +196,7 @@ func iterateTransactions(db ethdb.Database, from uint64, to uint64, reverse bool
 //
 // There is a passed channel, the whole procedure will be interrupted if any
 // signal received.
-func indexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}, hook func(uint64) bool, report bool) {
+func indexTransactions(db ethdb.Database, from uint64, to uint64, signer types.Signer, interrupt chan struct{}, hook func(uint64) bool, report bool) {
 	// short circuit for invalid range
 	if offset := db.BlockStore().AncientOffSet(); offset > from {
 		from = offset
@@ -194,7 +205,7 @@ func indexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan
 		return
 	}
 	var (
-		hashesCh = iterateTransactions(db, from, to, true, interrupt)
+		hashesCh = iterateBlockTransactions(db, from, to, true, signer, interrupt)
 		batch    = db.NewBatch()
 		start    = time.Now()
 		logged   = start.Add(-7 * time.Second)
@@ -224,11 +235,17 @@ func indexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan
 			delivery := queue.PopItem()
 			lastNum = delivery.number
 			WriteTxLookupEntries(batch, delivery.number, delivery.hashes)
+			if signer != nil {
+				writeAddressTxEntries(batch, delivery.number, delivery.hashes, delivery.addresses)
+			}
 			blocks++
 			txs += len(delivery.hashes)
 			// If enough data was accumulated in memory or we're at the last block, dump to disk
 			if batch.ValueSize() > ethdb.IdealBatchSize {
 				WriteTxIndexTail(batch, lastNum) // Also write the tail here
+				if signer != nil {
+					WriteAddressTxIndexTail(batch, lastNum)
+				}
 				if err := batch.Write(); err != nil {
 					log.Crit("Failed writing batch to db", "error", err)
 					return
@@ -246,6 +263,9 @@ func indexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan
 	// that the last batch is empty because nothing to index, but the tail has to
 	// be flushed anyway.
 	WriteTxIndexTail(batch, lastNum)
+	if signer != nil {
+		WriteAddressTxIndexTail(batch, lastNum)
+	}
 	if err := batch.Write(); err != nil {
 		log.Crit("Failed writing batch to db", "error", err)
 		return
@@ -272,19 +292,25 @@ func indexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan
 // There is a passed channel, the whole procedure will be interrupted if any
 // signal received.
 func IndexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}, report bool) {
-	indexTransactions(db, from, to, interrupt, nil, report)
+	indexTransactions(db, from, to, nil, interrupt, nil, report)
+}
+
+// IndexTransactionsAndAddresses is IndexTransactions, additionally indexing the
+// transactions by the addresses derived with the given signer.
+func IndexTransactionsAndAddresses(db ethdb.Database, from uint64, to uint64, signer types.Signer, interrupt chan struct{}, report bool) {
+	indexTransactions(db, from, to, signer, interrupt, nil, report)
 }
 
 // indexTransactionsForTesting is the internal debug version with an additional hook.
 func indexTransactionsForTesting(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}, hook func(uint64) bool) {
-	indexTransactions(db, from, to, interrupt, hook, false)
+	indexTransactions(db, from, to, nil, interrupt, hook, false)
 }
 
 // unindexTransactions removes txlookup indices of the specified block range.
 //
 // There is a passed channel, the whole procedure will be interrupted if any
 // signal received.
-func unindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}, hook func(uint64) bool, report bool) {
+func unindexTransactions(db ethdb.Database, from uint64, to uint64, signer types.Signer, interrupt chan struct{}, hook func(uint64) bool, report bool) {
 	// short circuit for invalid range
 	if offset := db.BlockStore().AncientOffSet(); offset > from {
 		from = offset
@@ -293,7 +319,7 @@ func unindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt ch
 		return
 	}
 	var (
-		hashesCh = iterateTransactions(db, from, to, false, interrupt)
+		hashesCh = iterateBlockTransactions(db, from, to, false, signer, interrupt)
 		batch    = db.NewBatch()
 		start    = time.Now()
 		logged   = start.Add(-7 * time.Second)
@@ -320,6 +346,10 @@ func unindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt ch
 			delivery := queue.PopItem()
 			nextNum = delivery.number + 1
 			DeleteTxLookupEntries(batch, delivery.hashes)
+			if signer != nil {
+				deleteAddressTxEntries(batch, delivery.number, delivery.addresses)
+				deleteCreatedContracts(db, batch, delivery.number)
+			}
 			txs += len(delivery.hashes)
 			blocks++
 
@@ -328,6 +358,9 @@ func unindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt ch
 			// often than that.
 			if blocks%1000 == 0 {
 				WriteTxIndexTail(batch, nextNum)
+				if signer != nil {
+					WriteAddressTxIndexTail(batch, nextNum)
+				}
 				if err := batch.Write(); err != nil {
 					log.Crit("Failed writing batch to db", "error", err)
 					return
@@ -345,6 +378,9 @@ func unindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt ch
 	// that the last batch is empty because nothing to unindex, but the tail has to
 	// be flushed anyway.
 	WriteTxIndexTail(batch, nextNum)
+	if signer != nil {
+		WriteAddressTxIndexTail(batch, nextNum)
+	}
 	if err := batch.Write(); err != nil {
 		log.Crit("Failed writing batch to db", "error", err)
 		return
@@ -367,10 +403,16 @@ func unindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt ch
 // There is a passed channel, the whole procedure will be interrupted if any
 // signal received.
 func UnindexTransactions(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}, report bool) {
-	unindexTransactions(db, from, to, interrupt, nil, report)
+	unindexTransactions(db, from, to, nil, interrupt, nil, report)
+}
+
+// UnindexTransactionsAndAddresses is UnindexTransactions, additionally removing
+// the address indices derived with the given signer.
+func UnindexTransactionsAndAddresses(db ethdb.Database, from uint64, to uint64, signer types.Signer, interrupt chan struct{}, report bool) {
+	unindexTransactions(db, from, to, signer, interrupt, nil, report)
 }
 
 // unindexTransactionsForTesting is the internal debug version with an additional hook.
 func unindexTransactionsForTesting(db ethdb.Database, from uint64, to uint64, interrupt chan struct{}, hook func(uint64) bool) {
-	unindexTransactions(db, from, to, interrupt, hook, false)
+	unindexTransactions(db, from, to, nil, interrupt, hook, false)
 }
@@ -937,10 +937,13 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		storageTries    stat
 		codes           stat
 		txLookups       stat
+		addressTxs      stat
 		accountSnaps    stat
 		storageSnaps    stat
 		preimages       stat
 		bloomBits       stat
+		logIndex        stat
+		accountChanges  stat
 		cliqueSnaps     stat
 		parliaSnaps     stat
 
@@ -987,6 +990,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			codes.Add(size)
 		case bytes.HasPrefix(key, txLookupPrefix) && len(key) == (len(txLookupPrefix)+common.HashLength):
 			txLookups.Add(size)
+		case bytes.HasPrefix(key, addressTxPrefix) && len(key) == (len(addressTxPrefix)+common.AddressLength+8+4):
+			addressTxs.Add(size)
+		case bytes.HasPrefix(key, createdContractsPrefix) && len(key) == (len(createdContractsPrefix)+8+common.HashLength):
+			addressTxs.Add(size)
 		case bytes.HasPrefix(key, SnapshotAccountPrefix) && len(key) == (len(SnapshotAccountPrefix)+common.HashLength):
 			accountSnaps.Add(size)
 		case bytes.HasPrefix(key, SnapshotStoragePrefix) && len(key) == (len(SnapshotStoragePrefix)+2*common.HashLength):
@@ -1001,6 +1008,14 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			bloomBits.Add(size)
 		case bytes.HasPrefix(key, BloomBitsIndexPrefix):
 			bloomBits.Add(size)
+		case bytes.HasPrefix(key, logIndexPrefix) && len(key) == (len(logIndexPrefix)+33+8+common.HashLength):
+			logIndex.Add(size)
+		case bytes.HasPrefix(key, LogIndexIndexPrefix):
+			logIndex.Add(size)
+		case bytes.HasPrefix(key, accountChangePrefix) && len(key) == (len(accountChangePrefix)+common.AddressLength+8+common.HashLength):
+			accountChanges.Add(size)
+		case bytes.HasPrefix(key, accountChangeBlockPrefix) && len(key) == (len(accountChangeBlockPrefix)+8+common.HashLength):
+			accountChanges.Add(size)
 		case bytes.HasPrefix(key, CliqueSnapshotPrefix) && len(key) == 7+common.HashLength:
 			cliqueSnaps.Add(size)
 		case bytes.HasPrefix(key, ParliaSnapshotPrefix) && len(key) == 7+common.HashLength:
@@ -1021,6 +1036,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 				snapshotGeneratorKey, snapshotRecoveryKey, txIndexTailKey, fastTxLookupLimitKey,
 				uncleanShutdownKey, badBlockKey, transitionStatusKey, skeletonSyncStatusKey,
 				persistentStateIDKey, trieJournalKey, snapshotSyncStatusKey, snapSyncStatusFlagKey,
+				addressTxIndexTailKey, accountChangeIndexTailKey, accountChangeIndexHeadKey, logIndexTailKey,
 			} {
 				if bytes.Equal(key, meta) {
 					metadata.Add(size)
@@ -1137,7 +1153,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		{"Key-Value store", "Block number->hash", numHashPairings.Size(), numHashPairings.Count()},
 		{"Key-Value store", "Block hash->number", hashNumPairings.Size(), hashNumPairings.Count()},
 		{"Key-Value store", "Transaction index", txLookups.Size(), txLookups.Count()},
+		{"Key-Value store", "Address transaction index", addressTxs.Size(), addressTxs.Count()},
 		{"Key-Value store", "Bloombit index", bloomBits.Size(), bloomBits.Count()},
+		{"Key-Value store", "Log index", logIndex.Size(), logIndex.Count()},
+		{"Key-Value store", "Account change index", accountChanges.Size(), accountChanges.Count()},
 		{"Key-Value store", "Contract codes", codes.Size(), codes.Count()},
 		{"Key-Value store", "Hash trie nodes", legacyTries.Size(), legacyTries.Count()},
 		{"Key-Value store", "Path trie state lookups", stateLookups.Size(), stateLookups.Count()},
@@ -79,6 +79,21 @@ var (
 	// txIndexTailKey tracks the oldest block whose transactions have been indexed.
 	txIndexTailKey = []byte("TransactionIndexTail")
 
+	// addressTxIndexTailKey tracks the oldest block whose transactions have been
+	// indexed by address.
+	addressTxIndexTailKey = []byte("TxByAddressIndexTail")
+
+	// accountChangeIndexTailKey tracks the oldest block whose account changes have
+	// been indexed.
+	accountChangeIndexTailKey = []byte("ChangeIndexTail")
+
+	// accountChangeIndexHeadKey tracks the latest block whose account changes have
+	// been indexed.
+	accountChangeIndexHeadKey = []byte("ChangeIndexHead")
+
+	// logIndexTailKey tracks the oldest section whose logs have been indexed.
+	logIndexTailKey = []byte("FilterIndexTail")
+
 	// fastTxLookupLimitKey tracks the transaction lookup limit during fast sync.
 	// This flag is deprecated, it's kept to avoid reporting errors when inspect
 	// database.
@@ -122,6 +137,7 @@ var (
 
 	txLookupPrefix        = []byte("l") // txLookupPrefix + hash -> transaction/receipt lookup metadata
 	bloomBitsPrefix       = []byte("B") // bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
+	logIndexPrefix        = []byte("x") // logIndexPrefix + term + section (uint64 big endian) + hash -> log positions
 	SnapshotAccountPrefix = []byte("a") // SnapshotAccountPrefix + account hash -> account trie value
 	SnapshotStoragePrefix = []byte("o") // SnapshotStoragePrefix + account hash + storage hash -> storage trie value
 	CodePrefix            = []byte("c") // CodePrefix + code hash -> account code
@@ -141,6 +157,9 @@ var (
 	// BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 	BloomBitsIndexPrefix = []byte("iB")
 
+	// LogIndexIndexPrefix is the data table of the log index chain indexer to track its progress
+	LogIndexIndexPrefix = []byte("ix")
+
 	ChtPrefix           = []byte("chtRootV2-") // ChtPrefix + chtNum (uint64 big endian) -> trie root hash
 	ChtTablePrefix      = []byte("cht-")
 	ChtIndexTablePrefix = []byte("chtIndexV2-")
@@ -154,6 +173,12 @@ var (
 
 	BlockBlobSidecarsPrefix = []byte("blobs")
 
+	addressTxPrefix        = []byte("TxByAddress-")      // addressTxPrefix + address + num (uint64 big endian) + index (uint32 big endian) -> tx hash
+	createdContractsPrefix = []byte("CreatedContracts-") // createdContractsPrefix + num (uint64 big endian) + hash -> contracts created by every transaction
+
+	accountChangePrefix      = []byte("ChangeIndex-a") // accountChangePrefix + address + num (uint64 big endian) + hash -> balance and nonce
+	accountChangeBlockPrefix = []byte("ChangeIndex-b") // accountChangeBlockPrefix + num (uint64 big endian) + hash -> changed addresses
+
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
 )
@@ -218,6 +243,28 @@ func diffLayerKey(hash common.Hash) []byte {
 	return append(diffLayerPrefix, hash.Bytes()...)
 }
 
+// addressTxKey = addressTxPrefix + address + num (uint64 big endian) + index (uint32 big endian)
+func addressTxKey(address common.Address, number uint64, index uint32) []byte {
+	enc := make([]byte, 4)
+	binary.BigEndian.PutUint32(enc, index)
+	return append(append(append(addressTxPrefix, address.Bytes()...), encodeBlockNumber(number)...), enc...)
+}
+
+// createdContractsKey = createdContractsPrefix + num (uint64 big endian) + hash
+func createdContractsKey(number uint64, hash common.Hash) []byte {
+	return append(append(createdContractsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// accountChangeKey = accountChangePrefix + address + num (uint64 big endian) + hash
+func accountChangeKey(address common.Address, number uint64, hash common.Hash) []byte {
+	return append(append(append(accountChangePrefix, address.Bytes()...), encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// accountChangeBlockKey = accountChangeBlockPrefix + num (uint64 big endian) + hash
+func accountChangeBlockKey(number uint64, hash common.Hash) []byte {
+	return append(append(accountChangeBlockPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
 // txLookupKey = txLookupPrefix + hash
 func txLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
@@ -252,6 +299,14 @@ func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	return key
 }
 
+// logIndexKey = logIndexPrefix + term + section (uint64 big endian) + hash
+func logIndexKey(term []byte, section uint64, hash common.Hash) []byte {
+	key := make([]byte, 0, len(logIndexPrefix)+len(term)+8+common.HashLength)
+	key = append(append(key, logIndexPrefix...), term...)
+	key = binary.BigEndian.AppendUint64(key, section)
+	return append(key, hash.Bytes()...)
+}
+
 // preimageKey = PreimagePrefix + hash
 func preimageKey(hash common.Hash) []byte {
 	return append(PreimagePrefix, hash.Bytes()...)
@@ -18,9 +18,11 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"runtime"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -123,6 +125,10 @@ type StateDB struct {
 	// Preimages occurred seen by VM in the scope of block.
 	preimages map[common.Hash][]byte
 
+	// Contracts created in the scope of block, by the hash of the creating
+	// transaction.
+	contracts map[common.Hash][]common.Address
+
 	// Per-transaction access list
 	accessList *accessList
 
@@ -186,6 +192,7 @@ func New(root common.Hash, db Database, snaps *snapshot.Tree) (*StateDB, error)
 		stateObjectsDestruct: make(map[common.Address]*types.StateAccount, defaultNumOfSlots),
 		logs:                 make(map[common.Hash][]*types.Log),
 		preimages:            make(map[common.Hash][]byte),
+		contracts:            make(map[common.Hash][]common.Address),
 		journal:              newJournal(),
 		accessList:           newAccessList(),
 		transientStorage:     newTransientStorage(),
@@ -395,6 +402,48 @@ func (s *StateDB) Preimages() map[common.Hash][]byte {
 	return s.preimages
 }
 
+// CreatedContracts returns the contracts created by the transaction with the
+// given hash, sorted by address, including the ones created by internal calls.
+// A contract is recorded once the creating transaction is finalised.
+func (s *StateDB) CreatedContracts(hash common.Hash) []common.Address {
+	contracts := slices.Clone(s.contracts[hash])
+	slices.SortFunc(contracts, func(a, b common.Address) int { return a.Cmp(b) })
+	return contracts
+}
+
+// AccountChanges returns the accounts whose balance or nonce differs from the
+// beginning of the block, sorted by address. It must be called after the state
+// has been finalised and before it is committed.
+func (s *StateDB) AccountChanges() []types.AccountChange {
+	var changes []types.AccountChange
+	for addr := range s.stateObjectsDirty {
+		obj := s.stateObjects[addr]
+		if obj == nil {
+			continue
+		}
+		origin := obj.origin
+		if prev, destructed := s.stateObjectsDestruct[addr]; destructed {
+			origin = prev
+		}
+		balance, nonce := new(uint256.Int), uint64(0)
+		if !obj.deleted {
+			balance, nonce = obj.data.Balance, obj.data.Nonce
+		}
+		if origin == nil {
+			if balance.IsZero() && nonce == 0 {
+				continue
+			}
+		} else if origin.Balance.Eq(balance) && origin.Nonce == nonce {
+			continue
+		}
+		changes = append(changes, types.AccountChange{Address: addr, Balance: balance.ToBig(), Nonce: nonce})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Address.Cmp(changes[j].Address) < 0
+	})
+	return changes
+}
+
 // AddRefund adds gas to the refund counter
 func (s *StateDB) AddRefund(gas uint64) {
 	s.journal.append(refundChange{prev: s.refund})
@@ -888,6 +937,7 @@ func (s *StateDB) copyInternal(doPrefetch bool) *StateDB {
 		logs:      make(map[common.Hash][]*types.Log, len(s.logs)),
 		logSize:   s.logSize,
 		preimages: make(map[common.Hash][]byte, len(s.preimages)),
+		contracts: make(map[common.Hash][]common.Address, len(s.contracts)),
 		journal:   newJournal(),
 		hasher:    crypto.NewKeccakState(),
 
@@ -954,6 +1004,10 @@ func (s *StateDB) copyInternal(doPrefetch bool) *StateDB {
 	for hash, preimage := range s.preimages {
 		state.preimages[hash] = preimage
 	}
+	// Deep copy the contracts created in the scope of block
+	for hash, contracts := range s.contracts {
+		state.contracts[hash] = slices.Clone(contracts)
+	}
 	// Do we need to copy the access list and transient storage?
 	// In practice: No. At the start of a transaction, these two lists are empty.
 	// In practice, we only ever copy state _between_ transactions/blocks, never
@@ -1050,6 +1104,11 @@ func (s *StateDB) Finalise(deleteEmptyObjects bool) {
 		} else {
 			obj.finalise(true) // Prefetch slots in the background
 		}
+		// Record the contracts deployed by the transaction, failed deployments
+		// have been reverted along with the account creation.
+		if obj.created && !bytes.Equal(obj.CodeHash(), types.EmptyCodeHash.Bytes()) {
+			s.contracts[s.thash] = append(s.contracts[s.thash], addr)
+		}
 		obj.created = false
 		s.stateObjectsPending[addr] = struct{}{}
 		s.stateObjectsDirty[addr] = struct{}{}
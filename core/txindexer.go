@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -46,6 +47,7 @@ type txIndexer struct {
 	//       and all others shouldn't.
 	limit    uint64
 	db       ethdb.Database
+	signer   types.Signer // Signer deriving the indexed addresses, nil if not indexed by address
 	progress chan chan TxIndexProgress
 	term     chan chan struct{}
 	closed   chan struct{}
@@ -56,10 +58,20 @@ func newTxIndexer(limit uint64, chain *BlockChain) *txIndexer {
 	indexer := &txIndexer{
 		limit:    limit,
 		db:       chain.db,
+		signer:   chain.addressSigner,
 		progress: make(chan chan TxIndexProgress),
 		term:     make(chan chan struct{}),
 		closed:   make(chan struct{}),
 	}
+	// Reindex the whole range if the addresses are not indexed as far as the
+	// transactions, e.g. if the address index has just been enabled.
+	if indexer.signer != nil {
+		txTail, addrTail := rawdb.ReadTxIndexTail(indexer.db), rawdb.ReadAddressTxIndexTail(indexer.db)
+		if txTail != nil && (addrTail == nil || *addrTail != *txTail) {
+			log.Info("Reindexing transactions to index addresses")
+			rawdb.DeleteTxIndexTail(indexer.db)
+		}
+	}
 	go indexer.loop(chain)
 
 	var msg string
@@ -91,7 +103,7 @@ func (indexer *txIndexer) run(tail *uint64, head uint64, stop chan struct{}, don
 		if indexer.limit != 0 && head >= indexer.limit {
 			from = head - indexer.limit + 1
 		}
-		rawdb.IndexTransactions(indexer.db, from, head+1, stop, true)
+		indexer.index(from, head+1, stop)
 		return
 	}
 	// The tail flag is existent (which means indexes in [tail, head] should be
@@ -105,7 +117,7 @@ func (indexer *txIndexer) run(tail *uint64, head uint64, stop chan struct{}, don
 			if end > head+1 {
 				end = head + 1
 			}
-			rawdb.IndexTransactions(indexer.db, 0, end, stop, true)
+			indexer.index(0, end, stop)
 		}
 		return
 	}
@@ -113,10 +125,30 @@ func (indexer *txIndexer) run(tail *uint64, head uint64, stop chan struct{}, don
 	// limit and the latest chain head.
 	if head-indexer.limit+1 < *tail {
 		// Reindex a part of missing indices and rewind index tail to HEAD-limit
-		rawdb.IndexTransactions(indexer.db, head-indexer.limit+1, *tail, stop, true)
+		indexer.index(head-indexer.limit+1, *tail, stop)
 	} else {
 		// Unindex a part of stale indices and forward index tail to HEAD-limit
-		rawdb.UnindexTransactions(indexer.db, *tail, head-indexer.limit+1, stop, false)
+		indexer.unindex(*tail, head-indexer.limit+1, stop)
+	}
+}
+
+// index creates the transaction indices of the given block range, by address as
+// well if enabled.
+func (indexer *txIndexer) index(from uint64, to uint64, stop chan struct{}) {
+	if indexer.signer != nil {
+		rawdb.IndexTransactionsAndAddresses(indexer.db, from, to, indexer.signer, stop, true)
+	} else {
+		rawdb.IndexTransactions(indexer.db, from, to, stop, true)
+	}
+}
+
+// unindex removes the transaction indices of the given block range, by address
+// as well if enabled.
+func (indexer *txIndexer) unindex(from uint64, to uint64, stop chan struct{}) {
+	if indexer.signer != nil {
+		rawdb.UnindexTransactionsAndAddresses(indexer.db, from, to, indexer.signer, stop, false)
+	} else {
+		rawdb.UnindexTransactions(indexer.db, from, to, stop, false)
 	}
 }
 
@@ -20,11 +20,13 @@ import (
 	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
@@ -241,3 +243,196 @@ func TestTxIndexer(t *testing.T) {
 		os.RemoveAll(frdir)
 	}
 }
+
+// TestAddressTxIndexer tests the maintenance of the transaction indexes by
+// address along with the transaction indexes.
+func TestAddressTxIndexer(t *testing.T) {
+	var (
+		testBankKey, _  = crypto.GenerateKey()
+		testBankAddress = crypto.PubkeyToAddress(testBankKey.PublicKey)
+		testBankFunds   = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+		recipient       = common.HexToAddress("0xdeadbeef")
+		contract        = crypto.CreateAddress(testBankAddress, 3)
+
+		gspec = &Genesis{
+			Config:  params.TestChainConfig,
+			Alloc:   types.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+		engine    = ethash.NewFaker()
+		signer    = types.LatestSigner(gspec.Config)
+		nonce     = uint64(0)
+		chainHead = uint64(16)
+	)
+	_, blocks, receipts := GenerateChainWithGenesis(gspec, engine, int(chainHead), func(i int, gen *BlockGen) {
+		var tx *types.Transaction
+		if i == 3 {
+			tx, _ = types.SignTx(types.NewContractCreation(nonce, common.Big0, 100000, big.NewInt(10*params.InitialBaseFee), []byte{0x60, 0x00}), signer, testBankKey)
+		} else {
+			tx, _ = types.SignTx(types.NewTransaction(nonce, recipient, big.NewInt(1000), params.TxGas, big.NewInt(10*params.InitialBaseFee), nil), signer, testBankKey)
+		}
+		gen.AddTx(tx)
+		nonce += 1
+	})
+	frdir := t.TempDir()
+	db, _ := rawdb.NewDatabaseWithFreezer(rawdb.NewMemoryDatabase(), frdir, "", false, false, false, false, false)
+	defer db.Close()
+	rawdb.WriteAncientBlocks(db, append([]*types.Block{gspec.ToBlock()}, blocks...), append([]types.Receipts{{}}, receipts...), big.NewInt(0))
+
+	indexer := &txIndexer{
+		db:       db,
+		signer:   signer,
+		progress: make(chan chan TxIndexProgress),
+	}
+	indexer.run(nil, chainHead, make(chan struct{}), make(chan struct{}))
+	if tail := rawdb.ReadAddressTxIndexTail(db); tail == nil || *tail != 0 {
+		t.Fatalf("address index tail mismatch: have %v, want %d", tail, 0)
+	}
+	if entries := rawdb.ReadAddressTxEntries(db, testBankAddress, 0, 0, chainHead, 0); len(entries) != int(chainHead) {
+		t.Fatalf("sender entry count mismatch: have %d, want %d", len(entries), chainHead)
+	}
+	if entries := rawdb.ReadAddressTxEntries(db, recipient, 0, 0, chainHead, 0); len(entries) != int(chainHead)-1 {
+		t.Fatalf("recipient entry count mismatch: have %d, want %d", len(entries), chainHead-1)
+	}
+	entries := rawdb.ReadAddressTxEntries(db, contract, 0, 0, chainHead, 0)
+	if len(entries) != 1 || entries[0].Number != 4 || entries[0].Hash != blocks[3].Transactions()[0].Hash() {
+		t.Fatalf("created contract entries mismatch: %v", entries)
+	}
+	// Entries are returned in order, starting at the requested position
+	entries = rawdb.ReadAddressTxEntries(db, testBankAddress, 5, 1, chainHead, 3)
+	if len(entries) != 3 || entries[0].Number != 6 || entries[2].Number != 8 {
+		t.Fatalf("paginated entries mismatch: %v", entries)
+	}
+	// Entries falling out of the range are removed along with the lookups
+	indexer.limit = 8
+	indexer.run(rawdb.ReadTxIndexTail(db), chainHead, make(chan struct{}), make(chan struct{}))
+	if tail := rawdb.ReadAddressTxIndexTail(db); tail == nil || *tail != 9 {
+		t.Fatalf("address index tail mismatch: have %v, want %d", tail, 9)
+	}
+	if entries := rawdb.ReadAddressTxEntries(db, testBankAddress, 0, 0, 8, 0); len(entries) != 0 {
+		t.Fatalf("unindexed entries left: %v", entries)
+	}
+	if entries := rawdb.ReadAddressTxEntries(db, testBankAddress, 0, 0, chainHead, 0); len(entries) != 8 || entries[0].Number != 9 {
+		t.Fatalf("indexed entries mismatch: %v", entries)
+	}
+}
+
+// Tests that the transactions of an address are retrieved from the chain,
+// skipping the stale entries.
+func TestGetAddressTransactions(t *testing.T) {
+	var (
+		testBankKey, _  = crypto.GenerateKey()
+		testBankAddress = crypto.PubkeyToAddress(testBankKey.PublicKey)
+		testBankFunds   = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+		recipient       = common.HexToAddress("0xdeadbeef")
+
+		gspec = &Genesis{
+			Config:  params.TestChainConfig,
+			Alloc:   types.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+		engine = ethash.NewFaker()
+		signer = types.LatestSigner(gspec.Config)
+		nonce  = uint64(0)
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 8, func(i int, gen *BlockGen) {
+		for j := 0; j < 2; j++ {
+			tx, _ := types.SignTx(types.NewTransaction(nonce, recipient, big.NewInt(1000), params.TxGas, big.NewInt(10*params.InitialBaseFee), nil), signer, testBankKey)
+			gen.AddTx(tx)
+			nonce += 1
+		}
+	})
+	db := rawdb.NewMemoryDatabase()
+	limit := uint64(0)
+	chain, err := NewBlockChain(db, nil, gspec, nil, engine, vm.Config{}, nil, &limit, EnableAddressIndex())
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	for i := 0; ; i++ {
+		if progress, _ := chain.TxIndexProgress(); progress.Done() && rawdb.ReadAddressTxIndexTail(db) != nil {
+			break
+		}
+		if i == 100 {
+			t.Fatal("transactions not indexed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	entries, err := chain.GetAddressTransactions(recipient, 2, 1, 8, 5)
+	if err != nil {
+		t.Fatalf("failed to retrieve transactions: %v", err)
+	}
+	if len(entries) != 5 || entries[0].Number != 2 || entries[0].Index != 1 || entries[4].Number != 4 || entries[4].Index != 1 {
+		t.Fatalf("transactions mismatch: %v", entries)
+	}
+	// Stale entries are skipped, filling the page with the next ones. Replace
+	// the entries of block 3 with the ones of transactions from block 7.
+	rawdb.WriteAddressTxEntriesByBlock(db, signer, types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3)}).WithBody(blocks[6].Transactions(), nil), nil)
+	entries, _ = chain.GetAddressTransactions(recipient, 3, 0, 8, 2)
+	if len(entries) != 2 || entries[0].Number != 4 || entries[0].Hash != blocks[3].Transactions()[0].Hash() {
+		t.Fatalf("transactions with stale entries mismatch: %v", entries)
+	}
+}
+
+// Tests that the contracts created by internal calls are indexed along with the
+// transactions creating them, from the results of the execution.
+func TestAddressIndexCreatedContracts(t *testing.T) {
+	var (
+		testBankKey, _  = crypto.GenerateKey()
+		testBankAddress = crypto.PubkeyToAddress(testBankKey.PublicKey)
+		testBankFunds   = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+
+		// The factory deploys a contract whose code is a single STOP on every call
+		factory     = common.HexToAddress("0xfac7")
+		factoryCode = common.FromHex("0x69600060005360016000f3600052600a60166000f000")
+		child       = crypto.CreateAddress(factory, 1)
+
+		gspec = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				testBankAddress: {Balance: testBankFunds},
+				factory:         {Code: factoryCode, Nonce: 1},
+			},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+		engine = ethash.NewFaker()
+		signer = types.LatestSigner(gspec.Config)
+	)
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 3, func(i int, gen *BlockGen) {
+		if i == 1 {
+			tx, _ := types.SignTx(types.NewTransaction(gen.TxNonce(testBankAddress), factory, common.Big0, 200000, big.NewInt(10*params.InitialBaseFee), nil), signer, testBankKey)
+			gen.AddTx(tx)
+		}
+	})
+	db := rawdb.NewMemoryDatabase()
+	limit := uint64(0)
+	chain, err := NewBlockChain(db, nil, gspec, nil, engine, vm.Config{}, nil, &limit, EnableAddressIndex())
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	for i := 0; ; i++ {
+		if progress, _ := chain.TxIndexProgress(); progress.Done() && rawdb.ReadAddressTxIndexTail(db) != nil {
+			break
+		}
+		if i == 100 {
+			t.Fatal("transactions not indexed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if contracts := rawdb.ReadCreatedContracts(db, 2, blocks[1].Hash()); len(contracts) != 1 || len(contracts[0]) != 1 || contracts[0][0] != child {
+		t.Fatalf("created contracts mismatch: %v", contracts)
+	}
+	entries := rawdb.ReadAddressTxEntries(db, child, 0, 0, 3, 0)
+	if len(entries) != 1 || entries[0].Number != 2 || entries[0].Hash != blocks[1].Transactions()[0].Hash() {
+		t.Fatalf("created contract entries mismatch: %v", entries)
+	}
+}
@@ -63,4 +63,12 @@ var (
 
 	// ErrInBlackList is returned if the transaction send by banned address
 	ErrInBlackList = errors.New("sender or to in black list")
+
+	// ErrDeployerNotAllowed is returned if a contract creation transaction is sent
+	// by an address the deployer proxy contract does not permit to deploy.
+	ErrDeployerNotAllowed = errors.New("sender not allowed to deploy contracts")
+
+	// ErrPrivateTxExpired is returned if a private transaction is submitted with
+	// an expiry block the chain has already reached.
+	ErrPrivateTxExpired = errors.New("private transaction expired")
 )
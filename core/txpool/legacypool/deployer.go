@@ -0,0 +1,82 @@
+package legacypool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// deployerRejectMeter counts the contract creations refused by the deployer proxy.
+var deployerRejectMeter = metrics.NewRegisteredMeter("txpool/deployer/reject", nil)
+
+// validateDeployer checks whether the sender of a contract creation transaction
+// is allowed to deploy contracts. It runs the deployer proxy registration the EVM
+// performs on every creation against a copy of the head state, so transactions
+// that would be reverted by the deployment hook are refused before they are
+// propagated and included.
+//
+// The check is only done if enabled in the pool config, and is skipped while the
+// deployer proxy contract is not deployed yet.
+func (pool *LegacyPool) validateDeployer(tx *types.Transaction, from common.Address) error {
+	if !pool.config.DeployerCheck || tx.To() != nil {
+		return nil
+	}
+	if len(pool.currentState.GetCode(systemcontract.DeployerProxyContractAddress)) == 0 {
+		return nil
+	}
+	var (
+		head   = pool.currentHead.Load()
+		number = new(big.Int).Add(head.Number, common.Big1)
+		rules  = pool.chainconfig.Rules(number, false, head.Time)
+		proxy  = systemcontract.DeployerProxyContractAddress
+	)
+	// The sender is both the origin and the caller of a contract creation
+	input, err := vm.DeploymentRegistration(rules, from, from, crypto.CreateAddress(from, tx.Nonce()))
+	if err != nil {
+		return err
+	}
+	// The state is copied once per reset, and the registration reverted after
+	// every check
+	if pool.deployState == nil {
+		pool.deployState = pool.currentState.Copy()
+	}
+	statedb := pool.deployState
+	defer statedb.RevertToSnapshot(statedb.Snapshot())
+	statedb.Prepare(rules, head.Coinbase, head.Coinbase, &proxy, vm.ActivePrecompiles(rules), nil)
+
+	var (
+		context = vm.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			GetHash:     func(uint64) common.Hash { return common.Hash{} },
+			Coinbase:    head.Coinbase,
+			GasLimit:    head.GasLimit,
+			BlockNumber: number,
+			Time:        head.Time,
+			Difficulty:  new(big.Int),
+			BaseFee:     new(big.Int),
+		}
+		txContext = vm.TxContext{Origin: from, GasPrice: new(big.Int)}
+		evm       = vm.NewEVM(context, txContext, statedb, pool.chainconfig, vm.Config{NoBaseFee: true})
+	)
+	ret, _, err := evm.Call(vm.AccountRef(head.Coinbase), proxy, input, tx.Gas(), common.U2560)
+	if err == nil {
+		return nil
+	}
+	deployerRejectMeter.Mark(1)
+	log.Trace("Rejecting contract creation from disallowed deployer", "hash", tx.Hash(), "from", from, "err", err)
+	if reason, unpackErr := abi.UnpackRevert(ret); unpackErr == nil {
+		return fmt.Errorf("%w: %s", txpool.ErrDeployerNotAllowed, reason)
+	}
+	return txpool.ErrDeployerNotAllowed
+}
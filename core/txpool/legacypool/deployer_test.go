@@ -0,0 +1,99 @@
+package legacypool
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tests that contract creations refused by the deployer proxy are rejected at
+// admission if the deployer check is enabled, while calls are unaffected.
+func TestDeployerCheck(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	create := func(nonce uint64) *types.Transaction {
+		tx, _ := types.SignTx(types.NewContractCreation(nonce, common.Big0, 100000, big.NewInt(1), []byte{0x00}), types.HomesteadSigner{}, key)
+		return tx
+	}
+	// Without a deployer proxy every creation is accepted
+	pool.mu.Lock()
+	pool.config.DeployerCheck = true
+	pool.mu.Unlock()
+
+	if err := pool.addRemoteSync(create(0)); err != nil {
+		t.Fatalf("failed to add creation without deployer proxy: %v", err)
+	}
+	// A proxy that reverts refuses all deployments: PUSH1 0 PUSH1 0 REVERT
+	pool.mu.Lock()
+	pool.currentState.SetCode(systemcontract.DeployerProxyContractAddress, common.FromHex("0x60006000fd"))
+	pool.mu.Unlock()
+
+	if err := pool.addRemoteSync(create(1)); !errors.Is(err, txpool.ErrDeployerNotAllowed) {
+		t.Fatalf("creation error mismatch: have %v, want %v", err, txpool.ErrDeployerNotAllowed)
+	}
+	if err := pool.addRemoteSync(transaction(1, 100000, key)); err != nil {
+		t.Fatalf("failed to add call transaction: %v", err)
+	}
+	// Disabling the check admits the creation again
+	pool.mu.Lock()
+	pool.config.DeployerCheck = false
+	pool.mu.Unlock()
+
+	if err := pool.addRemoteSync(create(2)); err != nil {
+		t.Fatalf("failed to add creation with deployer check disabled: %v", err)
+	}
+}
+
+// Tests that the deployer checks share a single copy of the head state, which
+// the registrations do not modify, until the pool is reset.
+func TestDeployerCheckState(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	// A proxy recording every deployment: PUSH1 1 PUSH1 0 SSTORE STOP
+	pool.mu.Lock()
+	pool.config.DeployerCheck = true
+	pool.currentState.SetCode(systemcontract.DeployerProxyContractAddress, common.FromHex("0x600160005500"))
+	pool.mu.Unlock()
+
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx, _ := types.SignTx(types.NewContractCreation(nonce, common.Big0, 100000, big.NewInt(1), []byte{0x00}), types.HomesteadSigner{}, key)
+		if err := pool.addRemoteSync(tx); err != nil {
+			t.Fatalf("failed to add creation %d: %v", nonce, err)
+		}
+	}
+	pool.mu.Lock()
+	statedb := pool.deployState
+	pool.mu.Unlock()
+
+	if statedb == nil {
+		t.Fatalf("deployer check state not cached")
+	}
+	if slot := statedb.GetState(systemcontract.DeployerProxyContractAddress, common.Hash{}); slot != (common.Hash{}) {
+		t.Fatalf("deployment registration leaked into the cached state: %x", slot)
+	}
+	<-pool.requestReset(nil, nil)
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.deployState != nil {
+		t.Fatalf("deployer check state kept across a reset")
+	}
+}
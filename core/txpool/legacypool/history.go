@@ -0,0 +1,115 @@
+package legacypool
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Rules evicting transactions from the pool, as reported by the history.
+const (
+	evictLifetime        = "lifetime"        // Queued for longer than the configured lifetime
+	evictTruncatePending = "truncatePending" // Above the global pending limit
+	evictTruncateQueue   = "truncateQueue"   // Above the global queue limit
+	evictAccountQueue    = "accountQueue"    // Above the per-account queue limit
+	evictContractQuota   = "contractQuota"   // Above the per-contract pending quota
+	evictUnderpriced     = "underpriced"     // Outbid by better paying transactions, or below the minimum tip
+	evictNonceTooLow     = "nonceTooLow"     // Nonce consumed by an included transaction
+	evictUnpayable       = "unpayable"       // Sender can no longer pay for it, or above the block gas limit
+)
+
+// Reasons for a transaction to stay in the non-executable queue.
+const (
+	queuedNonceGap            = "nonceGap"
+	queuedInsufficientBalance = "insufficientBalance"
+	queuedUnderpriced         = "underpriced"
+)
+
+// txHistory is a bounded ring remembering the fate of the transactions that
+// recently left the pool, oldest records being overwritten first.
+type txHistory struct {
+	entries map[common.Hash]*txpool.TxLifecycle
+	order   []common.Hash // Ring of the recorded hashes, in insertion order
+	next    int           // Next position of the ring to overwrite once full
+	size    int           // Maximum number of records kept
+}
+
+// newTxHistory creates a transaction history of the given size.
+func newTxHistory(size int) *txHistory {
+	return &txHistory{
+		entries: make(map[common.Hash]*txpool.TxLifecycle),
+		size:    size,
+	}
+}
+
+// add records the fate of a transaction, overwriting the oldest record if the
+// history is full.
+func (h *txHistory) add(hash common.Hash, entry *txpool.TxLifecycle) {
+	if h.size == 0 {
+		return
+	}
+	if _, ok := h.entries[hash]; !ok {
+		if len(h.order) < h.size {
+			h.order = append(h.order, hash)
+		} else {
+			delete(h.entries, h.order[h.next])
+			h.order[h.next] = hash
+			h.next = (h.next + 1) % h.size
+		}
+	}
+	h.entries[hash] = entry
+}
+
+// get returns the recorded fate of a transaction, if any.
+func (h *txHistory) get(hash common.Hash) *txpool.TxLifecycle {
+	return h.entries[hash]
+}
+
+// recordEvicted remembers that the given transactions were dropped by a rule.
+func (pool *LegacyPool) recordEvicted(txs []*types.Transaction, rule string) {
+	now := time.Now()
+	for _, tx := range txs {
+		pool.history.add(tx.Hash(), &txpool.TxLifecycle{Status: txpool.TxLifecycleEvicted, Reason: rule, Time: now})
+	}
+}
+
+// recordReplaced remembers that a transaction was replaced by another one.
+func (pool *LegacyPool) recordReplaced(old *types.Transaction, by common.Hash) {
+	pool.history.add(old.Hash(), &txpool.TxLifecycle{Status: txpool.TxLifecycleReplaced, ReplacedBy: &by, Time: time.Now()})
+}
+
+// Lifecycle implements txpool.LifecycleTracker, returning the state of a pooled
+// transaction or the recorded fate of a recently removed one.
+func (pool *LegacyPool) Lifecycle(hash common.Hash) *txpool.TxLifecycle {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	tx := pool.all.Get(hash)
+	if tx == nil {
+		if entry := pool.history.get(hash); entry != nil {
+			cpy := *entry
+			return &cpy
+		}
+		return nil
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	if list := pool.pending[from]; list != nil && list.txs.Get(tx.Nonce()) == tx {
+		return &txpool.TxLifecycle{Status: txpool.TxLifecyclePending, Time: tx.Time()}
+	}
+	return &txpool.TxLifecycle{Status: txpool.TxLifecycleQueued, Reason: pool.queuedReason(from, tx), Time: tx.Time()}
+}
+
+// queuedReason explains why a queued transaction is not executable yet.
+func (pool *LegacyPool) queuedReason(from common.Address, tx *types.Transaction) string {
+	switch {
+	case tx.Nonce() > pool.pendingNonces.get(from):
+		return queuedNonceGap
+	case pool.currentState.GetBalance(from).ToBig().Cmp(tx.Cost()) < 0:
+		return queuedInsufficientBalance
+	case !pool.locals.contains(from) && tx.GasTipCapIntCmp(pool.gasTip.Load().ToBig()) < 0:
+		return queuedUnderpriced
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+package legacypool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the lifecycle of live transactions is derived from the pool, and
+// that of removed ones from the bounded history.
+func TestTransactionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.HistorySize = 2
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), makeAddressReserver())
+	defer pool.Close()
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+	var (
+		pending  = pricedTransaction(0, 100000, big.NewInt(1), key)
+		replaced = pricedTransaction(1, 100000, big.NewInt(1), key)
+		replacer = pricedTransaction(1, 100000, big.NewInt(2), key)
+		gapped   = transaction(3, 100000, key)
+	)
+	pool.addRemotesSync([]*types.Transaction{pending, replaced, gapped})
+	if err := pool.addRemoteSync(replacer); err != nil {
+		t.Fatalf("failed to replace transaction: %v", err)
+	}
+	check := func(hash common.Hash, status, reason string) *txpool.TxLifecycle {
+		t.Helper()
+		lifecycle := pool.Lifecycle(hash)
+		if lifecycle == nil {
+			t.Fatalf("missing lifecycle for %x", hash)
+		}
+		if lifecycle.Status != status || lifecycle.Reason != reason {
+			t.Fatalf("lifecycle mismatch: have %s/%s, want %s/%s", lifecycle.Status, lifecycle.Reason, status, reason)
+		}
+		return lifecycle
+	}
+	check(pending.Hash(), txpool.TxLifecyclePending, "")
+	check(gapped.Hash(), txpool.TxLifecycleQueued, queuedNonceGap)
+	if by := check(replaced.Hash(), txpool.TxLifecycleReplaced, "").ReplacedBy; by == nil || *by != replacer.Hash() {
+		t.Fatalf("replacement mismatch: have %v, want %x", by, replacer.Hash())
+	}
+	// Evict the queued transaction and ensure the oldest record gets overwritten
+	pool.mu.Lock()
+	pool.removeTx(gapped.Hash(), true, true)
+	pool.recordEvicted([]*types.Transaction{gapped}, evictTruncateQueue)
+	pool.recordEvicted([]*types.Transaction{transaction(4, 100000, key)}, evictLifetime)
+	pool.mu.Unlock()
+
+	check(gapped.Hash(), txpool.TxLifecycleEvicted, evictTruncateQueue)
+	if lifecycle := pool.Lifecycle(replaced.Hash()); lifecycle != nil {
+		t.Fatalf("overwritten record still reported: %v", lifecycle)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
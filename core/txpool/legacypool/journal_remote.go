@@ -0,0 +1,135 @@
+package legacypool
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// remoteJournalEntry is a single record of the remote transaction journal. The
+// time the pool first saw the transaction is stored along with it, so that the
+// age limit keeps being enforced across restarts.
+type remoteJournalEntry struct {
+	Time uint64 // Unix time the transaction was first seen
+	Tx   *types.Transaction
+}
+
+// remoteJournal is a snapshot of the remote transactions of the pool, allowing
+// public nodes to restart without dropping the transactions of their users.
+// Contrary to the local journal it is not appended on every insertion, only
+// regenerated periodically and on shutdown, and it is bounded in both size and
+// the age of the transactions it holds.
+type remoteJournal struct {
+	path   string        // Filesystem path to store the transactions at
+	size   uint64        // Maximum number of transactions to store
+	maxAge time.Duration // Maximum age of the transactions to store or load
+}
+
+// newRemoteTxJournal creates a new remote transaction journal.
+func newRemoteTxJournal(path string, size uint64, maxAge time.Duration) *remoteJournal {
+	return &remoteJournal{
+		path:   path,
+		size:   size,
+		maxAge: maxAge,
+	}
+}
+
+// expired reports whether a transaction first seen at the given time is too old
+// to be journaled.
+func (journal *remoteJournal) expired(seen time.Time) bool {
+	return journal.maxAge > 0 && time.Since(seen) > journal.maxAge
+}
+
+// load parses the remote transaction journal from disk, injecting the fresh
+// enough transactions into the pool through the given admission function, so
+// they are revalidated against the current head state.
+func (journal *remoteJournal) load(add func([]*types.Transaction) []error) error {
+	input, err := os.Open(journal.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	var (
+		stream  = rlp.NewStream(input, 0)
+		batch   types.Transactions
+		failure error
+
+		total, expired, dropped int
+	)
+	loadBatch := func(txs types.Transactions) {
+		for _, err := range add(txs) {
+			if err != nil {
+				log.Debug("Failed to add journaled remote transaction", "err", err)
+				dropped++
+			}
+		}
+	}
+	for {
+		entry := new(remoteJournalEntry)
+		if err = stream.Decode(entry); err != nil {
+			if err != io.EOF {
+				failure = err
+			}
+			if batch.Len() > 0 {
+				loadBatch(batch)
+			}
+			break
+		}
+		total++
+
+		seen := time.Unix(int64(entry.Time), 0)
+		if journal.expired(seen) {
+			expired++
+			continue
+		}
+		entry.Tx.SetTime(seen)
+		if batch = append(batch, entry.Tx); batch.Len() > 1024 {
+			loadBatch(batch)
+			batch = batch[:0]
+		}
+	}
+	log.Info("Loaded remote transaction journal", "transactions", total, "expired", expired, "dropped", dropped)
+
+	return failure
+}
+
+// rotate regenerates the remote transaction journal from the given transaction
+// batches, each of them ordered by nonce. Batches are stored in order until the
+// size limit is reached, cutting them at a nonce boundary to avoid gaps.
+func (journal *remoteJournal) rotate(batches []types.Transactions) error {
+	replacement, err := os.OpenFile(journal.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	journaled := uint64(0)
+	for _, txs := range batches {
+		for _, tx := range txs {
+			if journaled >= journal.size || journal.expired(tx.Time()) {
+				break
+			}
+			entry := &remoteJournalEntry{Time: uint64(tx.Time().Unix()), Tx: tx}
+			if err = rlp.Encode(replacement, entry); err != nil {
+				replacement.Close()
+				return err
+			}
+			journaled++
+		}
+	}
+	replacement.Close()
+
+	if err = os.Rename(journal.path+".new", journal.path); err != nil {
+		return err
+	}
+	log.Debug("Regenerated remote transaction journal", "transactions", journaled)
+	return nil
+}
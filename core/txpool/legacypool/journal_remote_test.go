@@ -0,0 +1,118 @@
+package legacypool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that remote transactions survive a pool restart through the remote
+// journal, bounded by its size and revalidated against the new head state.
+func TestRemoteJournaling(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.RemoteJournal = filepath.Join(t.TempDir(), "remotes.rlp")
+	config.RemoteJournalSize = 3
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), makeAddressReserver())
+
+	first, _ := crypto.GenerateKey()
+	second, _ := crypto.GenerateKey()
+	third, _ := crypto.GenerateKey()
+	for _, key := range []*ecdsa.PrivateKey{first, second, third} {
+		testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+	}
+	// Two executable accounts seen in order, and a gapped one exceeding the size
+	now := time.Now()
+	txs := types.Transactions{
+		transaction(0, 100000, first),
+		transaction(1, 100000, first),
+		transaction(0, 100000, second),
+		transaction(1, 100000, third),
+	}
+	for i, tx := range txs {
+		tx.SetTime(now.Add(time.Duration(i-len(txs)) * time.Second))
+	}
+	pool.addRemotesSync(txs)
+	if pending, queued := pool.Stats(); pending != 3 || queued != 1 {
+		t.Fatalf("pool contents mismatch: have %d/%d, want %d/%d", pending, queued, 3, 1)
+	}
+	pool.Close()
+
+	// Invalidate the transaction of the second account and restart the pool, the
+	// reloaded transactions not counting against the sender quota
+	statedb.SetNonce(crypto.PubkeyToAddress(second.PublicKey), 1)
+	blockchain = newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config.SenderSlots = 1
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), makeAddressReserver())
+	defer pool.Close()
+
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer))
+	if pending, queued := pool.Stats(); pending != 2 || queued != 0 {
+		t.Fatalf("reloaded pool contents mismatch: have %d/%d, want %d/%d", pending, queued, 2, 0)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that transactions older than the age limit are neither journaled nor
+// reloaded.
+func TestRemoteJournalAge(t *testing.T) {
+	t.Parallel()
+
+	var (
+		path    = filepath.Join(t.TempDir(), "remotes.rlp")
+		key, _  = crypto.GenerateKey()
+		journal = newRemoteTxJournal(path, 16, time.Hour)
+		fresh   = transaction(0, 100000, key)
+		stale   = transaction(1, 100000, key)
+	)
+	stale.SetTime(time.Now().Add(-2 * time.Hour))
+
+	if err := journal.rotate([]types.Transactions{{fresh, stale}}); err != nil {
+		t.Fatalf("failed to rotate journal: %v", err)
+	}
+	var loaded types.Transactions
+	if err := journal.load(func(txs []*types.Transaction) []error {
+		loaded = append(loaded, txs...)
+		return make([]error, len(txs))
+	}); err != nil {
+		t.Fatalf("failed to load journal: %v", err)
+	}
+	if len(loaded) != 1 || loaded[0].Hash() != fresh.Hash() {
+		t.Fatalf("loaded transactions mismatch: have %d, want fresh only", len(loaded))
+	}
+	// Transactions turning stale while on disk are dropped on load
+	journal.maxAge = time.Nanosecond
+	loaded = nil
+	if err := journal.load(func(txs []*types.Transaction) []error {
+		loaded = append(loaded, txs...)
+		return make([]error, len(txs))
+	}); err != nil {
+		t.Fatalf("failed to load journal: %v", err)
+	}
+	if len(loaded) != 0 {
+		t.Fatalf("loaded %d stale transactions", len(loaded))
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("journal file missing: %v", err)
+	}
+}
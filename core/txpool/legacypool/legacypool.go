@@ -61,6 +61,10 @@ var (
 	// ErrTxPoolOverflow is returned if the transaction pool is full and can't accept
 	// another remote transaction.
 	ErrTxPoolOverflow = errors.New("txpool is full")
+
+	// ErrSenderQuotaExceeded is returned if the sender of a remote transaction has
+	// already submitted its allowance within the current quota window.
+	ErrSenderQuotaExceeded = errors.New("sender quota exceeded")
 )
 
 var (
@@ -83,6 +87,12 @@ var (
 	queuedNofundsMeter   = metrics.NewRegisteredMeter("txpool/queued/nofunds", nil)   // Dropped due to out-of-funds
 	queuedEvictionMeter  = metrics.NewRegisteredMeter("txpool/queued/eviction", nil)  // Dropped due to lifetime
 
+	// Metrics for the per-sender and per-contract quotas
+	senderQuotaMeter   = metrics.NewRegisteredMeter("txpool/quota/sender", nil)   // Rejected due to the sender quota
+	contractQuotaMeter = metrics.NewRegisteredMeter("txpool/quota/contract", nil) // Evicted due to the contract quota
+
+	privateExpiredMeter = metrics.NewRegisteredMeter("txpool/private/expired", nil) // Private transactions dropped after their expiry
+
 	// General tx metrics
 	knownTxMeter       = metrics.NewRegisteredMeter("txpool/known", nil)
 	validTxMeter       = metrics.NewRegisteredMeter("txpool/valid", nil)
@@ -130,6 +140,10 @@ type Config struct {
 	Journal   string           // Journal of local transactions to survive node restarts
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
 
+	RemoteJournal     string        // Journal of remote transactions to survive node restarts (empty = disabled)
+	RemoteJournalSize uint64        // Maximum number of remote transactions stored in the journal
+	RemoteJournalAge  time.Duration // Maximum age of remote transactions stored in or reloaded from the journal
+
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
 
@@ -140,6 +154,14 @@ type Config struct {
 
 	Lifetime       time.Duration // Maximum amount of time non-executable transaction are queued
 	ReannounceTime time.Duration // Duration for announcing local pending transactions again
+
+	DeployerCheck bool // Whether to reject contract creations the deployer proxy would refuse
+
+	SenderSlots   uint64        // Maximum number of remote transactions accepted per sender within SenderWindow (0 = unlimited)
+	SenderWindow  time.Duration // Time window over which the per-sender quota is enforced
+	ContractSlots uint64        // Maximum number of remote executable transactions per destination contract (0 = unlimited)
+
+	HistorySize uint64 // Number of recently removed transactions to remember the fate of (0 = disabled)
 }
 
 // DefaultConfig contains the default configurations for the transaction pool.
@@ -147,6 +169,11 @@ var DefaultConfig = Config{
 	Journal:   "transactions.rlp",
 	Rejournal: time.Hour,
 
+	RemoteJournalSize: 4096,
+	RemoteJournalAge:  time.Hour,
+
+	HistorySize: 4096,
+
 	PriceLimit: 1,
 	PriceBump:  10,
 
@@ -157,6 +184,8 @@ var DefaultConfig = Config{
 
 	Lifetime:       3 * time.Hour,
 	ReannounceTime: 10 * 365 * 24 * time.Hour,
+
+	SenderWindow: time.Minute,
 }
 
 // sanitize checks the provided user configurations and changes anything that's
@@ -167,6 +196,10 @@ func (config *Config) sanitize() Config {
 		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
 		conf.Rejournal = time.Second
 	}
+	if conf.RemoteJournal != "" && conf.RemoteJournalSize < 1 {
+		log.Warn("Sanitizing invalid txpool remote journal size", "provided", conf.RemoteJournalSize, "updated", DefaultConfig.RemoteJournalSize)
+		conf.RemoteJournalSize = DefaultConfig.RemoteJournalSize
+	}
 	if conf.PriceLimit < 1 {
 		log.Warn("Sanitizing invalid txpool price limit", "provided", conf.PriceLimit, "updated", DefaultConfig.PriceLimit)
 		conf.PriceLimit = DefaultConfig.PriceLimit
@@ -199,6 +232,10 @@ func (config *Config) sanitize() Config {
 		log.Warn("Sanitizing invalid txpool reannounce time", "provided", conf.ReannounceTime, "updated", time.Minute)
 		conf.ReannounceTime = time.Minute
 	}
+	if conf.SenderSlots > 0 && conf.SenderWindow < time.Second {
+		log.Warn("Sanitizing invalid txpool sender window", "provided", conf.SenderWindow, "updated", DefaultConfig.SenderWindow)
+		conf.SenderWindow = DefaultConfig.SenderWindow
+	}
 	return conf
 }
 
@@ -223,10 +260,12 @@ type LegacyPool struct {
 
 	currentHead   atomic.Pointer[types.Header] // Current head of the blockchain
 	currentState  *state.StateDB               // Current state in the blockchain head
+	deployState   *state.StateDB               // Copy of the current state running the deployer checks
 	pendingNonces *noncer                      // Pending state tracking virtual nonces
 
-	locals  *accountSet // Set of local transaction to exempt from eviction rules
-	journal *journal    // Journal of local transaction to back up to disk
+	locals        *accountSet    // Set of local transaction to exempt from eviction rules
+	journal       *journal       // Journal of local transaction to back up to disk
+	remoteJournal *remoteJournal // Journal of remote transactions to back up to disk
 
 	reserve txpool.AddressReserver       // Address reserver to ensure exclusivity across subpools
 	pending map[common.Address]*list     // All currently processable transactions
@@ -235,6 +274,11 @@ type LegacyPool struct {
 	all     *lookup                      // All transactions to allow lookups
 	priced  *pricedList                  // All transactions sorted by price
 
+	senderQuotas map[common.Address]*senderQuota // Admission quota usage of remote senders
+	history      *txHistory                      // Fate of the recently removed transactions
+	private      map[common.Hash]uint64          // Private transactions never to be propagated, with their expiry block
+	privateLock  sync.RWMutex                    // Protects the private set, checked by the network layer without the pool lock
+
 	reqResetCh      chan *txpoolResetRequest
 	reqPromoteCh    chan *accountSet
 	queueTxEventCh  chan *types.Transaction
@@ -266,6 +310,9 @@ func New(config Config, chain BlockChain) *LegacyPool {
 		queue:           make(map[common.Address]*list),
 		beats:           make(map[common.Address]time.Time),
 		all:             newLookup(),
+		senderQuotas:    make(map[common.Address]*senderQuota),
+		history:         newTxHistory(int(config.HistorySize)),
+		private:         make(map[common.Hash]uint64),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
 		queueTxEventCh:  make(chan *types.Transaction),
@@ -283,6 +330,9 @@ func New(config Config, chain BlockChain) *LegacyPool {
 	if !config.NoLocals && config.Journal != "" {
 		pool.journal = newTxJournal(config.Journal)
 	}
+	if config.RemoteJournal != "" {
+		pool.remoteJournal = newRemoteTxJournal(config.RemoteJournal, config.RemoteJournalSize, config.RemoteJournalAge)
+	}
 	return pool
 }
 
@@ -320,6 +370,7 @@ func (pool *LegacyPool) Init(gasTip uint64, head *types.Header, reserve txpool.A
 	}
 	pool.currentHead.Store(head)
 	pool.currentState = statedb
+	pool.deployState = nil
 	pool.pendingNonces = newNoncer(statedb)
 
 	// Start the reorg loop early, so it can handle requests generated during
@@ -329,13 +380,19 @@ func (pool *LegacyPool) Init(gasTip uint64, head *types.Header, reserve txpool.A
 
 	// If local transactions and journaling is enabled, load from disk
 	if pool.journal != nil {
-		if err := pool.journal.load(pool.addLocals); err != nil {
+		if err := pool.journal.load(pool.addJournaled(true)); err != nil {
 			log.Warn("Failed to load transaction journal", "err", err)
 		}
 		if err := pool.journal.rotate(pool.local()); err != nil {
 			log.Warn("Failed to rotate transaction journal", "err", err)
 		}
 	}
+	// If remote journaling is enabled, revalidate the remote transactions from disk
+	if pool.remoteJournal != nil {
+		if err := pool.remoteJournal.load(pool.addJournaled(false)); err != nil {
+			log.Warn("Failed to load remote transaction journal", "err", err)
+		}
+	}
 	pool.wg.Add(1)
 	go pool.loop()
 	return nil
@@ -395,9 +452,11 @@ func (pool *LegacyPool) loop() {
 					for _, tx := range list {
 						pool.removeTx(tx.Hash(), true, true)
 					}
+					pool.recordEvicted(list, evictLifetime)
 					queuedEvictionMeter.Mark(int64(len(list)))
 				}
 			}
+			pool.expireSenderQuotas()
 			pool.mu.Unlock()
 
 		case <-reannounce.C:
@@ -436,6 +495,13 @@ func (pool *LegacyPool) loop() {
 				}
 				pool.mu.Unlock()
 			}
+			if pool.remoteJournal != nil {
+				pool.mu.RLock()
+				if err := pool.remoteJournal.rotate(pool.remote()); err != nil {
+					log.Warn("Failed to rotate remote tx journal", "err", err)
+				}
+				pool.mu.RUnlock()
+			}
 		}
 	}
 }
@@ -449,6 +515,13 @@ func (pool *LegacyPool) Close() error {
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.remoteJournal != nil {
+		pool.mu.RLock()
+		if err := pool.remoteJournal.rotate(pool.remote()); err != nil {
+			log.Warn("Failed to store remote tx journal", "err", err)
+		}
+		pool.mu.RUnlock()
+	}
 	log.Info("Transaction pool stopped")
 	return nil
 }
@@ -495,6 +568,7 @@ func (pool *LegacyPool) SetGasTip(tip *big.Int) {
 			pool.removeTx(tx.Hash(), false, true)
 		}
 		pool.priced.Removed(len(drop))
+		pool.recordEvicted(drop, evictUnderpriced)
 	}
 	log.Info("Legacy pool tip threshold updated", "tip", newTip)
 }
@@ -647,6 +721,30 @@ func (pool *LegacyPool) local() map[common.Address]types.Transactions {
 	return txs
 }
 
+// remote retrieves all currently known remote transactions, grouped by origin
+// account and sorted by nonce. Executable transactions precede the queued ones,
+// and accounts are ordered by the time their first transaction was seen. Private
+// transactions are omitted, along with the higher nonces of their senders.
+func (pool *LegacyPool) remote() []types.Transactions {
+	var pending, queued []types.Transactions
+	for addr, list := range pool.pending {
+		if txs := pool.public(list.Flatten()); !pool.locals.contains(addr) && len(txs) > 0 {
+			pending = append(pending, txs)
+		}
+	}
+	for addr, list := range pool.queue {
+		if txs := pool.public(list.Flatten()); !pool.locals.contains(addr) && len(txs) > 0 {
+			queued = append(queued, txs)
+		}
+	}
+	for _, batches := range [][]types.Transactions{pending, queued} {
+		sort.Slice(batches, func(i, j int) bool {
+			return batches[i][0].Time().Before(batches[j][0].Time())
+		})
+	}
+	return append(pending, queued...)
+}
+
 // validateTxBasics checks whether a transaction is valid according to the consensus
 // rules, but does not check state-dependent validation such as sufficient balance.
 // This check is meant as an early check which only needs to be performed once,
@@ -728,6 +826,9 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction, local bool) error {
 	if err := txpool.ValidateTransactionWithState(tx, pool.signer, opts); err != nil {
 		return err
 	}
+	if err := pool.validateDeployer(tx, sender); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -841,6 +942,7 @@ func (pool *LegacyPool) add(tx *types.Transaction, local bool) (replaced bool, e
 
 			pool.changesSinceReorg += dropped
 		}
+		pool.recordEvicted(drop, evictUnderpriced)
 	}
 
 	// Try to replace an existing transaction in the pending pool
@@ -856,6 +958,7 @@ func (pool *LegacyPool) add(tx *types.Transaction, local bool) (replaced bool, e
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pendingReplaceMeter.Mark(1)
+			pool.recordReplaced(old, hash)
 		}
 		pool.all.Add(tx, isLocal)
 		pool.priced.Put(tx, isLocal)
@@ -931,6 +1034,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, local
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Mark(1)
+		pool.recordReplaced(old, hash)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Inc(1)
@@ -980,6 +1084,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
 		pendingDiscardMeter.Mark(1)
+		pool.recordEvicted([]*types.Transaction{tx}, evictUnderpriced)
 		return false
 	}
 	// Otherwise discard any previous transaction and mark this
@@ -987,6 +1092,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pendingReplaceMeter.Mark(1)
+		pool.recordReplaced(old, hash)
 	} else {
 		// Nothing was replaced, bump the pending counter
 		pendingGauge.Inc(1)
@@ -1039,12 +1145,27 @@ func (pool *LegacyPool) addRemoteSync(tx *types.Transaction) error {
 	return pool.Add([]*types.Transaction{tx}, false, true)[0]
 }
 
+// addJournaled returns the method enqueueing the transactions reloaded from the
+// local or remote journal. They were admitted before the restart, so they don't
+// count against the sender quota again.
+func (pool *LegacyPool) addJournaled(local bool) func([]*types.Transaction) []error {
+	return func(txs []*types.Transaction) []error {
+		return pool.addTxs(txs, local, local, false)
+	}
+}
+
 // Add enqueues a batch of transactions into the pool if they are valid. Depending
 // on the local flag, full pricing constraints will or will not be applied.
 //
 // If sync is set, the method will block until all internal maintenance related
 // to the add is finished. Only use this during tests for determinism!
 func (pool *LegacyPool) Add(txs []*types.Transaction, local, sync bool) []error {
+	return pool.addTxs(txs, local, sync, true)
+}
+
+// addTxs enqueues a batch of transactions into the pool like Add, only enforcing
+// the sender quota on the remote ones if requested.
+func (pool *LegacyPool) addTxs(txs []*types.Transaction, local, sync, quota bool) []error {
 	// Do not treat as local if local transactions have been disabled
 	local = local && !pool.config.NoLocals
 
@@ -1078,7 +1199,7 @@ func (pool *LegacyPool) Add(txs []*types.Transaction, local, sync bool) []error
 
 	// Process all the new transaction and merge any errors into the original slice
 	pool.mu.Lock()
-	newErrs, dirtyAddrs := pool.addTxsLocked(news, local)
+	newErrs, dirtyAddrs := pool.addTxsLocked(news, local, quota)
 	pool.mu.Unlock()
 
 	var nilSlot = 0
@@ -1097,16 +1218,27 @@ func (pool *LegacyPool) Add(txs []*types.Transaction, local, sync bool) []error
 	return errs
 }
 
-// addTxsLocked attempts to queue a batch of transactions if they are valid.
+// addTxsLocked attempts to queue a batch of transactions if they are valid,
+// charging the new nonces of remote senders against their quota if requested.
 // The transaction pool lock must be held.
-func (pool *LegacyPool) addTxsLocked(txs []*types.Transaction, local bool) ([]error, *accountSet) {
+func (pool *LegacyPool) addTxsLocked(txs []*types.Transaction, local bool, quota bool) ([]error, *accountSet) {
 	dirty := newAccountSet(pool.signer)
 	errs := make([]error, len(txs))
 	for i, tx := range txs {
+		limited := quota && !local && !pool.locals.containsTx(tx)
+		if limited && !pool.senderAllowed(tx) {
+			log.Trace("Discarding transaction exceeding sender quota", "hash", tx.Hash())
+			senderQuotaMeter.Mark(1)
+			errs[i] = ErrSenderQuotaExceeded
+			continue
+		}
 		replaced, err := pool.add(tx, local)
 		errs[i] = err
 		if err == nil && !replaced {
 			dirty.addTx(tx)
+			if limited {
+				pool.chargeSender(tx)
+			}
 		}
 	}
 	validTxMeter.Mark(int64(len(dirty.accounts)))
@@ -1367,6 +1499,7 @@ func (pool *LegacyPool) runReorg(done chan struct{}, reset *txpoolResetRequest,
 	// because of another transaction (e.g. higher gas price).
 	if reset != nil {
 		pool.demoteUnexecutables()
+		pool.expirePrivate()
 		if reset.newHead != nil {
 			if pool.chainconfig.IsLondon(new(big.Int).Add(reset.newHead.Number, big.NewInt(1))) {
 				pendingBaseFee := eip1559.CalcBaseFee(pool.chainconfig, reset.newHead)
@@ -1499,12 +1632,13 @@ func (pool *LegacyPool) reset(oldHead, newHead *types.Header) {
 	}
 	pool.currentHead.Store(newHead)
 	pool.currentState = statedb
+	pool.deployState = nil
 	pool.pendingNonces = newNoncer(statedb)
 
 	// Inject any transactions discarded due to reorgs
 	log.Debug("Reinjecting stale transactions", "count", len(reinject))
 	core.SenderCacher.Recover(pool.signer, reinject)
-	pool.addTxsLocked(reinject, false)
+	pool.addTxsLocked(reinject, false, false)
 }
 
 // promoteExecutables moves transactions that have become processable from the
@@ -1528,6 +1662,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 			pool.all.Remove(hash)
 		}
 		log.Trace("Removed old queued transactions", "count", len(forwards))
+		pool.recordEvicted(forwards, evictNonceTooLow)
 		// Drop all transactions that are too costly (low balance or out of gas)
 		drops, _ := list.Filter(pool.currentState.GetBalance(addr), gasLimit)
 		for _, tx := range drops {
@@ -1535,6 +1670,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 			pool.all.Remove(hash)
 		}
 		log.Trace("Removed unpayable queued transactions", "count", len(drops))
+		pool.recordEvicted(drops, evictUnpayable)
 		queuedNofundsMeter.Mark(int64(len(drops)))
 
 		// Gather all executable transactions and promote them
@@ -1558,6 +1694,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 				log.Trace("Removed cap-exceeding queued transaction", "hash", hash)
 			}
 			queuedRateLimitMeter.Mark(int64(len(caps)))
+			pool.recordEvicted(caps, evictAccountQueue)
 		}
 		// Mark all the items dropped as removed
 		pool.priced.Removed(len(forwards) + len(drops) + len(caps))
@@ -1581,6 +1718,9 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 // pending limit. The algorithm tries to reduce transaction counts by an approximately
 // equal number for all for accounts with many pending transactions.
 func (pool *LegacyPool) truncatePending() {
+	// Enforce the per-contract quota before the global limits
+	pool.truncateContractPending()
+
 	pending := uint64(0)
 	for _, list := range pool.pending {
 		pending += uint64(list.Len())
@@ -1626,6 +1766,7 @@ func (pool *LegacyPool) truncatePending() {
 						log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
 					}
 					pool.priced.Removed(len(caps))
+					pool.recordEvicted(caps, evictTruncatePending)
 					pendingGauge.Dec(int64(len(caps)))
 					if pool.locals.contains(offenders[i]) {
 						localGauge.Dec(int64(len(caps)))
@@ -1653,6 +1794,7 @@ func (pool *LegacyPool) truncatePending() {
 					log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
 				}
 				pool.priced.Removed(len(caps))
+				pool.recordEvicted(caps, evictTruncatePending)
 				pendingGauge.Dec(int64(len(caps)))
 				if pool.locals.contains(addr) {
 					localGauge.Dec(int64(len(caps)))
@@ -1692,9 +1834,11 @@ func (pool *LegacyPool) truncateQueue() {
 
 		// Drop all transactions if they are less than the overflow
 		if size := uint64(list.Len()); size <= drop {
-			for _, tx := range list.Flatten() {
+			txs := list.Flatten()
+			for _, tx := range txs {
 				pool.removeTx(tx.Hash(), true, true)
 			}
+			pool.recordEvicted(txs, evictTruncateQueue)
 			drop -= size
 			queuedRateLimitMeter.Mark(int64(size))
 			continue
@@ -1703,6 +1847,7 @@ func (pool *LegacyPool) truncateQueue() {
 		txs := list.Flatten()
 		for i := len(txs) - 1; i >= 0 && drop > 0; i-- {
 			pool.removeTx(txs[i].Hash(), true, true)
+			pool.recordEvicted(txs[i:i+1], evictTruncateQueue)
 			drop--
 			queuedRateLimitMeter.Mark(1)
 		}
@@ -1729,6 +1874,7 @@ func (pool *LegacyPool) demoteUnexecutables() {
 			pool.all.Remove(hash)
 			log.Trace("Removed old pending transaction", "hash", hash)
 		}
+		pool.recordEvicted(olds, evictNonceTooLow)
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
 		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), gasLimit)
 		for _, tx := range drops {
@@ -1736,6 +1882,7 @@ func (pool *LegacyPool) demoteUnexecutables() {
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
 			pool.all.Remove(hash)
 		}
+		pool.recordEvicted(drops, evictUnpayable)
 		pendingNofundsMeter.Mark(int64(len(drops)))
 
 		for _, tx := range invalids {
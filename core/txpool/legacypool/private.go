@@ -0,0 +1,90 @@
+package legacypool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// evictPrivateExpiry is the history rule of private transactions dropped after
+// their expiry block.
+const evictPrivateExpiry = "privateExpiry"
+
+// AddPrivate implements txpool.PrivateTracker, inserting a transaction flagged
+// as private until the given expiry block. The transaction is handled as remote
+// so it is never journaled to disk nor reannounced, the flag only keeps it away
+// from the network layer.
+func (pool *LegacyPool) AddPrivate(tx *types.Transaction, expiry uint64) error {
+	if head := pool.currentHead.Load(); head != nil && head.Number.Uint64() >= expiry {
+		return txpool.ErrPrivateTxExpired
+	}
+	// Flag the transaction before insertion, the pool announces it asynchronously
+	hash := tx.Hash()
+
+	pool.privateLock.Lock()
+	_, known := pool.private[hash]
+	if !known && pool.all.Get(hash) == nil {
+		pool.private[hash] = expiry
+	}
+	pool.privateLock.Unlock()
+
+	if err := pool.Add([]*types.Transaction{tx}, false, false)[0]; err != nil {
+		if !known {
+			pool.privateLock.Lock()
+			delete(pool.private, hash)
+			pool.privateLock.Unlock()
+		}
+		return err
+	}
+	return nil
+}
+
+// IsPrivate implements txpool.PrivateTracker, returning whether a transaction
+// is flagged as private. It only takes the lock of the private set, so that the
+// network layer can check every transaction it propagates without contending
+// with the pool.
+func (pool *LegacyPool) IsPrivate(hash common.Hash) bool {
+	pool.privateLock.RLock()
+	defer pool.privateLock.RUnlock()
+
+	_, ok := pool.private[hash]
+	return ok
+}
+
+// expirePrivate drops the private transactions whose expiry block was passed by
+// the current head, and forgets those which already left the pool.
+func (pool *LegacyPool) expirePrivate() {
+	pool.privateLock.Lock()
+	defer pool.privateLock.Unlock()
+
+	number := pool.currentHead.Load().Number.Uint64()
+	for hash, expiry := range pool.private {
+		tx := pool.all.Get(hash)
+		if tx == nil {
+			delete(pool.private, hash)
+			continue
+		}
+		if number > expiry {
+			log.Trace("Removed expired private transaction", "hash", hash, "expiry", expiry)
+			pool.removeTx(hash, true, true)
+			pool.recordEvicted([]*types.Transaction{tx}, evictPrivateExpiry)
+			delete(pool.private, hash)
+			privateExpiredMeter.Mark(1)
+		}
+	}
+}
+
+// public returns the leading transactions of a nonce-sorted batch up to the
+// first private one, so that the rest can be shared without nonce gaps.
+func (pool *LegacyPool) public(txs types.Transactions) types.Transactions {
+	pool.privateLock.RLock()
+	defer pool.privateLock.RUnlock()
+
+	for i, tx := range txs {
+		if _, ok := pool.private[tx.Hash()]; ok {
+			return txs[:i]
+		}
+	}
+	return txs
+}
@@ -0,0 +1,77 @@
+package legacypool
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that private transactions are flagged until their expiry block, kept out
+// of the remote journal and dropped once the head moves past the expiry.
+func TestPrivateTransactions(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	pool := New(testTxPoolConfig, blockchain)
+	pool.Init(testTxPoolConfig.PriceLimit, blockchain.CurrentBlock(), makeAddressReserver())
+	defer pool.Close()
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+	var (
+		public  = transaction(0, 100000, key)
+		private = transaction(1, 100000, key)
+		expired = transaction(2, 100000, key)
+	)
+	if err := pool.addRemoteSync(public); err != nil {
+		t.Fatalf("failed to add public transaction: %v", err)
+	}
+	if err := pool.AddPrivate(private, 10); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if err := pool.AddPrivate(expired, 0); !errors.Is(err, txpool.ErrPrivateTxExpired) {
+		t.Fatalf("expired private transaction error mismatch: have %v, want %v", err, txpool.ErrPrivateTxExpired)
+	}
+	if pool.IsPrivate(public.Hash()) || !pool.IsPrivate(private.Hash()) {
+		t.Fatalf("private flags mismatch")
+	}
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer, crypto.PubkeyToAddress(key.PublicKey)))
+	if pending, _ := pool.Stats(); pending != 2 {
+		t.Fatalf("pending transactions mismatch: have %d, want %d", pending, 2)
+	}
+	// Private transactions must not be persisted for a restart
+	pool.mu.RLock()
+	remotes := pool.remote()
+	pool.mu.RUnlock()
+	if len(remotes) != 1 || len(remotes[0]) != 1 || remotes[0][0].Hash() != public.Hash() {
+		t.Fatalf("remote transactions leaked private ones: %v", remotes)
+	}
+	// Move the head past the expiry and ensure the transaction is dropped
+	pool.mu.Lock()
+	head := types.CopyHeader(pool.currentHead.Load())
+	head.Number = big.NewInt(11)
+	pool.currentHead.Store(head)
+	pool.expirePrivate()
+	pool.mu.Unlock()
+
+	if pool.Has(private.Hash()) || pool.IsPrivate(private.Hash()) {
+		t.Fatalf("expired private transaction still pooled")
+	}
+	if lifecycle := pool.Lifecycle(private.Hash()); lifecycle == nil || lifecycle.Reason != evictPrivateExpiry {
+		t.Fatalf("expired private transaction lifecycle mismatch: %v", lifecycle)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package legacypool
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/prque"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// senderQuota tracks the number of transactions admitted from a single sender
+// within the current quota window.
+type senderQuota struct {
+	start time.Time // Beginning of the current window
+	count uint64    // Transactions admitted within the window
+}
+
+// senderAllowed reports whether the sender of the given transaction is still
+// within its allowance of new transactions in the current quota window. The
+// replacements of pooled transactions are always allowed, and the quota is not
+// enforced if disabled in the config.
+func (pool *LegacyPool) senderAllowed(tx *types.Transaction) bool {
+	if pool.config.SenderSlots == 0 {
+		return true
+	}
+	from, err := types.Sender(pool.signer, tx)
+	if err != nil {
+		return true // Rejected by the validation anyway
+	}
+	quota := pool.senderQuotas[from]
+	if quota == nil || time.Since(quota.start) >= pool.config.SenderWindow || quota.count < pool.config.SenderSlots {
+		return true
+	}
+	if list := pool.pending[from]; list != nil && list.Contains(tx.Nonce()) {
+		return true
+	}
+	if list := pool.queue[from]; list != nil && list.Contains(tx.Nonce()) {
+		return true
+	}
+	return false
+}
+
+// chargeSender charges a transaction inserted with a new nonce against the quota
+// of its sender, starting a new window if the previous one passed.
+func (pool *LegacyPool) chargeSender(tx *types.Transaction) {
+	if pool.config.SenderSlots == 0 {
+		return
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	now := time.Now()
+	quota := pool.senderQuotas[from]
+	if quota == nil || now.Sub(quota.start) >= pool.config.SenderWindow {
+		quota = &senderQuota{start: now}
+		pool.senderQuotas[from] = quota
+	}
+	quota.count++
+}
+
+// expireSenderQuotas drops the sender quotas whose window has already passed.
+func (pool *LegacyPool) expireSenderQuotas() {
+	for addr, quota := range pool.senderQuotas {
+		if time.Since(quota.start) >= pool.config.SenderWindow {
+			delete(pool.senderQuotas, addr)
+		}
+	}
+}
+
+// truncateContractPending evicts remote executable transactions destined to a
+// contract that is above the per-contract pending quota. The senders with the
+// most transactions to the contract are penalized first, always dropping their
+// highest nonces so that their remaining transactions stay executable.
+func (pool *LegacyPool) truncateContractPending() {
+	if pool.config.ContractSlots == 0 {
+		return
+	}
+	counts := make(map[common.Address]uint64)
+	for addr, list := range pool.pending {
+		if pool.locals.contains(addr) {
+			continue
+		}
+		for _, tx := range list.Flatten() {
+			if to := tx.To(); to != nil {
+				counts[*to]++
+			}
+		}
+	}
+	for to, count := range counts {
+		if count <= pool.config.ContractSlots || pool.currentState.GetCodeSize(to) == 0 {
+			continue
+		}
+		// Regroup the transactions, earlier evictions might have demoted some
+		destined := make(map[common.Address][]*types.Transaction)
+		count = 0
+		for addr, list := range pool.pending {
+			if pool.locals.contains(addr) {
+				continue
+			}
+			for _, tx := range list.Flatten() {
+				if tx.To() != nil && *tx.To() == to {
+					destined[addr] = append(destined[addr], tx)
+					count++
+				}
+			}
+		}
+		offenders := prque.New[int64, common.Address](nil)
+		for addr, txs := range destined {
+			offenders.Push(addr, int64(len(txs)))
+		}
+		var evicted int64
+		for count > pool.config.ContractSlots && !offenders.Empty() {
+			addr, _ := offenders.Pop()
+			txs := destined[addr]
+
+			// Evict the pending transactions of the sender from the highest nonce
+			// down to its last one to the contract, never leaving a nonce gap
+			last := txs[len(txs)-1]
+			flat := pool.pending[addr].Flatten()
+			for i := len(flat) - 1; i >= 0 && flat[i].Nonce() >= last.Nonce(); i-- {
+				log.Trace("Removed contract quota exceeding pending transaction", "hash", flat[i].Hash(), "to", to)
+				pool.removeTx(flat[i].Hash(), true, true)
+				pool.recordEvicted([]*types.Transaction{flat[i]}, evictContractQuota)
+				evicted++
+			}
+			count--
+
+			if txs = txs[:len(txs)-1]; len(txs) > 0 {
+				destined[addr] = txs
+				offenders.Push(addr, int64(len(txs)))
+			}
+		}
+		contractQuotaMeter.Mark(evicted)
+	}
+}
@@ -0,0 +1,133 @@
+package legacypool
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that remote senders are refused once they exhaust their quota within the
+// window, while local transactions are exempt.
+func TestSenderQuota(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.SenderSlots = 2
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), makeAddressReserver())
+	defer pool.Close()
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+	// Rejected transactions don't count against the quota
+	if err := pool.addRemoteSync(transaction(0, 100000000, key)); err == nil {
+		t.Fatalf("unaffordable transaction accepted")
+	}
+	for i := uint64(0); i < config.SenderSlots; i++ {
+		if err := pool.addRemoteSync(transaction(i, 100000, key)); err != nil {
+			t.Fatalf("failed to add transaction %d within quota: %v", i, err)
+		}
+	}
+	if err := pool.addRemoteSync(transaction(config.SenderSlots, 100000, key)); !errors.Is(err, ErrSenderQuotaExceeded) {
+		t.Fatalf("quota error mismatch: have %v, want %v", err, ErrSenderQuotaExceeded)
+	}
+	// Replacements are neither refused nor charged
+	if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(2), key)); err != nil {
+		t.Fatalf("failed to replace transaction above quota: %v", err)
+	}
+	if err := pool.addRemoteSync(pricedTransaction(1, 100000, big.NewInt(2), key)); err != nil {
+		t.Fatalf("failed to replace transaction above quota: %v", err)
+	}
+	pool.mu.RLock()
+	count := pool.senderQuotas[crypto.PubkeyToAddress(key.PublicKey)].count
+	pool.mu.RUnlock()
+	if count != config.SenderSlots {
+		t.Fatalf("quota usage mismatch: have %d, want %d", count, config.SenderSlots)
+	}
+	if err := pool.addLocal(transaction(config.SenderSlots, 100000, key)); err != nil {
+		t.Fatalf("failed to add local transaction above quota: %v", err)
+	}
+	// Once the window passes the sender is admitted again
+	pool.mu.Lock()
+	pool.senderQuotas[crypto.PubkeyToAddress(key.PublicKey)].start = time.Now().Add(-config.SenderWindow)
+	pool.mu.Unlock()
+
+	if err := pool.addRemoteSync(transaction(config.SenderSlots+1, 100000, key)); err != nil {
+		t.Fatalf("failed to add transaction after quota window: %v", err)
+	}
+}
+
+// Tests that executable transactions to a contract above the per-contract quota
+// are evicted, starting from the sender with the most transactions to it.
+func TestContractQuota(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.ContractSlots = 4
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), makeAddressReserver())
+	defer pool.Close()
+
+	// All test transactions are sent to the zero address, make it a contract
+	pool.mu.Lock()
+	pool.currentState.SetCode(common.Address{}, []byte{0x00})
+	pool.mu.Unlock()
+
+	keys := make([]*ecdsa.PrivateKey, 3)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		testAddBalance(pool, crypto.PubkeyToAddress(keys[i].PublicKey), big.NewInt(1000000000))
+	}
+	// The first sender floods the contract, the others send a single transaction
+	var txs types.Transactions
+	for i := uint64(0); i < 5; i++ {
+		txs = append(txs, transaction(i, 100000, keys[0]))
+	}
+	// The flooding sender also has a transaction to a plain account on top
+	plain, _ := types.SignTx(types.NewTransaction(5, common.Address{0x01}, big.NewInt(100), 100000, big.NewInt(1), nil), types.HomesteadSigner{}, keys[0])
+	txs = append(txs, plain)
+	txs = append(txs, transaction(0, 100000, keys[1]), transaction(0, 100000, keys[2]))
+	pool.addRemotesSync(txs)
+
+	// The evictions start from the highest nonce of the sender, leaving no gaps
+	pending, queued := pool.Stats()
+	if pending != int(config.ContractSlots) {
+		t.Fatalf("pending transactions mismatch: have %d, want %d", pending, config.ContractSlots)
+	}
+	if queued != 0 {
+		t.Fatalf("queued transactions mismatch: have %d, want %d", queued, 0)
+	}
+	if have := pool.pending[crypto.PubkeyToAddress(keys[0].PublicKey)].Len(); have != 2 {
+		t.Fatalf("flooding sender pending mismatch: have %d, want %d", have, 2)
+	}
+	if pool.all.Get(plain.Hash()) != nil {
+		t.Fatalf("transaction above the evicted nonces kept")
+	}
+	for _, key := range keys[1:] {
+		if pool.pending[crypto.PubkeyToAddress(key.PublicKey)] == nil {
+			t.Fatalf("single transaction sender evicted")
+		}
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
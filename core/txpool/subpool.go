@@ -170,3 +170,45 @@ type SubPool interface {
 	// SetMaxGas limit max acceptable tx gas when mine is enabled
 	SetMaxGas(maxGas uint64)
 }
+
+// Lifecycle states of a transaction reported by TxLifecycle.
+const (
+	TxLifecyclePending  = "pending"  // Executable, waiting for inclusion
+	TxLifecycleQueued   = "queued"   // Not executable yet, see the reason
+	TxLifecycleReplaced = "replaced" // Replaced by a transaction with the same nonce
+	TxLifecycleEvicted  = "evicted"  // Dropped from the pool, see the rule
+	TxLifecycleIncluded = "included" // Included in the canonical chain
+)
+
+// TxLifecycle describes what happened to a transaction handled by the pool.
+type TxLifecycle struct {
+	Status     string       `json:"status"`
+	Reason     string       `json:"reason,omitempty"`     // Why the transaction is queued, or the rule that evicted it
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"` // Transaction replacing this one
+	Time       time.Time    `json:"time"`                 // When the transaction entered its current state
+}
+
+// DefaultPrivateTxExpiry is the number of blocks a private transaction stays in
+// the pool when submitted without an explicit expiry block, and the longest one
+// accepted from the trusted peers relaying private transactions.
+const DefaultPrivateTxExpiry = 100
+
+// PrivateTracker is implemented by subpools able to hold private transactions,
+// which must never be propagated to the network and are dropped once the chain
+// moves past their expiry block.
+type PrivateTracker interface {
+	// AddPrivate inserts a transaction into the pool flagged as private until the
+	// given expiry block (inclusive).
+	AddPrivate(tx *types.Transaction, expiry uint64) error
+
+	// IsPrivate returns whether a pooled transaction is flagged as private.
+	IsPrivate(hash common.Hash) bool
+}
+
+// LifecycleTracker is implemented by subpools remembering the fate of the
+// transactions they recently handled.
+type LifecycleTracker interface {
+	// Lifecycle returns the state of a live transaction, or the recorded fate of
+	// a recently removed one. Nil is returned for unknown transactions.
+	Lifecycle(hash common.Hash) *TxLifecycle
+}
@@ -486,6 +486,44 @@ func (p *TxPool) Status(hash common.Hash) TxStatus {
 	return TxStatusUnknown
 }
 
+// AddPrivate inserts a transaction into the subpool accepting it, flagged as
+// private until the given expiry block. Private transactions are included in
+// locally produced blocks, but never announced or sent to the network.
+func (p *TxPool) AddPrivate(tx *types.Transaction, expiry uint64) error {
+	for _, subpool := range p.subpools {
+		if subpool.Filter(tx) {
+			if tracker, ok := subpool.(PrivateTracker); ok {
+				return tracker.AddPrivate(tx, expiry)
+			}
+			break
+		}
+	}
+	return core.ErrTxTypeNotSupported
+}
+
+// IsPrivate returns whether a pooled transaction is flagged as private.
+func (p *TxPool) IsPrivate(hash common.Hash) bool {
+	for _, subpool := range p.subpools {
+		if tracker, ok := subpool.(PrivateTracker); ok && tracker.IsPrivate(hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lifecycle returns the state of a transaction as known by the subpools that
+// track the fate of their transactions, or nil if none of them knows it.
+func (p *TxPool) Lifecycle(hash common.Hash) *TxLifecycle {
+	for _, subpool := range p.subpools {
+		if tracker, ok := subpool.(LifecycleTracker); ok {
+			if lifecycle := tracker.Lifecycle(hash); lifecycle != nil {
+				return lifecycle
+			}
+		}
+	}
+	return nil
+}
+
 // Sync is a helper method for unit tests or simulator runs where the chain events
 // are arriving in quick succession, without any time in between them to run the
 // internal background reset operations. This method will run an explicit reset
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccountChange is the balance and nonce of an account after a block changed
+// either of them.
+type AccountChange struct {
+	Address common.Address
+	Balance *big.Int
+	Nonce   uint64
+}
@@ -197,3 +197,50 @@ type MevParams struct {
 	BuilderFeeCeil        *big.Int
 	Version               string
 }
+
+// Statuses of a received bid, as recorded in the bid history.
+const (
+	BidStatusPending   = "pending"   // Queued for simulation
+	BidStatusDiscarded = "discarded" // Not expected to be better than the current best bid
+	BidStatusRejected  = "rejected"  // Invalid, or failed the simulation
+	BidStatusBest      = "best"      // Simulated as the best bid of its block so far
+	BidStatusOutbid    = "outbid"    // Simulated, but beaten by another bid
+	BidStatusWon       = "won"       // Sealed instead of the local block
+	BidStatusLost      = "lost"      // Best bid, but the local block paid more
+)
+
+// BidRecord is the outcome of a bid received by the validator.
+type BidRecord struct {
+	Hash        common.Hash    `json:"hash"`
+	Builder     common.Address `json:"builder"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	ParentHash  common.Hash    `json:"parentHash"`
+	Received    time.Time      `json:"received"`
+	Txs         int            `json:"txs"`
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	GasFee      *hexutil.Big   `json:"gasFee"`
+	BuilderFee  *hexutil.Big   `json:"builderFee"`
+
+	Status        string `json:"status"`
+	Reason        string `json:"reason,omitempty"`        // Why the bid was discarded or rejected
+	IssueReported bool   `json:"issueReported,omitempty"` // Whether the rejection reached the builder
+
+	SimulationTime        time.Duration `json:"simulationTime,omitempty"`
+	PackedBlockReward     *hexutil.Big  `json:"packedBlockReward,omitempty"`     // Block reward measured by the simulation
+	PackedValidatorReward *hexutil.Big  `json:"packedValidatorReward,omitempty"` // Validator reward measured by the simulation
+	LocalBlockReward      *hexutil.Big  `json:"localBlockReward,omitempty"`      // Reward of the local block it was compared with
+}
+
+// BuilderStats aggregates the outcome of the bids received from a builder.
+type BuilderStats struct {
+	Builder   common.Address `json:"builder"`
+	Received  uint64         `json:"received"`
+	Discarded uint64         `json:"discarded"`
+	Rejected  uint64         `json:"rejected"`
+	Best      uint64         `json:"best"`
+	Won       uint64         `json:"won"`
+	Lost      uint64         `json:"lost"`
+
+	WonBlockReward     *hexutil.Big `json:"wonBlockReward"`     // Total block reward of the won bids
+	WonValidatorReward *hexutil.Big `json:"wonValidatorReward"` // Total validator reward of the won bids
+}
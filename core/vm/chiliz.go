@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/systemcontract"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 )
 
@@ -28,12 +29,7 @@ func applyChilizDeploymentEvmHook(evm *EVM, caller ContractRef, addr common.Addr
 	if systemcontract.IsSystemContract(addr) {
 		return gas, nil
 	}
-	var input []byte
-	if evm.chainRules.HasDeployOrigin && !evm.chainRules.DeployerFactory {
-		input, err = systemcontract.EvmHooksAbi.Pack("registerDeployedContract", evm.TxContext.Origin, addr)
-	} else {
-		input, err = systemcontract.EvmHooksAbi.Pack("registerDeployedContract", caller.Address(), addr)
-	}
+	input, err := DeploymentRegistration(evm.chainRules, evm.TxContext.Origin, caller.Address(), addr)
 	if err != nil {
 		return gas, ErrNotAllowed
 	}
@@ -43,3 +39,14 @@ func applyChilizDeploymentEvmHook(evm *EVM, caller ContractRef, addr common.Addr
 	}
 	return gas, nil
 }
+
+// DeploymentRegistration returns the deployer proxy call registering a contract
+// created by caller within a transaction sent by origin. Between the deploy
+// origin and the deployer factory forks, the origin is registered as deployer.
+func DeploymentRegistration(rules params.Rules, origin, caller, addr common.Address) ([]byte, error) {
+	deployer := caller
+	if rules.HasDeployOrigin && !rules.DeployerFactory {
+		deployer = origin
+	}
+	return systemcontract.EvmHooksAbi.Pack("registerDeployedContract", deployer, addr)
+}
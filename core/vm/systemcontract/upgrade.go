@@ -6,6 +6,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// evmHookRuntimeUpgrade replaces the code of a system contract on behalf of the
+// RuntimeUpgrade contract. Governance approved upgrades are executed through it
+// within the governance transaction, followed by their apply function, so every
+// validator applies them identically and the state root of the block covers them.
+// No hard fork via core/systemcontracts and no extra step in Finalize is needed.
 type evmHookRuntimeUpgrade struct {
 	context EvmHookContext
 }
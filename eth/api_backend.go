@@ -300,11 +300,22 @@ func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction)
 	return b.eth.txPool.Add([]*types.Transaction{signedTx}, true, false)[0]
 }
 
+func (b *EthAPIBackend) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, expiry uint64) error {
+	if err := b.eth.txPool.AddPrivate(signedTx, expiry); err != nil {
+		return err
+	}
+	b.eth.handler.RelayPrivateTransactions(expiry, types.Transactions{signedTx})
+	return nil
+}
+
 func (b *EthAPIBackend) GetPoolTransactions() (types.Transactions, error) {
 	pending := b.eth.txPool.Pending(txpool.PendingFilter{})
 	var txs types.Transactions
 	for _, batch := range pending {
 		for _, lazy := range batch {
+			if b.eth.txPool.IsPrivate(lazy.Hash) {
+				continue
+			}
 			if tx := lazy.Resolve(); tx != nil {
 				txs = append(txs, tx)
 			}
@@ -347,19 +358,72 @@ func (b *EthAPIBackend) Stats() (runnable int, blocked int) {
 }
 
 func (b *EthAPIBackend) TxPoolContent() (map[common.Address][]*types.Transaction, map[common.Address][]*types.Transaction) {
-	return b.eth.txPool.Content()
+	pending, queued := b.eth.txPool.Content()
+	for _, content := range []map[common.Address][]*types.Transaction{pending, queued} {
+		for addr, txs := range content {
+			if txs = b.publicTxs(txs); len(txs) > 0 {
+				content[addr] = txs
+			} else {
+				delete(content, addr)
+			}
+		}
+	}
+	return pending, queued
 }
 
 func (b *EthAPIBackend) TxPoolContentFrom(addr common.Address) ([]*types.Transaction, []*types.Transaction) {
-	return b.eth.txPool.ContentFrom(addr)
+	pending, queued := b.eth.txPool.ContentFrom(addr)
+	return b.publicTxs(pending), b.publicTxs(queued)
+}
+
+// publicTxs filters the private transactions out of a batch of pooled ones, so
+// that they aren't disclosed over the public pool APIs.
+func (b *EthAPIBackend) publicTxs(txs []*types.Transaction) []*types.Transaction {
+	public := txs[:0:0]
+	for _, tx := range txs {
+		if !b.eth.txPool.IsPrivate(tx.Hash()) {
+			public = append(public, tx)
+		}
+	}
+	return public
+}
+
+func (b *EthAPIBackend) TxPoolLifecycle(hash common.Hash) *txpool.TxLifecycle {
+	return b.eth.txPool.Lifecycle(hash)
 }
 
 func (b *EthAPIBackend) TxPool() *txpool.TxPool {
 	return b.eth.txPool
 }
 
+// SubscribeNewTxsEvent subscribes to the transactions entering the pool, leaving
+// out the private ones.
 func (b *EthAPIBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
-	return b.eth.txPool.SubscribeTransactions(ch, true)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		var (
+			txsCh = make(chan core.NewTxsEvent, cap(ch))
+			sub   = b.eth.txPool.SubscribeTransactions(txsCh, true)
+		)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-txsCh:
+				if ev.Txs = b.publicTxs(ev.Txs); len(ev.Txs) == 0 {
+					continue
+				}
+				select {
+				case ch <- ev:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
 }
 
 func (b *EthAPIBackend) SubscribeNewVoteEvent(ch chan<- core.NewVoteEvent) event.Subscription {
@@ -431,6 +495,14 @@ func (b *EthAPIBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, sections
 }
 
+func (b *EthAPIBackend) LogIndexStatus() (uint64, uint64) {
+	if b.eth.logIndexer == nil {
+		return params.LogIndexBlocks, 0
+	}
+	sections, _, _ := b.eth.logIndexer.Sections()
+	return params.LogIndexBlocks, sections
+}
+
 func (b *EthAPIBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
 	for i := 0; i < bloomFilterThreads; i++ {
 		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, b.eth.bloomRequests)
@@ -507,6 +579,14 @@ func (b *EthAPIBackend) BestBidGasFee(parentHash common.Hash) *big.Int {
 	return b.Miner().BestPackedBlockReward(parentHash)
 }
 
+func (b *EthAPIBackend) BidHistory(builder *common.Address, number *uint64) []*types.BidRecord {
+	return b.Miner().BidHistory(builder, number)
+}
+
+func (b *EthAPIBackend) BuilderStats() []*types.BuilderStats {
+	return b.Miner().BuilderStats()
+}
+
 func (b *EthAPIBackend) MinerInTurn() bool {
 	return b.Miner().InTurn()
 }
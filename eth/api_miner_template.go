@@ -0,0 +1,101 @@
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/parlia"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/miner"
+)
+
+// BlockTemplateResult is the block the local worker would seal right now.
+type BlockTemplateResult struct {
+	Header             map[string]interface{} `json:"header"`
+	Transactions       []*TemplateTxResult    `json:"transactions"`
+	SystemTransactions []*TemplateTxResult    `json:"systemTransactions"`
+	SystemTxsError     string                 `json:"systemTransactionsError,omitempty"` // Why system transactions could not be projected
+	Excluded           []*ExcludedTxResult    `json:"excluded"`
+	ValidatorReward    *hexutil.Big           `json:"validatorReward"`   // Fees collected for the validator
+	Rewards            *parlia.Rewards        `json:"rewards,omitempty"` // Rewards paid out by the system transactions
+}
+
+// TemplateTxResult is a transaction of a block template.
+type TemplateTxResult struct {
+	*ethapi.RPCTransaction
+	Status       hexutil.Uint64 `json:"status"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Fee          *hexutil.Big   `json:"fee"`
+	Error        string         `json:"error,omitempty"`
+	HookRejected bool           `json:"hookRejected,omitempty"` // Whether the Chiliz EVM hooks refused the execution
+}
+
+// ExcludedTxResult is a pending transaction left out of a block template.
+type ExcludedTxResult struct {
+	Hash   common.Hash    `json:"hash"`
+	From   common.Address `json:"from"`
+	Nonce  hexutil.Uint64 `json:"nonce"`
+	Reason string         `json:"reason"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// GetPendingBlockTemplate returns the block the local worker would seal right
+// now: the ordered pool transactions with their fees, the pending transactions
+// left out with the reason, the system transactions projected by the consensus
+// engine and the expected validator reward.
+func (api *MinerAPI) GetPendingBlockTemplate() (*BlockTemplateResult, error) {
+	template, err := api.e.Miner().PendingBlockTemplate()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		config = api.e.BlockChain().Config()
+		signer = types.MakeSigner(config, template.Header.Number, template.Header.Time)
+		parent = api.e.BlockChain().GetHeaderByHash(template.Header.ParentHash)
+	)
+	result := &BlockTemplateResult{
+		Header:             ethapi.RPCMarshalHeader(template.Header),
+		Transactions:       make([]*TemplateTxResult, 0, len(template.Transactions)),
+		SystemTransactions: make([]*TemplateTxResult, 0, len(template.SystemTxs)),
+		Excluded:           make([]*ExcludedTxResult, 0, len(template.Excluded)),
+		ValidatorReward:    (*hexutil.Big)(template.Reward),
+		Rewards:            template.Rewards,
+	}
+	if template.SystemTxsErr != nil {
+		result.SystemTxsError = template.SystemTxsErr.Error()
+	}
+	marshal := func(tx *miner.TemplateTx) *TemplateTxResult {
+		res := &TemplateTxResult{
+			RPCTransaction: ethapi.NewRPCPendingTransaction(tx.Tx, parent, config),
+			Fee:            (*hexutil.Big)(tx.Fee),
+			HookRejected:   tx.HookRejected,
+		}
+		if tx.Receipt != nil {
+			res.Status, res.GasUsed = hexutil.Uint64(tx.Receipt.Status), hexutil.Uint64(tx.Receipt.GasUsed)
+		}
+		if tx.Err != nil {
+			res.Error = tx.Err.Error()
+		}
+		return res
+	}
+	for _, tx := range template.Transactions {
+		result.Transactions = append(result.Transactions, marshal(tx))
+	}
+	for _, tx := range template.SystemTxs {
+		result.SystemTransactions = append(result.SystemTransactions, marshal(tx))
+	}
+	for _, ex := range template.Excluded {
+		from, _ := types.Sender(signer, ex.Tx)
+		res := &ExcludedTxResult{
+			Hash:   ex.Tx.Hash(),
+			From:   from,
+			Nonce:  hexutil.Uint64(ex.Tx.Nonce()),
+			Reason: ex.Reason,
+		}
+		if ex.Err != nil {
+			res.Error = ex.Err.Error()
+		}
+		result.Excluded = append(result.Excluded, res)
+	}
+	return result, nil
+}
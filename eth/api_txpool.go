@@ -0,0 +1,136 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // Registers the prestate tracer
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// maxSimulatedTxs is the maximum number of transactions simulated in one call.
+const maxSimulatedTxs = 100
+
+// TxPoolAPI offers transaction pool features requiring access to the miner,
+// complementing the public txpool namespace.
+type TxPoolAPI struct {
+	e *Ethereum
+}
+
+// NewTxPoolAPI creates a new TxPoolAPI instance.
+func NewTxPoolAPI(e *Ethereum) *TxPoolAPI {
+	return &TxPoolAPI{e}
+}
+
+// SimulateTxArgs identifies a transaction of a simulated sequence, either by the
+// hash of a pooled transaction or by its signed binary encoding.
+type SimulateTxArgs struct {
+	Hash *common.Hash  `json:"hash"`
+	Raw  hexutil.Bytes `json:"raw"`
+}
+
+// SimulatedTxResult is the outcome of a transaction of a simulated sequence.
+type SimulatedTxResult struct {
+	Hash         common.Hash     `json:"hash"`
+	Included     bool            `json:"included"`         // Whether the miner would include the transaction
+	Status       *hexutil.Uint64 `json:"status,omitempty"` // Receipt status if included
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Logs         []*types.Log    `json:"logs"`
+	StateDiff    json.RawMessage `json:"stateDiff,omitempty"` // Pre and post state of the touched accounts
+	Error        string          `json:"error,omitempty"`     // Reason of a failed execution or exclusion
+	RevertReason string          `json:"revertReason,omitempty"`
+}
+
+// Simulate executes an ordered sequence of pooled or raw transactions on top of
+// the pending block, with the same rules the miner applies when including pool
+// transactions, and reports the outcome of each without including any of them.
+// If the pending block already includes some of the transactions, the sequence
+// is executed on top of its parent instead. Private pooled transactions can't be
+// referenced by hash.
+func (api *TxPoolAPI) Simulate(ctx context.Context, args []SimulateTxArgs) ([]*SimulatedTxResult, error) {
+	if len(args) == 0 {
+		return nil, errors.New("empty transaction sequence")
+	}
+	if len(args) > maxSimulatedTxs {
+		return nil, fmt.Errorf("too many transactions: have %d, max %d", len(args), maxSimulatedTxs)
+	}
+	txs := make([]*types.Transaction, len(args))
+	for i, arg := range args {
+		switch {
+		case arg.Hash != nil && len(arg.Raw) > 0:
+			return nil, fmt.Errorf("transaction %d: both hash and raw specified", i)
+
+		case arg.Hash != nil:
+			// Private transactions are refused as if unknown, not to reveal them
+			if txs[i] = api.e.txPool.Get(*arg.Hash); txs[i] == nil || api.e.txPool.IsPrivate(*arg.Hash) {
+				return nil, fmt.Errorf("transaction %d: %x not found in pool", i, *arg.Hash)
+			}
+
+		case len(arg.Raw) > 0:
+			tx := new(types.Transaction)
+			if err := tx.UnmarshalBinary(arg.Raw); err != nil {
+				return nil, fmt.Errorf("transaction %d: %w", i, err)
+			}
+			txs[i] = tx
+
+		default:
+			return nil, fmt.Errorf("transaction %d: missing hash or raw", i)
+		}
+	}
+	// Trace the state modifications of every transaction
+	tracerConfig := json.RawMessage(`{"diffMode":true}`)
+	// Traces are indexed by position, as a sequence may repeat a transaction
+	traces := make([]tracers.Tracer, len(txs))
+	newTracer := func(i int, tx *types.Transaction) vm.EVMLogger {
+		tracer, err := tracers.DefaultDirectory.New("prestateTracer", &tracers.Context{TxHash: tx.Hash()}, tracerConfig)
+		if err != nil {
+			log.Warn("Failed to create simulation tracer", "err", err)
+			return nil
+		}
+		traces[i] = tracer
+		return tracer
+	}
+	simulated, err := api.e.Miner().Simulate(txs, newTracer)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*SimulatedTxResult, len(simulated))
+	for i, sim := range simulated {
+		res := &SimulatedTxResult{
+			Hash: sim.Tx.Hash(),
+			Logs: []*types.Log{},
+		}
+		if sim.Err != nil {
+			res.Error = sim.Err.Error()
+			results[i] = res
+			continue
+		}
+		status := hexutil.Uint64(sim.Receipt.Status)
+		res.Included, res.Status = true, &status
+		res.GasUsed = hexutil.Uint64(sim.Receipt.GasUsed)
+		if sim.Receipt.Logs != nil {
+			res.Logs = sim.Receipt.Logs
+		}
+		if tracer := traces[i]; tracer != nil {
+			if diff, err := tracer.GetResult(); err == nil {
+				res.StateDiff = diff
+			}
+		}
+		if sim.Result.Err != nil {
+			res.Error = sim.Result.Err.Error()
+			if reason, err := abi.UnpackRevert(sim.Result.Revert()); err == nil {
+				res.RevertReason = reason
+			}
+		}
+		results[i] = res
+	}
+	return results, nil
+}
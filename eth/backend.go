@@ -101,6 +101,7 @@ type Ethereum struct {
 	bloomRequests     chan chan *bloombits.Retrieval // Channel receiving bloom data retrieval requests
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
 	closeBloomHandler chan struct{}
+	logIndexer        *core.ChainIndexer // Log indexer operating during block imports, nil if disabled
 
 	APIBackend *EthAPIBackend
 
@@ -297,6 +298,12 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if config.PersistDiff {
 		bcOps = append(bcOps, core.EnablePersistDiff(config.DiffBlock))
 	}
+	if config.AccountChangeIndex {
+		bcOps = append(bcOps, core.EnableAccountChangeIndex(config.AccountChangeHistory))
+	}
+	if config.AddressTxIndex {
+		bcOps = append(bcOps, core.EnableAddressIndex())
+	}
 	if stack.Config().EnableDoubleSignMonitor {
 		bcOps = append(bcOps, core.EnableDoubleSignChecker)
 	}
@@ -308,6 +315,10 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		return nil, err
 	}
 	eth.bloomIndexer.Start(eth.blockchain)
+	if config.LogIndex {
+		eth.logIndexer = core.NewLogIndexer(chainDb, params.LogIndexBlocks, params.BloomConfirms)
+		eth.logIndexer.Start(eth.blockchain)
+	}
 
 	if config.BlobPool.Datadir != "" {
 		config.BlobPool.Datadir = stack.ResolvePath(config.BlobPool.Datadir)
@@ -317,6 +328,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.RemoteJournal != "" {
+		config.TxPool.RemoteJournal = stack.ResolvePath(config.TxPool.RemoteJournal)
+	}
 	legacyPool := legacypool.New(config.TxPool, eth.blockchain)
 
 	eth.txPool, err = txpool.New(config.TxPool.PriceLimit, eth.blockchain, []txpool.SubPool{legacyPool, blobPool})
@@ -337,6 +351,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		RequiredBlocks:         config.RequiredBlocks,
 		DirectBroadcast:        config.DirectBroadcast,
 		DisablePeerTxBroadcast: config.DisablePeerTxBroadcast,
+		PrivateTxPeers:         config.PrivateTxPeers,
 		PeerSet:                peers,
 	}); err != nil {
 		return nil, err
@@ -450,6 +465,9 @@ func (s *Ethereum) APIs() []rpc.API {
 		}, {
 			Namespace: "miner",
 			Service:   NewMinerAPI(s),
+		}, {
+			Namespace: "txpool",
+			Service:   NewTxPoolAPI(s),
 		}, {
 			Namespace: "eth",
 			Service:   downloader.NewDownloaderAPI(s.handler.downloader, s.blockchain, s.eventMux),
@@ -695,6 +713,9 @@ func (s *Ethereum) Stop() error {
 	// Then stop everything else.
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
+	if s.logIndexer != nil {
+		s.logIndexer.Close()
+	}
 	s.txPool.Close()
 	s.miner.Close()
 	s.blockchain.Stop()
@@ -35,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -110,6 +111,10 @@ type Config struct {
 	PipeCommit          bool
 	RangeLimit          bool
 
+	// PrivateTxPeers are the node ids of the trusted validator peers private
+	// transactions are relayed to and accepted from over the trust protocol.
+	PrivateTxPeers []enode.ID `toml:",omitempty"`
+
 	// Deprecated, use 'TransactionHistory' instead.
 	TxLookupLimit      uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
 	TransactionHistory uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
@@ -121,6 +126,11 @@ type Config struct {
 	PathSyncFlush      bool   `toml:",omitempty"` // State scheme used to store ethereum state and merkle trie nodes on top
 	JournalFileEnabled bool   // Whether the TrieJournal is stored using journal file
 
+	AccountChangeIndex   bool   `toml:",omitempty"` // Whether to index the blocks changing the balance or nonce of accounts
+	AccountChangeHistory uint64 `toml:",omitempty"` // The maximum number of blocks from head whose account changes are reserved.
+	AddressTxIndex       bool   `toml:",omitempty"` // Whether to index the transactions by address, within the TransactionHistory range
+	LogIndex             bool   `toml:",omitempty"` // Whether to index the logs by address and topic, filtering them faster than the bloombits
+
 	// RequiredBlocks is a set of block number -> hash mappings which must be in the
 	// canonical chain of all remote peers. Setting the option makes geth verify the
 	// presence of these blocks for every new peer connection.
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
 // MarshalTOML marshals as TOML.
@@ -32,12 +33,17 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		EnableTrustProtocol     bool
 		PipeCommit              bool
 		RangeLimit              bool
-		TxLookupLimit           uint64 `toml:",omitempty"`
-		TransactionHistory      uint64 `toml:",omitempty"`
-		StateHistory            uint64 `toml:",omitempty"`
-		StateScheme             string `toml:",omitempty"`
-		PathSyncFlush           bool   `toml:",omitempty"`
+		PrivateTxPeers          []enode.ID `toml:",omitempty"`
+		TxLookupLimit           uint64     `toml:",omitempty"`
+		TransactionHistory      uint64     `toml:",omitempty"`
+		StateHistory            uint64     `toml:",omitempty"`
+		StateScheme             string     `toml:",omitempty"`
+		PathSyncFlush           bool       `toml:",omitempty"`
 		JournalFileEnabled      bool
+		AccountChangeIndex      bool                   `toml:",omitempty"`
+		AccountChangeHistory    uint64                 `toml:",omitempty"`
+		AddressTxIndex          bool                   `toml:",omitempty"`
+		LogIndex                bool                   `toml:",omitempty"`
 		RequiredBlocks          map[uint64]common.Hash `toml:"-"`
 		LightServ               int                    `toml:",omitempty"`
 		LightIngress            int                    `toml:",omitempty"`
@@ -91,12 +97,17 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.EnableTrustProtocol = c.EnableTrustProtocol
 	enc.PipeCommit = c.PipeCommit
 	enc.RangeLimit = c.RangeLimit
+	enc.PrivateTxPeers = c.PrivateTxPeers
 	enc.TxLookupLimit = c.TxLookupLimit
 	enc.TransactionHistory = c.TransactionHistory
 	enc.StateHistory = c.StateHistory
 	enc.StateScheme = c.StateScheme
 	enc.PathSyncFlush = c.PathSyncFlush
 	enc.JournalFileEnabled = c.JournalFileEnabled
+	enc.AccountChangeIndex = c.AccountChangeIndex
+	enc.AccountChangeHistory = c.AccountChangeHistory
+	enc.AddressTxIndex = c.AddressTxIndex
+	enc.LogIndex = c.LogIndex
 	enc.RequiredBlocks = c.RequiredBlocks
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
@@ -154,12 +165,17 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		EnableTrustProtocol     *bool
 		PipeCommit              *bool
 		RangeLimit              *bool
-		TxLookupLimit           *uint64 `toml:",omitempty"`
-		TransactionHistory      *uint64 `toml:",omitempty"`
-		StateHistory            *uint64 `toml:",omitempty"`
-		StateScheme             *string `toml:",omitempty"`
-		PathSyncFlush           *bool   `toml:",omitempty"`
+		PrivateTxPeers          []enode.ID `toml:",omitempty"`
+		TxLookupLimit           *uint64    `toml:",omitempty"`
+		TransactionHistory      *uint64    `toml:",omitempty"`
+		StateHistory            *uint64    `toml:",omitempty"`
+		StateScheme             *string    `toml:",omitempty"`
+		PathSyncFlush           *bool      `toml:",omitempty"`
 		JournalFileEnabled      *bool
+		AccountChangeIndex      *bool                  `toml:",omitempty"`
+		AccountChangeHistory    *uint64                `toml:",omitempty"`
+		AddressTxIndex          *bool                  `toml:",omitempty"`
+		LogIndex                *bool                  `toml:",omitempty"`
 		RequiredBlocks          map[uint64]common.Hash `toml:"-"`
 		LightServ               *int                   `toml:",omitempty"`
 		LightIngress            *int                   `toml:",omitempty"`
@@ -246,6 +262,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.RangeLimit != nil {
 		c.RangeLimit = *dec.RangeLimit
 	}
+	if dec.PrivateTxPeers != nil {
+		c.PrivateTxPeers = dec.PrivateTxPeers
+	}
 	if dec.TxLookupLimit != nil {
 		c.TxLookupLimit = *dec.TxLookupLimit
 	}
@@ -264,6 +283,18 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.JournalFileEnabled != nil {
 		c.JournalFileEnabled = *dec.JournalFileEnabled
 	}
+	if dec.AccountChangeIndex != nil {
+		c.AccountChangeIndex = *dec.AccountChangeIndex
+	}
+	if dec.AccountChangeHistory != nil {
+		c.AccountChangeHistory = *dec.AccountChangeHistory
+	}
+	if dec.AddressTxIndex != nil {
+		c.AddressTxIndex = *dec.AddressTxIndex
+	}
+	if dec.LogIndex != nil {
+		c.LogIndex = *dec.LogIndex
+	}
 	if dec.RequiredBlocks != nil {
 		c.RequiredBlocks = dec.RequiredBlocks
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -416,6 +417,81 @@ func (api *FilterAPI) Logs(ctx context.Context, crit FilterCriteria) (*rpc.Subsc
 	return rpcSub, nil
 }
 
+// LogsFrom creates a subscription that replays the logs matching the given
+// filter criteria from a block or a cursor up to the head, before delivering
+// the new logs. Unlike Logs, no log is missed across reorgs and reconnects:
+//
+// A client resumes the stream by passing the position of the last log it
+// received as cursor. If the logs previously delivered leave the canonical
+// chain, they are delivered again with the removed property set to true.
+//
+// The fromBlock of the criteria is where the stream starts without cursor,
+// "latest" by default. The toBlock selects the blocks followed, "latest" by
+// default or "finalized" to only deliver finalized logs, which are never removed.
+func (api *FilterAPI) LogsFrom(ctx context.Context, crit FilterCriteria, cursor *LogCursor) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	stream, err := newLogStream(ctx, api.sys, crit, cursor)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		rpcSub  = notifier.CreateSubscription()
+		headers = make(chan *types.Header)
+		headSub *Subscription
+	)
+	stream.notify = func(l *types.Log) error {
+		return notifier.Notify(rpcSub.ID, l)
+	}
+	if stream.finalized {
+		headSub = api.events.SubscribeNewFinalizedHeaders(headers)
+	} else {
+		headSub = api.events.SubscribeNewHeads(headers)
+	}
+
+	gopool.Submit(func() {
+		defer headSub.Unsubscribe()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Catching up may take a while, keep consuming the events meanwhile,
+		// only remembering that the chain progressed.
+		progressed := make(chan struct{}, 1)
+		go func() {
+			for {
+				select {
+				case <-headers:
+					select {
+					case progressed <- struct{}{}:
+					default:
+					}
+				case <-rpcSub.Err(): // client send an unsubscribe request
+					cancel()
+					return
+				case <-notifier.Closed(): // connection dropped
+					cancel()
+					return
+				}
+			}
+		}()
+		for {
+			if err := stream.advance(ctx); err != nil && ctx.Err() == nil {
+				log.Warn("Failed to stream logs", "id", rpcSub.ID, "err", err)
+			}
+			select {
+			case <-progressed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+
+	return rpcSub, nil
+}
+
 // FilterCriteria represents a request to create a new filter.
 // Same as ethereum.FilterQuery but with UnmarshalJSON() method.
 type FilterCriteria ethereum.FilterQuery
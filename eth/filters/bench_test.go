@@ -19,6 +19,7 @@ package filters
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 func BenchmarkBloomBits512(b *testing.B) {
@@ -186,3 +188,66 @@ func BenchmarkNoBloomBits(b *testing.B) {
 	b.Log(" ", d, "total  ", d*time.Duration(1000000)/time.Duration(*headNum+1), "per million blocks")
 	db.Close()
 }
+
+func BenchmarkGeneratedBloomBits(b *testing.B) {
+	benchmarkGeneratedChain(b, false)
+}
+
+func BenchmarkGeneratedLogIndex(b *testing.B) {
+	benchmarkGeneratedChain(b, true)
+}
+
+// benchmarkGeneratedChain measures the filtering of the transfers received by a
+// holder over a generated chain with a heavy event volume, with either the
+// bloombits or the log index.
+func benchmarkGeneratedChain(b *testing.B, logIndex bool) {
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(b, db, Config{})
+		tokens       = make([]common.Address, 20)
+		holders      = make([]common.Address, 10000)
+		blocks       = 2 * params.BloomBitsBlocks
+	)
+	for i := range tokens {
+		tokens[i] = common.BigToAddress(big.NewInt(int64(0xfa00 + i)))
+	}
+	for i := range holders {
+		holders[i] = common.BigToAddress(big.NewInt(int64(0xbe0000 + i)))
+	}
+	generateTransferChain(db, int(blocks-1), 50, tokens, holders)
+
+	if logIndex {
+		backend.logIndexSize = params.LogIndexBlocks
+		backend.logIndexSections = indexLogs(b, db, params.LogIndexBlocks)
+	} else {
+		for section := uint64(0); section < blocks/params.BloomBitsBlocks; section++ {
+			gen, err := bloombits.NewGenerator(uint(params.BloomBitsBlocks))
+			if err != nil {
+				b.Fatal(err)
+			}
+			for i := uint64(0); i < params.BloomBitsBlocks; i++ {
+				number := section*params.BloomBitsBlocks + i
+				header := rawdb.ReadHeader(db, rawdb.ReadCanonicalHash(db, number), number)
+				gen.AddBloom(uint(i), header.Bloom)
+			}
+			head := rawdb.ReadCanonicalHash(db, (section+1)*params.BloomBitsBlocks-1)
+			for i := 0; i < types.BloomBitLength; i++ {
+				bits, err := gen.Bitset(uint(i))
+				if err != nil {
+					b.Fatal(err)
+				}
+				rawdb.WriteBloomBits(db, uint(i), section, head, bits)
+			}
+		}
+		backend.sections = blocks / params.BloomBitsBlocks
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		holder := common.BytesToHash(holders[i%len(holders)].Bytes())
+		filter := sys.NewRangeFilter(0, int64(blocks-1), nil, [][]common.Hash{{transferTopic}, {}, {holder}}, false)
+		if _, err := filter.Logs(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
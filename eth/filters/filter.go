@@ -23,6 +23,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/logindex"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -198,9 +200,23 @@ func (f *Filter) rangeLogsAsync(ctx context.Context) (chan *types.Log, chan erro
 		// Gather all indexed logs, and finish with non indexed ones
 		var (
 			end            = uint64(f.end)
-			size, sections = f.sys.backend.BloomStatus()
+			size, sections = f.sys.backend.LogIndexStatus()
 			err            error
 		)
+		// The log index is preferred over the bloombits, which are only used for
+		// the criteria and the range it doesn't cover. Sections below the tail
+		// of a pruned chain are not indexed.
+		tail := rawdb.ReadLogIndexTail(f.sys.backend.ChainDb()) * size
+		if indexed := sections * size; indexed > uint64(f.begin) && uint64(f.begin) >= tail {
+			if indexed > end {
+				indexed = end + 1
+			}
+			if err = f.logIndexLogs(ctx, size, indexed-1, logChan); err != nil {
+				errChan <- err
+				return
+			}
+		}
+		size, sections = f.sys.backend.BloomStatus()
 		if indexed := sections * size; indexed > uint64(f.begin) {
 			if indexed > end {
 				indexed = end + 1
@@ -222,6 +238,53 @@ func (f *Filter) rangeLogsAsync(ctx context.Context) (chan *types.Log, chan erro
 	return logChan, errChan
 }
 
+// logIndexLogs returns the logs matching the filter criteria based on the log
+// index, stopping without progress if the criteria restrict neither addresses
+// nor topics.
+func (f *Filter) logIndexLogs(ctx context.Context, size, end uint64, logChan chan *types.Log) error {
+	db := f.sys.backend.ChainDb()
+	for section := uint64(f.begin) / size; section <= end/size; section++ {
+		head, err := f.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber((section+1)*size-1))
+		if head == nil || err != nil {
+			return err
+		}
+		matches, ok, err := logindex.Match(f.addresses, f.topics, func(term logindex.Term) ([]byte, error) {
+			return rawdb.ReadLogIndex(db, term[:], section, head.Hash()), nil
+		})
+		if err != nil || !ok {
+			return err
+		}
+		// The positions only narrow down the blocks to retrieve, whose logs are
+		// still filtered as usual
+		var last uint64
+		for i, match := range matches {
+			number := section*size + match.Offset()
+			if number < uint64(f.begin) || number > end || (i > 0 && number == last) {
+				continue
+			}
+			last = number
+
+			header, err := f.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(number))
+			if header == nil || err != nil {
+				return err
+			}
+			found, err := f.checkMatches(ctx, header)
+			if err != nil {
+				return err
+			}
+			for _, log := range found {
+				select {
+				case logChan <- log:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+		f.begin = int64(min((section+1)*size, end+1))
+	}
+	return nil
+}
+
 // indexedLogs returns the logs matching the filter criteria based on the bloom
 // bits indexed available locally or via the network.
 func (f *Filter) indexedLogs(ctx context.Context, end uint64, logChan chan *types.Log) error {
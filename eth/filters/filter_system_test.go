@@ -44,6 +44,8 @@ import (
 type testBackend struct {
 	db                  ethdb.Database
 	sections            uint64
+	logIndexSize        uint64
+	logIndexSections    uint64
 	txFeed              event.Feed
 	logsFeed            event.Feed
 	rmLogsFeed          event.Feed
@@ -157,6 +159,10 @@ func (b *testBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, b.sections
 }
 
+func (b *testBackend) LogIndexStatus() (uint64, uint64) {
+	return b.logIndexSize, b.logIndexSections
+}
+
 func (b *testBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
 	requests := make(chan chan *bloombits.Retrieval)
 
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
+	"math/rand"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -32,8 +34,11 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/ethereum/go-ethereum/triedb"
 )
 
@@ -390,3 +395,137 @@ func TestFilters(t *testing.T) {
 		}
 	})
 }
+
+// transferTopic is the topic of the ERC-20 Transfer event.
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// generateTransferChain writes a chain of blocks whose receipts carry transfer
+// events of the tokens between random holders, without executing them.
+func generateTransferChain(db ethdb.Database, blocks, transfers int, tokens, holders []common.Address) {
+	var (
+		rng    = rand.New(rand.NewSource(1))
+		parent = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0), Difficulty: common.Big1})
+	)
+	rawdb.WriteBlock(db, parent)
+	rawdb.WriteCanonicalHash(db, parent.Hash(), 0)
+	rawdb.WriteReceipts(db, parent.Hash(), 0, types.Receipts{})
+
+	for n := 1; n <= blocks; n++ {
+		var (
+			txs      = make([]*types.Transaction, transfers)
+			receipts = make([]*types.Receipt, transfers)
+		)
+		for i := range txs {
+			var (
+				token = tokens[rng.Intn(len(tokens))]
+				from  = holders[rng.Intn(len(holders))]
+				to    = holders[rng.Intn(len(holders))]
+			)
+			txs[i] = types.NewTransaction(uint64(n*transfers+i), token, nil, 50000, big.NewInt(1), nil)
+			receipts[i] = types.NewReceipt(nil, false, uint64(i+1)*50000)
+			receipts[i].Logs = []*types.Log{{
+				Address: token,
+				Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+				Data:    common.LeftPadBytes(big.NewInt(int64(rng.Intn(1000))).Bytes(), 32),
+			}}
+			receipts[i].Bloom = types.CreateBloom(types.Receipts{receipts[i]})
+		}
+		header := &types.Header{ParentHash: parent.Hash(), Number: big.NewInt(int64(n)), Difficulty: common.Big1, GasLimit: uint64(transfers) * 50000}
+		block := types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		rawdb.WriteHeadBlockHash(db, block.Hash())
+		rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts)
+		parent = block
+	}
+}
+
+// indexerChain is the chain followed by the chain indexers in the tests.
+type indexerChain struct {
+	head *types.Header
+	feed event.Feed
+}
+
+func (c *indexerChain) CurrentHeader() *types.Header { return c.head }
+
+func (c *indexerChain) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return c.feed.Subscribe(ch)
+}
+
+// indexLogs builds the log index of the chain in the database with sections of
+// the given size, returning the number of sections indexed.
+func indexLogs(t testing.TB, db ethdb.Database, size uint64) uint64 {
+	var (
+		hash    = rawdb.ReadHeadBlockHash(db)
+		head    = rawdb.ReadHeader(db, hash, *rawdb.ReadHeaderNumber(db, hash))
+		want    = (head.Number.Uint64() + 1) / size
+		indexer = core.NewLogIndexer(db, size, 0)
+	)
+	defer indexer.Close()
+	indexer.Start(&indexerChain{head: head})
+
+	for deadline := time.Now().Add(time.Minute); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if sections, _, _ := indexer.Sections(); sections == want {
+			return sections
+		}
+	}
+	t.Fatalf("log index not built")
+	return 0
+}
+
+// Tests that filtering the logs with the log index returns the same logs as
+// without, for criteria and ranges both covered or not by the index.
+func TestLogIndexFilters(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(t, db, Config{})
+		tokens       = make([]common.Address, 5)
+		holders      = make([]common.Address, 20)
+		holderTopic  = func(i int) common.Hash { return common.BytesToHash(holders[i].Bytes()) }
+	)
+	for i := range tokens {
+		tokens[i] = common.BytesToAddress([]byte{0xfa, byte(i)})
+	}
+	for i := range holders {
+		holders[i] = common.BytesToAddress([]byte{0xbe, byte(i)})
+	}
+	generateTransferChain(db, 300, 8, tokens, holders)
+	backend.logIndexSize = 64
+	sections := indexLogs(t, db, backend.logIndexSize)
+
+	for i, tc := range []struct {
+		addresses []common.Address
+		topics    [][]common.Hash
+	}{
+		{addresses: []common.Address{tokens[0]}},
+		{addresses: []common.Address{tokens[1], tokens[2]}, topics: [][]common.Hash{{transferTopic}, {}, {holderTopic(3)}}},
+		{topics: [][]common.Hash{{}, {holderTopic(1), holderTopic(2)}}},
+		{topics: [][]common.Hash{{}, {holderTopic(4)}, {holderTopic(5)}}},
+		{addresses: []common.Address{{0xde, 0xad}}},
+		{},
+	} {
+		for _, r := range [][2]int64{{0, -1}, {70, 200}, {100, 290}} {
+			backend.logIndexSections = 0
+			want, err := sys.NewRangeFilter(r[0], r[1], tc.addresses, tc.topics, false).Logs(context.Background())
+			if err != nil {
+				t.Fatalf("test %d, range %v: failed to filter without index: %v", i, r, err)
+			}
+			backend.logIndexSections = sections
+			have, err := sys.NewRangeFilter(r[0], r[1], tc.addresses, tc.topics, false).Logs(context.Background())
+			if err != nil {
+				t.Fatalf("test %d, range %v: failed to filter with index: %v", i, r, err)
+			}
+			if len(have) != len(want) {
+				t.Fatalf("test %d, range %v: log count mismatch: have %d, want %d", i, r, len(have), len(want))
+			}
+			for j := range have {
+				if !reflect.DeepEqual(have[j], want[j]) {
+					t.Fatalf("test %d, range %v: log %d mismatch: have %v, want %v", i, r, j, have[j], want[j])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// logStreamRange is the maximum number of blocks searched at once when a log
+// stream replays the history.
+const logStreamRange = 1000
+
+// LogCursor is the position of a log in the chain. A logsFrom subscription
+// resumed from a cursor delivers the logs following it, clients pass the
+// blockNumber, blockHash and logIndex of the last log they received.
+type LogCursor struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	LogIndex    hexutil.Uint   `json:"logIndex"`
+}
+
+// logStream delivers the logs matching a filter block after block, following
+// either the head or the finalized block of the chain. Blocks are tracked by
+// hash, hence the logs of the blocks leaving the canonical chain are delivered
+// again, flagged as removed.
+type logStream struct {
+	sys       *FilterSystem
+	crit      FilterCriteria
+	finalized bool // whether to follow the finalized block instead of the head
+	notify    func(*types.Log) error
+
+	prev    *types.Header // last block delivered, nil if none
+	partial *uint         // index of the last log delivered from prev, nil if delivered entirely
+}
+
+// newLogStream creates a stream of the logs matching the criteria, starting
+// after the cursor if any, or at the criteria's fromBlock otherwise. The
+// criteria's toBlock selects the blocks followed, either latest or finalized.
+func newLogStream(ctx context.Context, sys *FilterSystem, crit FilterCriteria, cursor *LogCursor) (*logStream, error) {
+	if crit.BlockHash != nil {
+		return nil, errors.New("logsFrom does not support filtering by block hash")
+	}
+	if len(crit.Topics) > maxTopics {
+		return nil, errExceedMaxTopics
+	}
+	s := &logStream{sys: sys, crit: crit}
+	if crit.ToBlock != nil {
+		switch rpc.BlockNumber(crit.ToBlock.Int64()) {
+		case rpc.LatestBlockNumber:
+		case rpc.FinalizedBlockNumber:
+			s.finalized = true
+		default:
+			return nil, errInvalidBlockRange
+		}
+	}
+	if cursor != nil {
+		header, err := sys.backend.HeaderByHash(ctx, cursor.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		if header == nil || header.Number.Uint64() != uint64(cursor.BlockNumber) {
+			return nil, fmt.Errorf("unknown cursor block #%d (%x)", cursor.BlockNumber, cursor.BlockHash)
+		}
+		index := uint(cursor.LogIndex)
+		s.prev, s.partial = header, &index
+		return s, nil
+	}
+	from := rpc.LatestBlockNumber
+	if crit.FromBlock != nil {
+		from = rpc.BlockNumber(crit.FromBlock.Int64())
+	}
+	switch {
+	case from == rpc.LatestBlockNumber:
+		// Only interested in the blocks to come
+		head, err := s.target(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.prev = head
+	case from > 0:
+		header, err := sys.backend.HeaderByNumber(ctx, from-1)
+		if err != nil {
+			return nil, err
+		}
+		if header == nil {
+			return nil, fmt.Errorf("block #%d not found", from-1)
+		}
+		s.prev = header
+	case from < 0:
+		return nil, errInvalidBlockRange
+	}
+	return s, nil
+}
+
+// target returns the block the stream is following.
+func (s *logStream) target(ctx context.Context) (*types.Header, error) {
+	number := rpc.LatestBlockNumber
+	if s.finalized {
+		number = rpc.FinalizedBlockNumber
+	}
+	header, err := s.sys.backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, errors.New("target header not found")
+	}
+	return header, nil
+}
+
+// canonical reports whether the header is part of the canonical chain.
+func (s *logStream) canonical(ctx context.Context, header *types.Header) (bool, error) {
+	canon, err := s.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(header.Number.Int64()))
+	if err != nil {
+		return false, err
+	}
+	return canon != nil && canon.Hash() == header.Hash(), nil
+}
+
+// blockLogs returns the logs of a block matching the criteria, which may not
+// be part of the canonical chain.
+func (s *logStream) blockLogs(ctx context.Context, header *types.Header) ([]*types.Log, error) {
+	return s.sys.NewBlockFilter(header.Hash(), s.crit.Addresses, s.crit.Topics).Logs(ctx)
+}
+
+// advance delivers the logs up to the block followed. The logs of the
+// delivered blocks which left the canonical chain are delivered again in
+// reverse order as removed, before the logs of the blocks replacing them.
+func (s *logStream) advance(ctx context.Context) error {
+	for {
+		if err := s.rewind(ctx); err != nil {
+			return err
+		}
+		if err := s.completePartial(ctx); err != nil {
+			return err
+		}
+		target, err := s.target(ctx)
+		if err != nil {
+			return err
+		}
+		next := uint64(0)
+		if s.prev != nil {
+			next = s.prev.Number.Uint64() + 1
+		}
+		if next > target.Number.Uint64() {
+			return nil
+		}
+		end := min(next+logStreamRange-1, target.Number.Uint64())
+		last, err := s.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(end))
+		if err != nil {
+			return err
+		}
+		if last == nil {
+			return fmt.Errorf("block #%d not found", end)
+		}
+		logs, err := s.sys.NewRangeFilter(int64(next), int64(end), s.crit.Addresses, s.crit.Topics, false).Logs(ctx)
+		if err != nil {
+			return err
+		}
+		// The range is searched by number, make sure no reorg happened meanwhile,
+		// otherwise the logs may not all belong to the chain extending prev.
+		if ok, err := s.stillCanonical(ctx, last); err != nil || !ok {
+			if err == nil {
+				log.Debug("Chain reorganised while streaming logs, retrying", "from", next, "to", end)
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		for _, l := range logs {
+			if err := s.notify(l); err != nil {
+				return err
+			}
+		}
+		s.prev = last
+	}
+}
+
+// stillCanonical reports whether both the last delivered block and the last
+// block of the range searched are still canonical.
+func (s *logStream) stillCanonical(ctx context.Context, last *types.Header) (bool, error) {
+	if s.prev != nil {
+		if ok, err := s.canonical(ctx, s.prev); err != nil || !ok {
+			return false, err
+		}
+	}
+	return s.canonical(ctx, last)
+}
+
+// rewind walks the delivered blocks back to the canonical chain, delivering
+// their logs again as removed.
+func (s *logStream) rewind(ctx context.Context) error {
+	for s.prev != nil {
+		ok, err := s.canonical(ctx, s.prev)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		logs, err := s.blockLogs(ctx, s.prev)
+		if err != nil {
+			return err
+		}
+		for i := len(logs) - 1; i >= 0; i-- {
+			if s.partial != nil && logs[i].Index > *s.partial {
+				continue
+			}
+			removed := *logs[i]
+			removed.Removed = true
+			if err := s.notify(&removed); err != nil {
+				return err
+			}
+		}
+		s.partial = nil
+		if s.prev.Number.Sign() == 0 {
+			s.prev = nil
+			return nil
+		}
+		parent, err := s.sys.backend.HeaderByHash(ctx, s.prev.ParentHash)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return fmt.Errorf("parent of block #%d (%x) not found", s.prev.Number, s.prev.Hash())
+		}
+		s.prev = parent
+	}
+	return nil
+}
+
+// completePartial delivers the logs following the cursor the stream resumed
+// from, in the block of the cursor.
+func (s *logStream) completePartial(ctx context.Context) error {
+	if s.partial == nil {
+		return nil
+	}
+	logs, err := s.blockLogs(ctx, s.prev)
+	if err != nil {
+		return err
+	}
+	for _, l := range logs {
+		if l.Index <= *s.partial {
+			continue
+		}
+		if err := s.notify(l); err != nil {
+			return err
+		}
+	}
+	s.partial = nil
+	return nil
+}
@@ -0,0 +1,156 @@
+package filters
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// Tests that log streams replay the history, deliver the removed logs on reorg
+// and resume from a cursor.
+func TestLogsFrom(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(t, db, Config{})
+		api          = NewFilterAPI(sys, false)
+
+		key, _   = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr     = crypto.PubkeyToAddress(key.PublicKey)
+		signer   = types.NewLondonSigner(big.NewInt(1))
+		contract = common.Address{0xfe}
+
+		gspec = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr:     {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+				contract: {Balance: big.NewInt(0), Code: common.FromHex("0x60006000a000")}, // log0(0, 0)
+			},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+	)
+	genesis, err := gspec.Commit(db, triedb.NewDatabase(db, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every block of both chains emits a single log, the fork replaces the last
+	// three blocks of the chain with four others.
+	generate := func(parent *types.Block, n int, nonce uint64, coinbase common.Address) []*types.Block {
+		blocks, receipts := core.GenerateChain(gspec.Config, parent, ethash.NewFaker(), db, n, func(i int, gen *core.BlockGen) {
+			gen.SetCoinbase(coinbase)
+			tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{
+				Nonce:    nonce + uint64(i),
+				GasPrice: gen.BaseFee(),
+				Gas:      30000,
+				To:       &contract,
+			}), signer, key)
+			gen.AddTx(tx)
+		})
+		for i, block := range blocks {
+			rawdb.WriteBlock(db, block)
+			rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts[i])
+		}
+		return blocks
+	}
+	setHead := func(blocks []*types.Block) {
+		for _, block := range blocks {
+			rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		}
+		head := blocks[len(blocks)-1]
+		rawdb.WriteHeadBlockHash(db, head.Hash())
+		backend.chainFeed.Send(core.ChainEvent{Block: head, Hash: head.Hash()})
+	}
+	chain := generate(genesis, 6, 0, common.Address{0x1})
+	fork := generate(chain[2], 4, 3, common.Address{0x2})
+	setHead(chain)
+
+	server := rpc.NewServer()
+	defer server.Stop()
+	if err := server.RegisterName("eth", api); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	type event struct {
+		block   common.Hash
+		removed bool
+	}
+	expect := func(t *testing.T, logs chan types.Log, want []event) {
+		t.Helper()
+		for i, w := range want {
+			select {
+			case l := <-logs:
+				if l.BlockHash != w.block || l.Removed != w.removed || l.Address != contract || l.TxHash == (common.Hash{}) {
+					t.Fatalf("log %d mismatch: have block %x removed %v, want block %x removed %v", i, l.BlockHash, l.Removed, w.block, w.removed)
+				}
+			case <-time.After(3 * time.Second):
+				t.Fatalf("timeout waiting for log %d", i)
+			}
+		}
+		select {
+		case l := <-logs:
+			t.Fatalf("unexpected log of block %x", l.BlockHash)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	added := func(blocks ...*types.Block) []event {
+		var events []event
+		for _, block := range blocks {
+			events = append(events, event{block.Hash(), false})
+		}
+		return events
+	}
+	removed := func(blocks ...*types.Block) []event {
+		var events []event
+		for _, block := range blocks {
+			events = append(events, event{block.Hash(), true})
+		}
+		return events
+	}
+	subscribe := func(t *testing.T, crit map[string]interface{}, cursor *LogCursor) chan types.Log {
+		logs := make(chan types.Log, 16)
+		sub, err := client.EthSubscribe(context.Background(), logs, "logsFrom", crit, cursor)
+		if err != nil {
+			t.Fatalf("failed to subscribe: %v", err)
+		}
+		t.Cleanup(sub.Unsubscribe)
+		return logs
+	}
+
+	// Replay from block 2, then follow the reorg
+	logs := subscribe(t, map[string]interface{}{"fromBlock": "0x2"}, nil)
+	expect(t, logs, added(chain[1:]...))
+
+	setHead(append(chain[:3:3], fork...))
+	expect(t, logs, append(removed(chain[5], chain[4], chain[3]), added(fork...)...))
+
+	// Resume from a log which left the canonical chain
+	logs = subscribe(t, map[string]interface{}{}, &LogCursor{BlockNumber: 5, BlockHash: chain[4].Hash()})
+	expect(t, logs, append(removed(chain[4], chain[3]), added(fork...)...))
+
+	// Resume from a canonical log
+	logs = subscribe(t, map[string]interface{}{}, &LogCursor{BlockNumber: 5, BlockHash: fork[1].Hash()})
+	expect(t, logs, added(fork[2:]...))
+
+	// Unknown cursors and unsupported targets are rejected
+	if _, err := client.EthSubscribe(context.Background(), make(chan types.Log), "logsFrom", map[string]interface{}{}, &LogCursor{BlockNumber: 5, BlockHash: common.Hash{0x1}}); err == nil {
+		t.Fatal("expected error for unknown cursor")
+	}
+	if _, err := client.EthSubscribe(context.Background(), make(chan types.Log), "logsFrom", map[string]interface{}{"toBlock": hexutil.EncodeUint64(3)}, nil); err == nil {
+		t.Fatal("expected error for unsupported toBlock")
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
 const (
@@ -83,6 +84,13 @@ type txPool interface {
 	// Add should add the given transactions to the pool.
 	Add(txs []*types.Transaction, local bool, sync bool) []error
 
+	// AddPrivate should add the given transaction to the pool flagged as private
+	// until the expiry block.
+	AddPrivate(tx *types.Transaction, expiry uint64) error
+
+	// IsPrivate returns whether a pooled transaction must not be propagated.
+	IsPrivate(hash common.Hash) bool
+
 	// Pending should return pending transactions.
 	// The slice should be modifiable by the caller.
 	Pending(filter txpool.PendingFilter) map[common.Address][]*txpool.LazyTransaction
@@ -123,6 +131,7 @@ type handlerConfig struct {
 	RequiredBlocks         map[uint64]common.Hash // Hard coded map of required block hashes for sync challenges
 	DirectBroadcast        bool
 	DisablePeerTxBroadcast bool
+	PrivateTxPeers         []enode.ID // Trusted validator peers private transactions are exchanged with
 	PeerSet                *peerSet
 }
 
@@ -162,6 +171,7 @@ type handler struct {
 	voteMonitorSub event.Subscription
 
 	requiredBlocks map[uint64]common.Hash
+	privateTxPeers map[enode.ID]struct{} // Trusted validator peers private transactions are exchanged with
 
 	// channels for fetcher, syncer, txsyncLoop
 	quitSync chan struct{}
@@ -196,12 +206,16 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		merger:                 config.Merger,
 		peersPerIP:             make(map[string]int),
 		requiredBlocks:         config.RequiredBlocks,
+		privateTxPeers:         make(map[enode.ID]struct{}),
 		directBroadcast:        config.DirectBroadcast,
 		quitSync:               make(chan struct{}),
 		handlerDoneCh:          make(chan struct{}),
 		handlerStartCh:         make(chan struct{}),
 		stopCh:                 make(chan struct{}),
 	}
+	for _, id := range config.PrivateTxPeers {
+		h.privateTxPeers[id] = struct{}{}
+	}
 	if config.Sync == downloader.FullSync {
 		// The database seems empty as the current block is the genesis. Yet the snap
 		// block is ahead, so snap sync was enabled for this node at a certain point.
@@ -843,6 +857,8 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 		blobTxs  int // Number of blob transactions to announce only
 		largeTxs int // Number of large transactions to announce only
 
+		privateTxs int // Number of private transactions not to propagate
+
 		directCount int // Number of transactions sent directly to peers (duplicates included)
 		directPeers int // Number of peers that were sent transactions directly
 		annCount    int // Number of transactions announced across all peers (duplicates included)
@@ -853,6 +869,11 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 	)
 	// Broadcast transactions to a batch of peers not knowing about it
 	for _, tx := range txs {
+		// Private transactions are only ever relayed to trusted peers
+		if h.txpool.IsPrivate(tx.Hash()) {
+			privateTxs++
+			continue
+		}
 		peers := h.peers.peersWithoutTransaction(tx.Hash())
 
 		var numDirect int
@@ -883,8 +904,35 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 		annCount += len(hashes)
 		peer.AsyncSendPooledTransactionHashes(hashes)
 	}
-	log.Debug("Distributed transactions", "plaintxs", len(txs)-blobTxs-largeTxs, "blobtxs", blobTxs, "largetxs", largeTxs,
-		"bcastpeers", directPeers, "bcastcount", directCount, "annpeers", annPeers, "anncount", annCount)
+	log.Debug("Distributed transactions", "plaintxs", len(txs)-blobTxs-largeTxs-privateTxs, "blobtxs", blobTxs, "largetxs", largeTxs,
+		"privatetxs", privateTxs, "bcastpeers", directPeers, "bcastcount", directCount, "annpeers", annPeers, "anncount", annCount)
+}
+
+// RelayPrivateTransactions sends a batch of private transactions to the trusted
+// validator peers configured for private transactions and connected over
+// `trust`, so that they may include them in their blocks before the expiry block.
+// It returns the number of peers the transactions were relayed to.
+func (h *handler) RelayPrivateTransactions(expiry uint64, txs types.Transactions) int {
+	var relayed int
+	for _, peer := range h.peers.trustPeers() {
+		if peer.Version() < trust.Trust2 || !h.isPrivateTxPeer(peer.Node().ID()) {
+			continue
+		}
+		if err := peer.SendPrivateTransactions(expiry, txs); err != nil {
+			peer.Log().Debug("Failed to relay private transactions", "err", err)
+			continue
+		}
+		relayed++
+	}
+	log.Debug("Relayed private transactions", "txs", len(txs), "expiry", expiry, "peers", relayed)
+	return relayed
+}
+
+// isPrivateTxPeer returns whether private transactions may be exchanged with the
+// peer of the given node id.
+func (h *handler) isPrivateTxPeer(id enode.ID) bool {
+	_, ok := h.privateTxPeers[id]
+	return ok
 }
 
 // ReannounceTransactions will announce a batch of local pending transactions
@@ -892,6 +940,9 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 func (h *handler) ReannounceTransactions(txs types.Transactions) {
 	hashes := make([]common.Hash, 0, txs.Len())
 	for _, tx := range txs {
+		if h.txpool.IsPrivate(tx.Hash()) {
+			continue
+		}
 		hashes = append(hashes, tx.Hash())
 	}
 
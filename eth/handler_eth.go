@@ -34,7 +34,7 @@ import (
 type ethHandler handler
 
 func (h *ethHandler) Chain() *core.BlockChain { return h.chain }
-func (h *ethHandler) TxPool() eth.TxPool      { return h.txpool }
+func (h *ethHandler) TxPool() eth.TxPool      { return (*publicTxPool)(h) }
 
 // RunPeer is invoked when a peer joins on the `eth` protocol.
 func (h *ethHandler) RunPeer(peer *eth.Peer, hand eth.Handler) error {
@@ -145,3 +145,15 @@ func (h *ethHandler) handleBlockBroadcast(peer *eth.Peer, packet *eth.NewBlockPa
 	}
 	return nil
 }
+
+// publicTxPool exposes the transaction pool to the `eth` protocol, hiding the
+// private transactions from remote peers.
+type publicTxPool ethHandler
+
+// Get retrieves a transaction from the pool unless it is private.
+func (p *publicTxPool) Get(hash common.Hash) *types.Transaction {
+	if p.txpool.IsPrivate(hash) {
+		return nil
+	}
+	return p.txpool.Get(hash)
+}
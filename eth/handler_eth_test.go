@@ -536,6 +536,68 @@ func testTransactionPropagation(t *testing.T, protocol uint) {
 	}
 }
 
+// Tests that private transactions are neither broadcast nor served to peers,
+// while the public ones are propagated as usual.
+func TestPrivateTransactionPropagation(t *testing.T) {
+	t.Parallel()
+
+	source := newTestHandler()
+	source.handler.snapSync.Store(false)
+	defer source.close()
+
+	sink := newTestHandler()
+	defer sink.close()
+	sink.handler.synced.Store(true) // mark synced to accept transactions
+
+	sourcePipe, sinkPipe := p2p.MsgPipe()
+	defer sourcePipe.Close()
+	defer sinkPipe.Close()
+
+	sourcePeer := eth.NewPeer(eth.ETH68, p2p.NewPeerPipe(enode.ID{1}, "", nil, sourcePipe), sourcePipe, source.txpool)
+	sinkPeer := eth.NewPeer(eth.ETH68, p2p.NewPeerPipe(enode.ID{0}, "", nil, sinkPipe), sinkPipe, sink.txpool)
+	defer sourcePeer.Close()
+	defer sinkPeer.Close()
+
+	go source.handler.runEthPeer(sourcePeer, func(peer *eth.Peer) error {
+		return eth.Handle((*ethHandler)(source.handler), peer)
+	})
+	go sink.handler.runEthPeer(sinkPeer, func(peer *eth.Peer) error {
+		return eth.Handle((*ethHandler)(sink.handler), peer)
+	})
+	// Wait for the peering to complete, transactions are only sent to known peers
+	for source.handler.peers.len() == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	txCh := make(chan core.NewTxsEvent, 16)
+	sub := sink.txpool.SubscribeTransactions(txCh, false)
+	defer sub.Unsubscribe()
+
+	private, _ := types.SignTx(types.NewTransaction(0, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil), types.HomesteadSigner{}, testKey)
+	public, _ := types.SignTx(types.NewTransaction(1, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil), types.HomesteadSigner{}, testKey)
+
+	if err := source.txpool.AddPrivate(private, 10); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	source.txpool.Add([]*types.Transaction{public}, false, false)
+
+	select {
+	case event := <-txCh:
+		if len(event.Txs) != 1 || event.Txs[0].Hash() != public.Hash() {
+			t.Fatalf("propagated transactions mismatch: have %d, want public only", len(event.Txs))
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("public transaction propagation timed out")
+	}
+	select {
+	case event := <-txCh:
+		t.Fatalf("unexpected transactions propagated: %d", len(event.Txs))
+	case <-time.After(100 * time.Millisecond):
+	}
+	if tx := (*ethHandler)(source.handler).TxPool().Get(private.Hash()); tx != nil {
+		t.Fatalf("private transaction served to peers")
+	}
+}
+
 // Tests that local pending transactions get propagated to peers.
 func TestTransactionPendingReannounce(t *testing.T) {
 	t.Parallel()
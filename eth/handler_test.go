@@ -56,7 +56,8 @@ var (
 // Its goal is to get around setting up a valid statedb for the balance and nonce
 // checks.
 type testTxPool struct {
-	pool map[common.Hash]*types.Transaction // Hash map of collected transactions
+	pool    map[common.Hash]*types.Transaction // Hash map of collected transactions
+	private map[common.Hash]uint64             // Expiry blocks of the private transactions
 
 	txFeed       event.Feed   // Notification feed to allow waiting for inclusion
 	reannoTxFeed event.Feed   // Notification feed to trigger reannouce
@@ -100,6 +101,27 @@ func (p *testTxPool) Add(txs []*types.Transaction, local bool, sync bool) []erro
 	return make([]error, len(txs))
 }
 
+// AddPrivate appends a transaction to the pool flagged as private.
+func (p *testTxPool) AddPrivate(tx *types.Transaction, expiry uint64) error {
+	p.lock.Lock()
+	if p.private == nil {
+		p.private = make(map[common.Hash]uint64)
+	}
+	p.private[tx.Hash()] = expiry
+	p.lock.Unlock()
+
+	return p.Add([]*types.Transaction{tx}, false, true)[0]
+}
+
+// IsPrivate returns whether a transaction was added as private.
+func (p *testTxPool) IsPrivate(hash common.Hash) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	_, ok := p.private[hash]
+	return ok
+}
+
 // ReannouceTransactions announce the transactions to some peers.
 func (p *testTxPool) ReannouceTransactions(txs []*types.Transaction) []error {
 	p.lock.Lock()
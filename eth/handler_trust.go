@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/eth/protocols/trust"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 )
@@ -47,6 +48,25 @@ func (h *trustHandler) Handle(peer *trust.Peer, packet trust.Packet) error {
 		}
 		return errors.New("verify manager is nil which is unexpected")
 
+	case *trust.PrivateTransactionsPacket:
+		// Only the configured validator peers may hand over private transactions,
+		// and only for a short window, so that they can't be used to hide and pin
+		// transactions in the pool.
+		if !(*handler)(h).isPrivateTxPeer(peer.Node().ID()) {
+			peer.Log().Debug("Dropped private transactions from untrusted peer", "txs", len(packet.Transactions))
+			return nil
+		}
+		expiry := packet.Expiry
+		if limit := h.chain.CurrentBlock().Number.Uint64() + txpool.DefaultPrivateTxExpiry; expiry > limit {
+			expiry = limit
+		}
+		for _, tx := range packet.Transactions {
+			if err := h.txpool.AddPrivate(tx, expiry); err != nil {
+				peer.Log().Debug("Failed to add relayed private transaction", "hash", tx.Hash(), "err", err)
+			}
+		}
+		return nil
+
 	default:
 		return fmt.Errorf("unexpected trust packet type: %T", packet)
 	}
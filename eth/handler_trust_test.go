@@ -0,0 +1,50 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/protocols/trust"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// Tests that private transactions are only accepted from the configured trusted
+// validator peers, and that the expiry they request is capped.
+func TestHandlePrivateTransactions(t *testing.T) {
+	t.Parallel()
+
+	handler := newTestHandler()
+	defer handler.close()
+
+	trusted, untrusted := enode.ID{1}, enode.ID{2}
+	handler.handler.privateTxPeers[trusted] = struct{}{}
+
+	handle := func(id enode.ID, tx *types.Transaction, expiry uint64) {
+		app, net := p2p.MsgPipe()
+		defer app.Close()
+		defer net.Close()
+
+		peer := trust.NewPeer(trust.Trust2, p2p.NewPeerPipe(id, "", nil, app), app)
+		packet := &trust.PrivateTransactionsPacket{Expiry: expiry, Transactions: types.Transactions{tx}}
+		if err := (*trustHandler)(handler.handler).Handle(peer, packet); err != nil {
+			t.Fatalf("failed to handle private transactions: %v", err)
+		}
+	}
+	dropped, _ := types.SignTx(types.NewTransaction(0, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil), types.HomesteadSigner{}, testKey)
+	handle(untrusted, dropped, 10)
+	if handler.txpool.Has(dropped.Hash()) {
+		t.Fatalf("private transaction accepted from untrusted peer")
+	}
+	accepted, _ := types.SignTx(types.NewTransaction(1, common.Address{}, big.NewInt(0), 100000, big.NewInt(0), nil), types.HomesteadSigner{}, testKey)
+	handle(trusted, accepted, 1_000_000)
+	if !handler.txpool.IsPrivate(accepted.Hash()) {
+		t.Fatalf("private transaction not accepted from trusted peer")
+	}
+	if expiry, limit := handler.txpool.private[accepted.Hash()], handler.chain.CurrentBlock().Number.Uint64()+txpool.DefaultPrivateTxExpiry; expiry != limit {
+		t.Fatalf("private transaction expiry mismatch: have %d, want %d", expiry, limit)
+	}
+}
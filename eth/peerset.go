@@ -377,6 +377,20 @@ func (ps *peerSet) GetVerifyPeers() []core.VerifyPeer {
 	return res
 }
 
+// trustPeers retrieves all the peers connected over the `trust` extension.
+func (ps *peerSet) trustPeers() []*trustPeer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	list := make([]*trustPeer, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if p.trustExt != nil && p.trustExt.Peer != nil {
+			list = append(list, p.trustExt)
+		}
+	}
+	return list
+}
+
 // registerPeer injects a new `eth` peer into the working set, or returns an error
 // if the peer is already known.
 func (ps *peerSet) registerPeer(peer *eth.Peer, ext *snap.Peer, trustExt *trust.Peer, bscExt *bsc.Peer) error {
@@ -110,6 +110,9 @@ func handleMessage(backend Backend, peer *Peer) error {
 	case msg.Code == RespondRootMsg:
 		return handleRootResponse(backend, msg, peer)
 
+	case msg.Code == PrivateTransactionsMsg && peer.Version() >= Trust2:
+		return handlePrivateTransactions(backend, msg, peer)
+
 	default:
 		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
 	}
@@ -147,6 +150,19 @@ func handleRootResponse(backend Backend, msg Decoder, peer *Peer) error {
 	return backend.Handle(peer, res)
 }
 
+func handlePrivateTransactions(backend Backend, msg Decoder, peer *Peer) error {
+	txs := new(PrivateTransactionsPacket)
+	if err := msg.Decode(txs); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	for i, tx := range txs.Transactions {
+		if tx == nil {
+			return fmt.Errorf("%w: transaction %d is nil", errDecode, i)
+		}
+	}
+	return backend.Handle(peer, txs)
+}
+
 // NodeInfo represents a short summary of the `trust` sub-protocol metadata
 // known about the host peer.
 type NodeInfo struct{}
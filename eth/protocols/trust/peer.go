@@ -4,6 +4,7 @@ import (
 	"math/rand"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
 )
@@ -64,3 +65,15 @@ func (p *Peer) RequestRoot(blockNumber uint64, blockHash common.Hash, diffHash c
 		DiffHash:    diffHash,
 	})
 }
+
+// SendPrivateTransactions relays a batch of private transactions to the peer,
+// which must include them only in its own blocks until the expiry block.
+func (p *Peer) SendPrivateTransactions(expiry uint64, txs types.Transactions) error {
+	if p.version < Trust2 {
+		return errPrivateTxUnsupported
+	}
+	return p2p.Send(p.rw, PrivateTransactionsMsg, &PrivateTransactionsPacket{
+		Expiry:       expiry,
+		Transactions: txs,
+	})
+}
@@ -12,6 +12,7 @@ import (
 // Constants to match up protocol versions and messages
 const (
 	Trust1 = 1
+	Trust2 = 2
 )
 
 // ProtocolName is the official short name of the `trust` protocol used during
@@ -20,11 +21,11 @@ const ProtocolName = "trust"
 
 // ProtocolVersions are the supported versions of the `trust` protocol (first
 // is primary).
-var ProtocolVersions = []uint{Trust1}
+var ProtocolVersions = []uint{Trust2, Trust1}
 
 // protocolLengths are the number of implemented message corresponding to
 // different protocol versions.
-var protocolLengths = map[uint]uint64{Trust1: 2}
+var protocolLengths = map[uint]uint64{Trust1: 2, Trust2: 3}
 
 // maxMessageSize is the maximum cap on the size of a protocol message.
 const maxMessageSize = 10 * 1024 * 1024
@@ -32,6 +33,9 @@ const maxMessageSize = 10 * 1024 * 1024
 const (
 	RequestRootMsg = 0x00
 	RespondRootMsg = 0x01
+
+	// Protocol messages overloaded in trust/2
+	PrivateTransactionsMsg = 0x02
 )
 
 var defaultExtra = []byte{0x00}
@@ -40,6 +44,8 @@ var (
 	errMsgTooLarge    = errors.New("message too long")
 	errDecode         = errors.New("invalid message")
 	errInvalidMsgCode = errors.New("invalid message code")
+
+	errPrivateTxUnsupported = errors.New("private transactions not supported by peer")
 )
 
 // Packet represents a p2p message in the `trust` protocol.
@@ -64,8 +70,18 @@ type RootResponsePacket struct {
 	Extra       rlp.RawValue // for extension
 }
 
+// PrivateTransactionsPacket relays private transactions to a trusted peer, which
+// must not propagate them any further and drop them past the expiry block.
+type PrivateTransactionsPacket struct {
+	Expiry       uint64
+	Transactions []*types.Transaction
+}
+
 func (*RootRequestPacket) Name() string { return "RequestRoot" }
 func (*RootRequestPacket) Kind() byte   { return RequestRootMsg }
 
 func (*RootResponsePacket) Name() string { return "RootResponse" }
 func (*RootResponsePacket) Kind() byte   { return RespondRootMsg }
+
+func (*PrivateTransactionsPacket) Name() string { return "PrivateTransactions" }
+func (*PrivateTransactionsPacket) Kind() byte   { return PrivateTransactionsMsg }
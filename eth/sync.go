@@ -38,6 +38,9 @@ func (h *handler) syncTransactions(p *eth.Peer) {
 	var hashes []common.Hash
 	for _, batch := range h.txpool.Pending(txpool.PendingFilter{OnlyPlainTxs: true}) {
 		for _, tx := range batch {
+			if h.txpool.IsPrivate(tx.Hash) {
+				break // Don't leak private transactions, nor gap the following ones
+			}
 			hashes = append(hashes, tx.Hash)
 		}
 	}
@@ -1162,18 +1162,27 @@ func (api *API) traceTx(ctx context.Context, message *core.Message, txctx *Conte
 	// Run the transaction with tracing enabled.
 	if posa, ok := api.backend.Engine().(consensus.PoSA); ok && message.From == vmctx.Coinbase &&
 		posa.IsSystemContract(message.To) && message.GasPrice.Cmp(big.NewInt(0)) == 0 {
+		// Report the balance moves done outside of the EVM as native transfers
+		transfer := func(from common.Address, value *big.Int) {
+			if tracer, ok := tracer.(BalanceTracer); ok && value.Sign() > 0 {
+				tracer.CaptureBalanceTransfer(from, vmctx.Coinbase, value)
+			}
+		}
 		balance := statedb.GetBalance(consensus.SystemAddress)
 		if balance.Cmp(common.U2560) > 0 {
 			statedb.SetBalance(consensus.SystemAddress, uint256.MustFromBig(big.NewInt(0)))
 			statedb.AddBalance(vmctx.Coinbase, balance)
+			transfer(consensus.SystemAddress, balance.ToBig())
 		}
 		if posa.IsTokenomicsDeposit(message.To, message.Data) {
 			statedb.AddBalance(vmctx.Coinbase, uint256.MustFromBig(message.Value))
+			transfer(common.Address{}, message.Value)
 		}
 
 		parent := api.chainContext(ctx).GetHeader(vmctx.GetHash(vmctx.BlockNumber.Uint64()-1), vmctx.BlockNumber.Uint64()-1)
 		if posa.IsPepper8Block(vmctx.Time, parent.Time) {
 			statedb.AddBalance(vmctx.Coinbase, uint256.MustFromBig(posa.GetPepper8MintAmount()))
+			transfer(common.Address{}, posa.GetPepper8MintAmount())
 		}
 	}
 	if isSystemTx {
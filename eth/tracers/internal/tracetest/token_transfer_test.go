@@ -0,0 +1,171 @@
+package tracetest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/tests"
+)
+
+// Tests that the token transfer tracer reports the native and token movements
+// of a transaction, dropping the reverted ones.
+func TestTokenTransferTracer(t *testing.T) {
+	var (
+		token    = common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		reverter = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+		origin   = common.HexToAddress("0x000000000000000000000000000000000000feed")
+		holder   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		system   = common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffffe")
+
+		transfer       = crypto.Keccak256([]byte("Transfer(address,address,uint256)"))
+		transferSingle = crypto.Keccak256([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+		transferBatch  = crypto.Keccak256([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+	)
+	push := func(code []byte, data []byte) []byte {
+		return append(append(code, byte(vm.PUSH1)+byte(len(data)-1)), data...)
+	}
+	mstore := func(code []byte, offset, value byte) []byte {
+		code = push(code, []byte{value})
+		code = push(code, []byte{offset})
+		return append(code, byte(vm.MSTORE))
+	}
+	// emit logs the memory range with the topics, given in event order
+	emit := func(code []byte, size byte, topics ...[]byte) []byte {
+		for i := len(topics) - 1; i >= 0; i-- {
+			code = push(code, topics[i])
+		}
+		code = push(code, []byte{size})
+		code = push(code, []byte{0})
+		return append(code, byte(vm.LOG0)+byte(len(topics)))
+	}
+	call := func(code []byte, to common.Address, value byte) []byte {
+		code = push(code, []byte{0}) // out size
+		code = push(code, []byte{0}) // out offset
+		code = push(code, []byte{0}) // in size
+		code = push(code, []byte{0}) // in offset
+		code = push(code, []byte{value})
+		code = push(code, to.Bytes())
+		return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+	}
+	var code []byte
+	code = mstore(code, 0, 100)
+	code = emit(code, 32, transfer, token.Bytes(), holder.Bytes())           // ERC-20
+	code = emit(code, 0, transfer, holder.Bytes(), token.Bytes(), []byte{7}) // ERC-721
+	code = mstore(code, 32, 3)
+	code = emit(code, 64, transferSingle, origin.Bytes(), token.Bytes(), holder.Bytes()) // ERC-1155 id 100
+	code = mstore(code, 0, 0x40)
+	code = mstore(code, 32, 0x80)
+	code = mstore(code, 64, 1)
+	code = mstore(code, 96, 5)
+	code = mstore(code, 128, 1)
+	code = mstore(code, 160, 9)
+	code = emit(code, 192, transferBatch, origin.Bytes(), holder.Bytes(), token.Bytes()) // ERC-1155 batch
+	code = call(code, holder, 2)
+	code = call(code, reverter, 3)
+
+	var revert []byte
+	revert = mstore(revert, 0, 1)
+	revert = emit(revert, 32, transfer, reverter.Bytes(), holder.Bytes())
+	revert = call(revert, holder, 1)
+	revert = push(revert, []byte{0})
+	revert = push(revert, []byte{0})
+	revert = append(revert, byte(vm.REVERT))
+
+	for _, tc := range []struct {
+		name   string
+		to     common.Address
+		value  int64
+		system bool
+		sweep  int64 // balance moved from the system address to the holder ahead of the transaction
+		want   string
+	}{
+		{
+			name:  "Transfers",
+			to:    token,
+			value: 5,
+			want:  `[{"type":"native","from":"0x000000000000000000000000000000000000feed","to":"0x00000000000000000000000000000000deadbeef","value":"0x5"},{"type":"erc20","token":"0x00000000000000000000000000000000deadbeef","from":"0x00000000000000000000000000000000deadbeef","to":"0x00000000000000000000000000000000000000aa","value":"0x64"},{"type":"erc721","token":"0x00000000000000000000000000000000deadbeef","from":"0x00000000000000000000000000000000000000aa","to":"0x00000000000000000000000000000000deadbeef","tokenId":"0x7"},{"type":"erc1155","token":"0x00000000000000000000000000000000deadbeef","operator":"0x000000000000000000000000000000000000feed","from":"0x00000000000000000000000000000000deadbeef","to":"0x00000000000000000000000000000000000000aa","tokenId":"0x64","value":"0x3"},{"type":"erc1155","token":"0x00000000000000000000000000000000deadbeef","operator":"0x000000000000000000000000000000000000feed","from":"0x00000000000000000000000000000000000000aa","to":"0x00000000000000000000000000000000deadbeef","tokenId":"0x5","value":"0x9"},{"type":"native","from":"0x00000000000000000000000000000000deadbeef","to":"0x00000000000000000000000000000000000000aa","value":"0x2"}]`,
+		},
+		{
+			name:  "Reverted",
+			to:    reverter,
+			value: 5,
+			want:  `[]`,
+		},
+		{
+			name:   "System",
+			to:     holder,
+			value:  5,
+			system: true,
+			want:   `[{"type":"native","from":"0x000000000000000000000000000000000000feed","to":"0x00000000000000000000000000000000000000aa","value":"0x5","system":true}]`,
+		},
+		{
+			name:   "Balances",
+			to:     reverter,
+			value:  5,
+			system: true,
+			sweep:  8,
+			want:   `[{"type":"native","from":"0xfffffffffffffffffffffffffffffffffffffffe","to":"0x00000000000000000000000000000000000000aa","value":"0x8","system":true}]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tracer, err := tracers.DefaultDirectory.New("tokenTransferTracer", nil, nil)
+			if err != nil {
+				t.Fatalf("failed to create token transfer tracer: %v", err)
+			}
+			state := tests.MakePreState(rawdb.NewMemoryDatabase(),
+				types.GenesisAlloc{
+					token:    types.Account{Code: code},
+					reverter: types.Account{Code: revert, Balance: big.NewInt(10)},
+					origin:   types.Account{Balance: big.NewInt(500000000000000)},
+				}, false, rawdb.HashScheme)
+			defer state.Close()
+
+			context := vm.BlockContext{
+				CanTransfer: core.CanTransfer,
+				Transfer:    core.Transfer,
+				BlockNumber: new(big.Int).SetUint64(8000000),
+				Time:        5,
+				Difficulty:  big.NewInt(0x30000),
+				GasLimit:    uint64(6000000),
+			}
+			evm := vm.NewEVM(context, vm.TxContext{Origin: origin, GasPrice: big.NewInt(1)}, state.StateDB, params.MainnetChainConfig, vm.Config{Tracer: tracer})
+			msg := &core.Message{
+				To:        &tc.to,
+				From:      origin,
+				Value:     big.NewInt(tc.value),
+				GasLimit:  200000,
+				GasPrice:  big.NewInt(0),
+				GasFeeCap: big.NewInt(0),
+				GasTipCap: big.NewInt(0),
+			}
+			if tc.sweep > 0 {
+				tracer.(tracers.BalanceTracer).CaptureBalanceTransfer(system, holder, big.NewInt(tc.sweep))
+			}
+			st := core.NewStateTransition(evm, msg, new(core.GasPool).AddGas(msg.GasLimit))
+			if _, err := st.TransitionDb(); err != nil {
+				t.Fatalf("failed to execute transaction: %v", err)
+			}
+			var intrinsicGas uint64
+			if tc.system {
+				intrinsicGas = params.TxGas
+			}
+			tracer.CaptureSystemTxEnd(intrinsicGas)
+
+			res, err := tracer.GetResult()
+			if err != nil {
+				t.Fatalf("failed to retrieve trace result: %v", err)
+			}
+			if string(res) != tc.want {
+				t.Errorf("trace mismatch\n have: %v\n want: %v\n", string(res), tc.want)
+			}
+		})
+	}
+}
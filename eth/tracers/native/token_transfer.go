@@ -0,0 +1,286 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("tokenTransferTracer", newTokenTransferTracer, false)
+}
+
+var (
+	// transferEvent is the topic of the ERC-20 and ERC-721 Transfer events, told
+	// apart by the token id of the latter being indexed.
+	transferEvent = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	// transferSingleEvent and transferBatchEvent are the topics of the ERC-1155
+	// transfer events.
+	transferSingleEvent = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	transferBatchEvent  = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+)
+
+// Kinds of the token movements reported by the tokenTransferTracer.
+const (
+	nativeTransfer  = "native"
+	erc20Transfer   = "erc20"
+	erc721Transfer  = "erc721"
+	erc1155Transfer = "erc1155"
+)
+
+// tokenTransfer is a movement of native currency or of tokens.
+type tokenTransfer struct {
+	Type     string          `json:"type"`
+	Token    *common.Address `json:"token,omitempty"`    // Contract of the token, nil for native transfers
+	Operator *common.Address `json:"operator,omitempty"` // Operator of ERC-1155 transfers
+	From     common.Address  `json:"from"`
+	To       common.Address  `json:"to"`
+	TokenID  *hexutil.Big    `json:"tokenId,omitempty"` // Id of ERC-721 and ERC-1155 tokens
+	Value    *hexutil.Big    `json:"value,omitempty"`   // Amount moved, nil for ERC-721 transfers
+	System   bool            `json:"system,omitempty"`  // Whether the transfer is part of a system transaction
+}
+
+// tokenTransferTracer collects the movements of native currency, including
+// the ones of internal calls, and of ERC-20, ERC-721 and ERC-1155 tokens done by
+// a transaction, in execution order. Movements of reverted calls are dropped.
+//
+// Example:
+//
+//	> debug.traceTransaction("0x5f2c...", {tracer: "tokenTransferTracer"})
+//	[
+//	  {type: "native", from: "0x7a25...", to: "0x3e1b...", value: "0xde0b6b3a7640000"},
+//	  {type: "erc20", token: "0x3506...", from: "0x3e1b...", to: "0x7a25...", value: "0x56bc75e2d63100000"}
+//	]
+type tokenTransferTracer struct {
+	noopTracer
+	balances  []tokenTransfer   // Balance moves done outside of the EVM ahead of the transaction
+	frames    [][]tokenTransfer // Transfers of the call frames being executed
+	interrupt atomic.Bool       // Atomic flag to signal execution interruption
+	reason    error             // Textual reason for the interruption
+}
+
+// newTokenTransferTracer returns a native go tracer which collects the token
+// movements of a tx, and implements vm.EVMLogger.
+func newTokenTransferTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &tokenTransferTracer{frames: make([][]tokenTransfer, 1)}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *tokenTransferTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.frames[0] = t.addValue(nil, from, to, value)
+}
+
+// CaptureBalanceTransfer implements the tracers.BalanceTracer interface, recording
+// the balance moves ahead of a system transaction, which can't be reverted by it.
+func (t *tokenTransferTracer) CaptureBalanceTransfer(from common.Address, to common.Address, value *big.Int) {
+	t.balances = t.addValue(t.balances, from, to, value)
+	t.balances[len(t.balances)-1].System = true
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *tokenTransferTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if err != nil {
+		t.frames[0] = nil
+	}
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *tokenTransferTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// Transfer events carry at least the event, sender and recipient topics
+	if err != nil || (op != vm.LOG3 && op != vm.LOG4) {
+		return
+	}
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+	stackData := scope.Stack.Data()
+	if len(stackData) < 2+int(op-vm.LOG0) {
+		return
+	}
+	var (
+		mStart = stackData[len(stackData)-1]
+		mSize  = stackData[len(stackData)-2]
+		topics = make([]common.Hash, op-vm.LOG0)
+	)
+	for i := range topics {
+		topics[i] = common.Hash(stackData[len(stackData)-3-i].Bytes32())
+	}
+	if topics[0] != transferEvent && topics[0] != transferSingleEvent && topics[0] != transferBatchEvent {
+		return
+	}
+	data, err := tracers.GetMemoryCopyPadded(scope.Memory, int64(mStart.Uint64()), int64(mSize.Uint64()))
+	if err != nil {
+		// mSize was unrealistically large
+		log.Warn("failed to copy log data", "err", err, "tracer", "tokenTransferTracer", "offset", mStart, "size", mSize)
+		return
+	}
+	frame := &t.frames[len(t.frames)-1]
+	*frame = append(*frame, decodeTokenTransfers(scope.Contract.Address(), topics, data)...)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *tokenTransferTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	var frame []tokenTransfer
+	switch typ {
+	case vm.CALL, vm.CREATE, vm.CREATE2, vm.SELFDESTRUCT:
+		// Callcode and delegatecall keep the value within the calling contract
+		frame = t.addValue(frame, from, to, value)
+	}
+	t.frames = append(t.frames, frame)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *tokenTransferTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	size := len(t.frames)
+	if size <= 1 {
+		return
+	}
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+
+	// The transfers of failed calls are reverted along with them
+	if err == nil {
+		t.frames[size-2] = append(t.frames[size-2], frame...)
+	}
+}
+
+// CaptureSystemTxEnd marks the transfers as done by a system transaction, such
+// as the distribution of the block rewards. The intrinsic gas is only refunded
+// to the system transactions.
+func (t *tokenTransferTracer) CaptureSystemTxEnd(intrinsicGas uint64) {
+	if intrinsicGas == 0 {
+		return
+	}
+	for i := range t.frames[0] {
+		t.frames[0][i].System = true
+	}
+}
+
+// GetResult returns the json-encoded list of token transfers, and any error
+// arising from the encoding or forceful termination (via `Stop`).
+func (t *tokenTransferTracer) GetResult() (json.RawMessage, error) {
+	transfers := append(append([]tokenTransfer{}, t.balances...), t.frames[0]...)
+	res, err := json.Marshal(transfers)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *tokenTransferTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// addValue appends the transfer of native currency of a call, if any.
+func (t *tokenTransferTracer) addValue(frame []tokenTransfer, from, to common.Address, value *big.Int) []tokenTransfer {
+	if value == nil || value.Sign() == 0 {
+		return frame
+	}
+	return append(frame, tokenTransfer{
+		Type:  nativeTransfer,
+		From:  from,
+		To:    to,
+		Value: (*hexutil.Big)(new(big.Int).Set(value)),
+	})
+}
+
+// decodeTokenTransfers returns the token transfers reported by a log emitted by
+// the given contract, skipping the logs not following the token standards.
+func decodeTokenTransfers(token common.Address, topics []common.Hash, data []byte) []tokenTransfer {
+	switch {
+	case topics[0] == transferEvent && len(topics) == 3 && len(data) == 32:
+		return []tokenTransfer{{
+			Type:  erc20Transfer,
+			Token: &token,
+			From:  common.BytesToAddress(topics[1][:]),
+			To:    common.BytesToAddress(topics[2][:]),
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(data)),
+		}}
+
+	case topics[0] == transferEvent && len(topics) == 4 && len(data) == 0:
+		return []tokenTransfer{{
+			Type:    erc721Transfer,
+			Token:   &token,
+			From:    common.BytesToAddress(topics[1][:]),
+			To:      common.BytesToAddress(topics[2][:]),
+			TokenID: (*hexutil.Big)(new(big.Int).SetBytes(topics[3][:])),
+		}}
+
+	case topics[0] == transferSingleEvent && len(topics) == 4 && len(data) == 64:
+		operator := common.BytesToAddress(topics[1][:])
+		return []tokenTransfer{{
+			Type:     erc1155Transfer,
+			Token:    &token,
+			Operator: &operator,
+			From:     common.BytesToAddress(topics[2][:]),
+			To:       common.BytesToAddress(topics[3][:]),
+			TokenID:  (*hexutil.Big)(new(big.Int).SetBytes(data[:32])),
+			Value:    (*hexutil.Big)(new(big.Int).SetBytes(data[32:])),
+		}}
+
+	case topics[0] == transferBatchEvent && len(topics) == 4:
+		ids, ok := decodeUintArray(data, 0)
+		if !ok {
+			return nil
+		}
+		values, ok := decodeUintArray(data, 1)
+		if !ok || len(values) != len(ids) {
+			return nil
+		}
+		var (
+			operator  = common.BytesToAddress(topics[1][:])
+			transfers = make([]tokenTransfer, len(ids))
+		)
+		for i := range ids {
+			transfers[i] = tokenTransfer{
+				Type:     erc1155Transfer,
+				Token:    &token,
+				Operator: &operator,
+				From:     common.BytesToAddress(topics[2][:]),
+				To:       common.BytesToAddress(topics[3][:]),
+				TokenID:  (*hexutil.Big)(ids[i]),
+				Value:    (*hexutil.Big)(values[i]),
+			}
+		}
+		return transfers
+	}
+	return nil
+}
+
+// decodeUintArray decodes the ABI encoded uint256 array referenced by the head
+// word at the given index of the data.
+func decodeUintArray(data []byte, index int) ([]*big.Int, bool) {
+	word := func(offset uint64) (*big.Int, bool) {
+		if offset > uint64(len(data)) || uint64(len(data))-offset < 32 {
+			return nil, false
+		}
+		return new(big.Int).SetBytes(data[offset : offset+32]), true
+	}
+	offset, ok := word(uint64(index) * 32)
+	if !ok || !offset.IsUint64() {
+		return nil, false
+	}
+	length, ok := word(offset.Uint64())
+	if !ok || !length.IsUint64() || length.Uint64() > uint64(len(data))/32 {
+		return nil, false
+	}
+	array := make([]*big.Int, length.Uint64())
+	for i := range array {
+		if array[i], ok = word(offset.Uint64() + 32*uint64(i+1)); !ok {
+			return nil, false
+		}
+	}
+	return array, true
+}
@@ -45,6 +45,13 @@ type Tracer interface {
 	Stop(err error)
 }
 
+// BalanceTracer is implemented by tracers reporting the balance moves the node
+// performs outside of the EVM ahead of a system transaction, like sweeping the
+// collected fees to the coinbase. Mints are reported from the zero address.
+type BalanceTracer interface {
+	CaptureBalanceTransfer(from common.Address, to common.Address, value *big.Int)
+}
+
 type ctorFn func(*Context, json.RawMessage) (Tracer, error)
 type jsCtorFn func(string, *Context, json.RawMessage) (Tracer, error)
 
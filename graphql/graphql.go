@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -351,6 +352,46 @@ func (t *Transaction) BlobVersionedHashes(ctx context.Context) *[]common.Hash {
 	return &blobHashes
 }
 
+func (t *Transaction) SystemTx(ctx context.Context) (*bool, error) {
+	kind, err := t.systemTxKind(ctx)
+	if err != nil || kind == nil {
+		return nil, err
+	}
+	isSystem := *kind != consensus.SystemTxNone
+	return &isSystem, nil
+}
+
+func (t *Transaction) SystemTxKind(ctx context.Context) (*string, error) {
+	kind, err := t.systemTxKind(ctx)
+	if err != nil || kind == nil || *kind == consensus.SystemTxNone {
+		return nil, err
+	}
+	ret := string(*kind)
+	return &ret, nil
+}
+
+// systemTxKind classifies the transaction with the consensus engine, returning
+// nil if it is pending or if the engine has no system transactions.
+func (t *Transaction) systemTxKind(ctx context.Context) (*consensus.SystemTxKind, error) {
+	posa, ok := t.r.backend.Engine().(consensus.PoSA)
+	if !ok {
+		return nil, nil
+	}
+	tx, block := t.resolve(ctx)
+	if tx == nil || block == nil {
+		return nil, nil
+	}
+	header, err := block.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kind, err := posa.SystemTransactionKind(tx, header)
+	if err != nil {
+		return nil, err
+	}
+	return &kind, nil
+}
+
 func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
 	tx, block := t.resolve(ctx)
 	if tx == nil {
@@ -1092,6 +1133,143 @@ func (b *Block) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
 	return &ret, nil
 }
 
+func (b *Block) Signer(ctx context.Context) (common.Address, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if posa, ok := b.r.backend.Engine().(consensus.PoSA); ok {
+		return posa.GetSigner(header)
+	}
+	return b.r.backend.Engine().Author(header)
+}
+
+func (b *Block) Finality(ctx context.Context) (*Finality, error) {
+	chain := b.r.backend.Chain()
+	if _, ok := b.r.backend.Engine().(consensus.PoSA); !ok || chain == nil {
+		return nil, nil
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	justified, finalized := chain.CurrentSafeBlock(), chain.CurrentFinalBlock()
+	if justified == nil || finalized == nil {
+		return nil, nil
+	}
+	number := header.Number.Uint64()
+	canonical := chain.GetCanonicalHash(number) == header.Hash()
+	return &Finality{
+		r:              b.r,
+		justified:      canonical && number <= justified.Number.Uint64(),
+		finalized:      canonical && number <= finalized.Number.Uint64(),
+		justifiedBlock: justified,
+		finalizedBlock: finalized,
+	}, nil
+}
+
+func (b *Block) VoteAttestation(ctx context.Context) (*VoteAttestation, error) {
+	posa, ok := b.r.backend.Engine().(consensus.PoSA)
+	if !ok {
+		return nil, nil
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attestation, err := posa.GetVoteAttestation(header)
+	if err != nil || attestation == nil || attestation.Data == nil {
+		return nil, err
+	}
+	return &VoteAttestation{attestation}, nil
+}
+
+func (b *Block) Validators(ctx context.Context) (*[]common.Address, error) {
+	posa, ok := b.r.backend.Engine().(consensus.PoSA)
+	if !ok {
+		return nil, nil
+	}
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := posa.GetEpochValidators(header)
+	if err != nil || validators == nil {
+		return nil, err
+	}
+	return &validators, nil
+}
+
+// Finality represents the fast finality status of a block relative to the
+// current head of the chain.
+type Finality struct {
+	r              *Resolver
+	justified      bool
+	finalized      bool
+	justifiedBlock *types.Header
+	finalizedBlock *types.Header
+}
+
+func (f *Finality) Justified(ctx context.Context) bool {
+	return f.justified
+}
+
+func (f *Finality) Finalized(ctx context.Context) bool {
+	return f.finalized
+}
+
+func (f *Finality) JustifiedBlock(ctx context.Context) *Block {
+	return f.block(f.justifiedBlock)
+}
+
+func (f *Finality) FinalizedBlock(ctx context.Context) *Block {
+	return f.block(f.finalizedBlock)
+}
+
+func (f *Finality) block(header *types.Header) *Block {
+	numberOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), true)
+	return &Block{
+		r:            f.r,
+		numberOrHash: &numberOrHash,
+		hash:         header.Hash(),
+		header:       header,
+	}
+}
+
+// VoteAttestation represents the aggregated vote of the validators justifying
+// a block, carried in the extra data of a later block.
+type VoteAttestation struct {
+	attestation *types.VoteAttestation
+}
+
+func (a *VoteAttestation) VoteAddressSet(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(a.attestation.VoteAddressSet)
+}
+
+func (a *VoteAttestation) AggSignature(ctx context.Context) hexutil.Bytes {
+	return a.attestation.AggSignature[:]
+}
+
+func (a *VoteAttestation) SourceNumber(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(a.attestation.Data.SourceNumber)
+}
+
+func (a *VoteAttestation) SourceHash(ctx context.Context) common.Hash {
+	return a.attestation.Data.SourceHash
+}
+
+func (a *VoteAttestation) TargetNumber(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(a.attestation.Data.TargetNumber)
+}
+
+func (a *VoteAttestation) TargetHash(ctx context.Context) common.Hash {
+	return a.attestation.Data.TargetHash
+}
+
+func (a *VoteAttestation) Extra(ctx context.Context) hexutil.Bytes {
+	return a.attestation.Extra
+}
+
 // BlockFilterCriteria encapsulates criteria passed to a `logs` accessor inside
 // a block.
 type BlockFilterCriteria struct {
@@ -261,6 +261,51 @@ func TestGraphQLBlockSerializationEIP2718(t *testing.T) {
 }
 
 // Tests that a graphQL request is not handled successfully when graphql is not enabled on the specified endpoint
+// Tests that the consensus fields degrade gracefully on engines without fast
+// finality and system transactions.
+func TestConsensusFieldsWithoutPoSA(t *testing.T) {
+	var (
+		key, _   = crypto.GenerateKey()
+		addr     = crypto.PubkeyToAddress(key.PublicKey)
+		coinbase = common.HexToAddress("0xc0ffee")
+
+		genesis = &core.Genesis{
+			Config:     params.AllEthashProtocolChanges,
+			GasLimit:   11500000,
+			Difficulty: common.Big1,
+			Alloc: types.GenesisAlloc{
+				addr: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+			},
+		}
+		signer = types.LatestSigner(genesis.Config)
+		stack  = createNode(t)
+	)
+	defer stack.Close()
+
+	handler, _ := newGQLService(t, stack, false, genesis, 1, func(i int, gen *core.BlockGen) {
+		gen.SetCoinbase(coinbase)
+		tx, _ := types.SignNewTx(key, signer, &types.LegacyTx{To: &common.Address{}, Gas: 100000, GasPrice: big.NewInt(params.InitialBaseFee)})
+		gen.AddTx(tx)
+	})
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+	body := "{block(number: 1) { signer finality { justified } voteAttestation { sourceNumber } validators transactions { systemTx systemTxKind } } }"
+	want := `{"block":{"signer":"0x0000000000000000000000000000000000c0ffee","finality":null,"voteAttestation":null,"validators":null,"transactions":[{"systemTx":null,"systemTxKind":null}]}}`
+
+	res := handler.Schema.Exec(context.Background(), body, "", map[string]interface{}{})
+	if res.Errors != nil {
+		t.Fatalf("failed to execute query: %v", res.Errors)
+	}
+	have, err := json.Marshal(res.Data)
+	if err != nil {
+		t.Fatalf("failed to encode graphql response: %s", err)
+	}
+	if string(have) != want {
+		t.Errorf("response unmatch.\nhave:\n%s\nwant:\n%s", have, want)
+	}
+}
+
 func TestGraphQLHTTPOnSamePort_GQLRequest_Unsuccessful(t *testing.T) {
 	stack := createNode(t)
 	defer stack.Close()
@@ -170,6 +170,13 @@ const schema string = `
         rawReceipt: Bytes!
         # BlobVersionedHashes is a set of hash outputs from the blobs in the transaction.
         blobVersionedHashes: [Bytes32!]
+        # SystemTx is true if the transaction was injected by the consensus engine.
+        # If the transaction is pending or the engine has no system transactions,
+        # this field will be null.
+        systemTx: Boolean
+        # SystemTxKind is the kind of the system transaction, e.g. rewardDistribution.
+        # If the transaction is not a system transaction, this field will be null.
+        systemTxKind: String
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
@@ -279,6 +286,52 @@ const schema string = `
         blobGasUsed: Long
         # ExcessBlobGas is a running total of blob gas consumed in excess of the target, prior to the block.
         excessBlobGas: Long
+        # Signer is the account which sealed this block. Unlike miner, it is
+        # recovered from the block signature.
+        signer: Address!
+        # Finality is the fast finality status of this block. If the consensus
+        # engine has no fast finality, this field will be null.
+        finality: Finality
+        # VoteAttestation is the aggregated vote attestation carried by this block.
+        # If the block carries no attestation, this field will be null.
+        voteAttestation: VoteAttestation
+        # Validators is the validator set elected at this epoch block. If this
+        # block is not an epoch block, this field will be null.
+        validators: [Address!]
+    }
+
+    # Finality is the fast finality status of a block, relative to the current
+    # head of the chain.
+    type Finality {
+        # Justified is true if this block is a canonical block at or below the
+        # latest justified block.
+        justified: Boolean!
+        # Finalized is true if this block is a canonical block at or below the
+        # latest finalized block.
+        finalized: Boolean!
+        # JustifiedBlock is the latest justified block.
+        justifiedBlock: Block!
+        # FinalizedBlock is the latest finalized block.
+        finalizedBlock: Block!
+    }
+
+    # VoteAttestation is the aggregated vote of the validators justifying a block.
+    type VoteAttestation {
+        # VoteAddressSet is the bitset of the validators which voted, indexed in
+        # the order of the validator set.
+        voteAddressSet: Long!
+        # AggSignature is the aggregated BLS signature of the votes.
+        aggSignature: Bytes!
+        # SourceNumber is the number of the latest justified block voted from.
+        sourceNumber: Long!
+        # SourceHash is the hash of the latest justified block voted from.
+        sourceHash: Bytes32!
+        # TargetNumber is the number of the block voted for.
+        targetNumber: Long!
+        # TargetHash is the hash of the block voted for.
+        targetHash: Bytes32!
+        # Extra is reserved for future use.
+        extra: Bytes!
     }
 
     # CallData represents the data associated with a local contract call.
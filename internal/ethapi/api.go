@@ -18,6 +18,7 @@ package ethapi
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -39,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -68,6 +70,17 @@ const UnHealthyTimeout = 5 * time.Second
 // allowed to produce in order to speed up calculations.
 const estimateGasErrorRatio = 0.015
 
+// maxAccountChanges is the maximum number of account changes returned by a
+// single eth_getAccountChanges request.
+const maxAccountChanges = 10000
+
+// defaultAddressTxs and maxAddressTxs are the default and maximum number of
+// transactions returned by a single eth_getTransactionsByAddress request.
+const (
+	defaultAddressTxs = 100
+	maxAddressTxs     = 1000
+)
+
 var errBlobTxNotSupported = errors.New("signing blob transactions not supported")
 
 // EthereumAPI provides an API to access Ethereum related information.
@@ -276,6 +289,46 @@ func (s *TxPoolAPI) Inspect() map[string]map[string]map[string]string {
 	return content
 }
 
+// RPCTransactionStatus is the lifecycle of a transaction as reported by
+// txpool_getTransactionStatus.
+type RPCTransactionStatus struct {
+	Status           string          `json:"status"`
+	Reason           string          `json:"reason,omitempty"`
+	ReplacedBy       *common.Hash    `json:"replacedBy,omitempty"`
+	Time             *hexutil.Uint64 `json:"time,omitempty"`
+	BlockHash        *common.Hash    `json:"blockHash,omitempty"`
+	BlockNumber      *hexutil.Big    `json:"blockNumber,omitempty"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex,omitempty"`
+}
+
+// GetTransactionStatus reports whether a transaction is included in the chain,
+// pending or queued in the pool (and why), or recently replaced or evicted from
+// it (and by which transaction or rule). Nil is returned for unknown hashes.
+func (s *TxPoolAPI) GetTransactionStatus(ctx context.Context, hash common.Hash) (*RPCTransactionStatus, error) {
+	found, _, blockHash, blockNumber, index, err := s.b.GetTransaction(ctx, hash)
+	if found {
+		return &RPCTransactionStatus{
+			Status:           txpool.TxLifecycleIncluded,
+			BlockHash:        &blockHash,
+			BlockNumber:      (*hexutil.Big)(new(big.Int).SetUint64(blockNumber)),
+			TransactionIndex: (*hexutil.Uint64)(&index),
+		}, nil
+	}
+	if lifecycle := s.b.TxPoolLifecycle(hash); lifecycle != nil {
+		seen := hexutil.Uint64(lifecycle.Time.Unix())
+		return &RPCTransactionStatus{
+			Status:     lifecycle.Status,
+			Reason:     lifecycle.Reason,
+			ReplacedBy: lifecycle.ReplacedBy,
+			Time:       &seen,
+		}, nil
+	}
+	if err != nil {
+		return nil, NewTxIndexingError()
+	}
+	return nil, nil
+}
+
 // EthereumAccountAPI provides an API to access accounts managed by this node.
 // It offers only methods that can retrieve accounts.
 type EthereumAccountAPI struct {
@@ -830,10 +883,14 @@ func (s *BlockChainAPI) GetHeaderByHash(ctx context.Context, hash common.Hash) m
 //   - When blockNr is -4 the chain safe block is returned.
 //   - When fullTx is true all transactions in the block are returned, otherwise
 //     only the transaction hash is returned.
-func (s *BlockChainAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+//   - When filter is set only the transactions it matches are returned.
+func (s *BlockChainAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool, filter *BlockTxFilter) (map[string]interface{}, error) {
 	block, err := s.b.BlockByNumber(ctx, number)
 	if block != nil && err == nil {
 		response, err := s.rpcMarshalBlock(ctx, block, true, fullTx)
+		if err == nil && filter != nil {
+			response["transactions"] = filter.apply(response["transactions"].([]interface{}), systemTxKinds(s.b.Engine(), block))
+		}
 		if err == nil && number == rpc.PendingBlockNumber {
 			// Pending blocks need to nil out a few fields
 			for _, field := range []string{"hash", "nonce", "miner"} {
@@ -845,6 +902,46 @@ func (s *BlockChainAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNu
 	return nil, err
 }
 
+// BlockTxFilter selects the transactions of a block returned over RPC by their
+// system transaction classification. Filters are ignored on chains whose engine
+// does not produce system transactions.
+type BlockTxFilter struct {
+	SystemTx      *bool                    `json:"systemTx"`      // Only system (true) or only regular (false) transactions
+	SystemTxKinds []consensus.SystemTxKind `json:"systemTxKinds"` // Only system transactions of the listed kinds
+}
+
+// matches reports whether a transaction of the given kind passes the filter.
+func (f *BlockTxFilter) matches(kind consensus.SystemTxKind) bool {
+	isSystem := kind != consensus.SystemTxNone
+	if f.SystemTx != nil && *f.SystemTx != isSystem {
+		return false
+	}
+	if len(f.SystemTxKinds) == 0 {
+		return true
+	}
+	for _, k := range f.SystemTxKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// apply drops the marshalled transactions not matching the filter, kinds holding
+// the classification of each of them.
+func (f *BlockTxFilter) apply(txs []interface{}, kinds []consensus.SystemTxKind) []interface{} {
+	if kinds == nil {
+		return txs
+	}
+	filtered := make([]interface{}, 0, len(txs))
+	for i, tx := range txs {
+		if f.matches(kinds[i]) {
+			filtered = append(filtered, tx)
+		}
+	}
+	return filtered
+}
+
 // GetBlockByHash returns the requested block. When fullTx is true all transactions in the block are returned in full
 // detail, otherwise only the transaction hash is returned.
 func (s *BlockChainAPI) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error) {
@@ -911,7 +1008,7 @@ func (s *BlockChainAPI) GetFinalizedBlock(ctx context.Context, probabilisticFina
 	}
 	finalizedBlockNumber := max(fastFinalizedHeader.Number.Int64(), latestHeader.Number.Int64()-probabilisticFinalized*int64(currentTurnLength))
 
-	return s.GetBlockByNumber(ctx, rpc.BlockNumber(finalizedBlockNumber), fullTx)
+	return s.GetBlockByNumber(ctx, rpc.BlockNumber(finalizedBlockNumber), fullTx, nil)
 }
 
 // GetUncleByBlockNumberAndIndex returns the uncle block for the given block hash and index.
@@ -1011,6 +1108,7 @@ func (s *BlockChainAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.
 	result := make([]map[string]interface{}, len(receipts))
 	for i, receipt := range receipts {
 		result[i] = marshalReceipt(receipt, block.Hash(), block.NumberU64(), signer, txs[i], i)
+		markSystemTx(result[i], s.b.Engine(), txs[i], block.Header())
 	}
 
 	return result, nil
@@ -1166,6 +1264,38 @@ func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
 	}
 }
 
+// MakeHeader returns a new header object with the overridden fields.
+// Note: MakeHeader ignores BlobBaseFee if set. That's because the header has
+// no such field.
+func (diff *BlockOverrides) MakeHeader(header *types.Header) *types.Header {
+	if diff == nil {
+		return header
+	}
+	h := types.CopyHeader(header)
+	if diff.Number != nil {
+		h.Number = diff.Number.ToInt()
+	}
+	if diff.Difficulty != nil {
+		h.Difficulty = diff.Difficulty.ToInt()
+	}
+	if diff.Time != nil {
+		h.Time = uint64(*diff.Time)
+	}
+	if diff.GasLimit != nil {
+		h.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		h.Coinbase = *diff.Coinbase
+	}
+	if diff.Random != nil {
+		h.MixDigest = *diff.Random
+	}
+	if diff.BaseFee != nil {
+		h.BaseFee = diff.BaseFee.ToInt()
+	}
+	return h
+}
+
 // ChainContextBackend provides methods required to implement ChainContext.
 type ChainContextBackend interface {
 	Engine() consensus.Engine
@@ -1514,6 +1644,49 @@ func (s *BlockChainAPI) GetVerifyResult(ctx context.Context, blockNr rpc.BlockNu
 	return s.b.Chain().GetVerifyResult(uint64(blockNr), blockHash, diffHash)
 }
 
+// AccountChange is a block which changed the balance or nonce of an account,
+// along with the values after the block.
+type AccountChange struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	Balance     *hexutil.Big   `json:"balance"`
+	Nonce       hexutil.Uint64 `json:"nonce"`
+}
+
+// GetAccountChanges returns the blocks in the inclusive range which changed the
+// balance or nonce of the given account, either through transactions or system
+// rewards. It requires the node to index account changes.
+func (s *BlockChainAPI) GetAccountChanges(ctx context.Context, address common.Address, fromBlock rpc.BlockNumber, toBlock rpc.BlockNumber) ([]*AccountChange, error) {
+	if s.b.Chain() == nil {
+		return nil, errors.New("blockchain not support get account changes")
+	}
+	from, err := s.b.HeaderByNumber(ctx, fromBlock)
+	if from == nil || err != nil {
+		return nil, fmt.Errorf("block not found for block number (%d): %v", fromBlock, err)
+	}
+	to, err := s.b.HeaderByNumber(ctx, toBlock)
+	if to == nil || err != nil {
+		return nil, fmt.Errorf("block not found for block number (%d): %v", toBlock, err)
+	}
+	if from.Number.Cmp(to.Number) > 0 {
+		return nil, fmt.Errorf("invalid block range %d-%d", from.Number, to.Number)
+	}
+	entries, err := s.b.Chain().GetAccountChanges(address, from.Number.Uint64(), to.Number.Uint64(), maxAccountChanges)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*AccountChange, 0, len(entries))
+	for _, entry := range entries {
+		changes = append(changes, &AccountChange{
+			BlockNumber: hexutil.Uint64(entry.Number),
+			BlockHash:   entry.Hash,
+			Balance:     (*hexutil.Big)(entry.Balance),
+			Nonce:       hexutil.Uint64(entry.Nonce),
+		})
+	}
+	return changes, nil
+}
+
 // RPCMarshalHeader converts the given header to the RPC output .
 func RPCMarshalHeader(head *types.Header) map[string]interface{} {
 	result := map[string]interface{}{
@@ -1602,6 +1775,15 @@ func (s *BlockChainAPI) rpcMarshalBlock(ctx context.Context, b *types.Block, inc
 	if inclTx {
 		fields["totalDifficulty"] = (*hexutil.Big)(s.b.GetTd(ctx, b.Hash()))
 	}
+	if inclTx && fullTx {
+		if kinds := systemTxKinds(s.b.Engine(), b); kinds != nil {
+			for i, tx := range fields["transactions"].([]interface{}) {
+				if rpcTx, ok := tx.(*RPCTransaction); ok && rpcTx != nil {
+					rpcTx.setSystemTxKind(kinds[i])
+				}
+			}
+		}
+	}
 	return fields, nil
 }
 
@@ -1629,6 +1811,77 @@ type RPCTransaction struct {
 	R                   *hexutil.Big      `json:"r"`
 	S                   *hexutil.Big      `json:"s"`
 	YParity             *hexutil.Uint64   `json:"yParity,omitempty"`
+
+	// Set only on PoSA chains, classifying the engine injected system transactions
+	SystemTx     *bool                  `json:"systemTx,omitempty"`
+	SystemTxKind consensus.SystemTxKind `json:"systemTxKind,omitempty"`
+}
+
+// setSystemTxKind annotates the transaction with its system transaction kind.
+func (tx *RPCTransaction) setSystemTxKind(kind consensus.SystemTxKind) {
+	isSystem := kind != consensus.SystemTxNone
+	tx.SystemTx = &isSystem
+	tx.SystemTxKind = kind
+}
+
+// systemTxKind classifies the transaction included in the block with the given
+// header. The returned flag is false if the engine does not produce system
+// transactions, in which case no classification should be reported.
+func systemTxKind(engine consensus.Engine, tx *types.Transaction, header *types.Header) (consensus.SystemTxKind, bool) {
+	posa, ok := engine.(consensus.PoSA)
+	if !ok {
+		return consensus.SystemTxNone, false
+	}
+	kind, err := posa.SystemTransactionKind(tx, header)
+	if err != nil {
+		return consensus.SystemTxNone, true
+	}
+	return kind, true
+}
+
+// systemTxKinds classifies all transactions of the given block, returning nil if
+// the engine does not produce system transactions.
+func systemTxKinds(engine consensus.Engine, block *types.Block) []consensus.SystemTxKind {
+	if _, ok := engine.(consensus.PoSA); !ok {
+		return nil
+	}
+	txs := block.Transactions()
+	kinds := make([]consensus.SystemTxKind, len(txs))
+	for i, tx := range txs {
+		kinds[i], _ = systemTxKind(engine, tx, block.Header())
+	}
+	return kinds
+}
+
+// withSystemTxKind annotates the RPC representation of a transaction included in
+// the block with the given header with its system transaction classification.
+func withSystemTxKind(engine consensus.Engine, rpcTx *RPCTransaction, tx *types.Transaction, header *types.Header) *RPCTransaction {
+	if rpcTx == nil {
+		return nil
+	}
+	if kind, ok := systemTxKind(engine, tx, header); ok {
+		rpcTx.setSystemTxKind(kind)
+	}
+	return rpcTx
+}
+
+// blockSystemTxKind annotates the transaction at the given index of the block
+// with its system transaction classification.
+func blockSystemTxKind(engine consensus.Engine, rpcTx *RPCTransaction, b *types.Block, index uint64) *RPCTransaction {
+	if txs := b.Transactions(); index < uint64(len(txs)) {
+		return withSystemTxKind(engine, rpcTx, txs[index], b.Header())
+	}
+	return rpcTx
+}
+
+// markSystemTx adds the system transaction classification to a marshalled receipt.
+func markSystemTx(fields map[string]interface{}, engine consensus.Engine, tx *types.Transaction, header *types.Header) {
+	if kind, ok := systemTxKind(engine, tx, header); ok {
+		fields["systemTx"] = kind != consensus.SystemTxNone
+		if kind != consensus.SystemTxNone {
+			fields["systemTxKind"] = kind
+		}
+	}
 }
 
 // newRPCTransaction returns a transaction that will serialize to the RPC
@@ -1885,7 +2138,11 @@ func (s *TransactionAPI) GetBlockTransactionCountByHash(ctx context.Context, blo
 // GetTransactionsByBlockNumber returns all the transactions for the given block number.
 func (s *TransactionAPI) GetTransactionsByBlockNumber(ctx context.Context, blockNr rpc.BlockNumber) []*RPCTransaction {
 	if block, _ := s.b.BlockByNumber(ctx, blockNr); block != nil {
-		return newRPCTransactionsFromBlockIndex(block, s.b.ChainConfig())
+		txs := newRPCTransactionsFromBlockIndex(block, s.b.ChainConfig())
+		for i, tx := range txs {
+			blockSystemTxKind(s.b.Engine(), tx, block, uint64(i))
+		}
+		return txs
 	}
 	return nil
 }
@@ -1893,7 +2150,8 @@ func (s *TransactionAPI) GetTransactionsByBlockNumber(ctx context.Context, block
 // GetTransactionByBlockNumberAndIndex returns the transaction for the given block number and index.
 func (s *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNr rpc.BlockNumber, index hexutil.Uint) *RPCTransaction {
 	if block, _ := s.b.BlockByNumber(ctx, blockNr); block != nil {
-		return newRPCTransactionFromBlockIndex(block, uint64(index), s.b.ChainConfig())
+		tx := newRPCTransactionFromBlockIndex(block, uint64(index), s.b.ChainConfig())
+		return blockSystemTxKind(s.b.Engine(), tx, block, uint64(index))
 	}
 	return nil
 }
@@ -1901,7 +2159,8 @@ func (s *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context
 // GetTransactionByBlockHashAndIndex returns the transaction for the given block hash and index.
 func (s *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context, blockHash common.Hash, index hexutil.Uint) *RPCTransaction {
 	if block, _ := s.b.BlockByHash(ctx, blockHash); block != nil {
-		return newRPCTransactionFromBlockIndex(block, uint64(index), s.b.ChainConfig())
+		tx := newRPCTransactionFromBlockIndex(block, uint64(index), s.b.ChainConfig())
+		return blockSystemTxKind(s.b.Engine(), tx, block, uint64(index))
 	}
 	return nil
 }
@@ -1959,7 +2218,128 @@ func (s *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.H
 	if err != nil {
 		return nil, err
 	}
-	return newRPCTransaction(tx, blockHash, blockNumber, header.Time, index, header.BaseFee, s.b.ChainConfig()), nil
+	rpcTx := newRPCTransaction(tx, blockHash, blockNumber, header.Time, index, header.BaseFee, s.b.ChainConfig())
+	return withSystemTxKind(s.b.Engine(), rpcTx, tx, header), nil
+}
+
+// AddressTransactionsArgs represents the arguments of a paginated query of the
+// transactions of an address.
+type AddressTransactionsArgs struct {
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber `json:"toBlock"`
+	Limit     *hexutil.Uint64  `json:"limit"`
+	Cursor    hexutil.Bytes    `json:"cursor"` // Opaque position to resume from, returned by the previous page
+}
+
+// AddressTransactions is a page of the transactions of an address.
+type AddressTransactions struct {
+	Transactions []*RPCTransaction `json:"transactions"`
+	NextCursor   hexutil.Bytes     `json:"nextCursor"` // Nil if there are no more transactions in the range
+}
+
+// encodeAddressTxCursor encodes the position of a transaction into a cursor.
+func encodeAddressTxCursor(number uint64, index uint64) hexutil.Bytes {
+	cursor := make([]byte, 12)
+	binary.BigEndian.PutUint64(cursor, number)
+	binary.BigEndian.PutUint32(cursor[8:], uint32(index))
+	return cursor
+}
+
+// decodeAddressTxCursor decodes the position of a transaction from a cursor.
+func decodeAddressTxCursor(cursor hexutil.Bytes) (uint64, uint64, error) {
+	if len(cursor) != 12 {
+		return 0, 0, errors.New("invalid cursor")
+	}
+	return binary.BigEndian.Uint64(cursor), uint64(binary.BigEndian.Uint32(cursor[8:])), nil
+}
+
+// GetTransactionsByAddress returns the canonical transactions sent from or to
+// the given address, including the contracts they create, in ascending order.
+// Results are paginated, the cursor of the next page is returned along with the
+// transactions. The range starts at the oldest indexed block by default. It
+// requires the node to index transactions by address.
+//
+// The contracts created by internal CREATE or CREATE2 calls are indexed for the
+// blocks executed by the node. They are not known for the blocks synced without
+// execution, where only the contracts created by the transactions themselves are.
+func (s *TransactionAPI) GetTransactionsByAddress(ctx context.Context, address common.Address, args *AddressTransactionsArgs) (*AddressTransactions, error) {
+	if s.b.Chain() == nil {
+		return nil, errors.New("blockchain not support get transactions by address")
+	}
+	if args == nil {
+		args = new(AddressTransactionsArgs)
+	}
+	var (
+		from, index uint64
+		to          = s.b.CurrentHeader().Number.Uint64()
+		limit       = uint64(defaultAddressTxs)
+	)
+	if tail := rawdb.ReadAddressTxIndexTail(s.b.ChainDb()); tail != nil {
+		from = *tail
+	}
+	if args.FromBlock != nil {
+		header, err := s.b.HeaderByNumber(ctx, *args.FromBlock)
+		if header == nil || err != nil {
+			return nil, fmt.Errorf("block not found for block number (%d): %v", *args.FromBlock, err)
+		}
+		from = header.Number.Uint64()
+	}
+	if args.ToBlock != nil {
+		header, err := s.b.HeaderByNumber(ctx, *args.ToBlock)
+		if header == nil || err != nil {
+			return nil, fmt.Errorf("block not found for block number (%d): %v", *args.ToBlock, err)
+		}
+		to = header.Number.Uint64()
+	}
+	if args.Cursor != nil {
+		number, i, err := decodeAddressTxCursor(args.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if number < from {
+			return nil, fmt.Errorf("cursor block %d before range start %d", number, from)
+		}
+		from, index = number, i
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range %d-%d", from, to)
+	}
+	if args.Limit != nil {
+		if *args.Limit == 0 || *args.Limit > maxAddressTxs {
+			return nil, fmt.Errorf("limit must be between 1 and %d", maxAddressTxs)
+		}
+		limit = uint64(*args.Limit)
+	}
+	// Retrieve one more entry to find out where the next page starts
+	entries, err := s.b.Chain().GetAddressTransactions(address, from, index, to, int(limit)+1)
+	if err != nil {
+		return nil, err
+	}
+	result := &AddressTransactions{Transactions: make([]*RPCTransaction, 0, len(entries))}
+	if uint64(len(entries)) > limit {
+		result.NextCursor = encodeAddressTxCursor(entries[limit].Number, entries[limit].Index)
+		entries = entries[:limit]
+	}
+	headers := make(map[common.Hash]*types.Header)
+	for _, entry := range entries {
+		found, tx, blockHash, blockNumber, txIndex, err := s.b.GetTransaction(ctx, entry.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		header := headers[blockHash]
+		if header == nil {
+			if header, err = s.b.HeaderByHash(ctx, blockHash); header == nil || err != nil {
+				return nil, fmt.Errorf("header %x not found: %v", blockHash, err)
+			}
+			headers[blockHash] = header
+		}
+		rpcTx := newRPCTransaction(tx, blockHash, blockNumber, header.Time, txIndex, header.BaseFee, s.b.ChainConfig())
+		result.Transactions = append(result.Transactions, withSystemTxKind(s.b.Engine(), rpcTx, tx, header))
+	}
+	return result, nil
 }
 
 // GetRawTransactionByHash returns the bytes of the transaction for the given hash.
@@ -2037,6 +2417,7 @@ func (s *TransactionAPI) GetTransactionReceiptsByBlockNumber(ctx context.Context
 		if receipt.ContractAddress != (common.Address{}) {
 			fields["contractAddress"] = receipt.ContractAddress
 		}
+		markSystemTx(fields, s.b.Engine(), tx, block.Header())
 
 		txReceipts = append(txReceipts, fields)
 	}
@@ -2066,6 +2447,7 @@ func (s *TransactionAPI) GetTransactionDataAndReceipt(ctx context.Context, hash
 	}
 	signer := types.MakeSigner(s.b.ChainConfig(), header.Number, header.Time)
 	fields := marshalReceipt(receipt, blockHash, blockNumber, signer, tx, int(index))
+	markSystemTx(fields, s.b.Engine(), tx, header)
 
 	// TODO use nil basefee before landon fork is enabled
 	rpcTransaction := newRPCTransaction(tx, blockHash, blockNumber, header.Time, index, nil, s.b.ChainConfig())
@@ -2117,7 +2499,9 @@ func (s *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.
 
 	// Derive the sender.
 	signer := types.MakeSigner(s.b.ChainConfig(), header.Number, header.Time)
-	return marshalReceipt(receipt, blockHash, blockNumber, signer, tx, int(index)), nil
+	fields := marshalReceipt(receipt, blockHash, blockNumber, signer, tx, int(index))
+	markSystemTx(fields, s.b.Engine(), tx, header)
+	return fields, nil
 }
 
 // marshalReceipt marshals a transaction receipt into a JSON object.
@@ -2318,6 +2702,38 @@ func (s *TransactionAPI) SendRawTransactionConditional(ctx context.Context, inpu
 	return SubmitTransaction(ctx, s.b, tx)
 }
 
+// SendPrivateRawTransaction adds the signed transaction to the transaction pool
+// flagged as private: it is never gossiped to the network, only included by the
+// local validator or relayed to the trusted validator peers. The transaction is
+// dropped once the chain moves past maxBlockNumber, defaulting to a short window
+// above the current head.
+func (s *TransactionAPI) SendPrivateRawTransaction(ctx context.Context, input hexutil.Bytes, maxBlockNumber *hexutil.Uint64) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	head := s.b.CurrentHeader().Number.Uint64()
+
+	expiry := head + txpool.DefaultPrivateTxExpiry
+	if maxBlockNumber != nil {
+		expiry = uint64(*maxBlockNumber)
+	}
+	if expiry <= head {
+		return common.Hash{}, fmt.Errorf("private transaction expiry %d not above current block %d", expiry, head)
+	}
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+		return common.Hash{}, err
+	}
+	if !s.b.UnprotectedAllowed() && !tx.Protected() {
+		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+	if err := s.b.SendPrivateTx(ctx, tx, expiry); err != nil {
+		return common.Hash{}, err
+	}
+	log.Info("Submitted private transaction", "hash", tx.Hash().Hex(), "nonce", tx.Nonce(), "recipient", tx.To(), "expiry", expiry)
+	return tx.Hash(), nil
+}
+
 // Sign calculates an ECDSA signature for:
 // keccak256("\x19Ethereum Signed Message:\n" + len(message) + message).
 //
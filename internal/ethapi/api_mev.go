@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -83,6 +84,23 @@ func (m *MevAPI) BestBidGasFee(_ context.Context, parentHash common.Hash) *big.I
 	return m.b.BestBidGasFee(parentHash)
 }
 
+// BidHistory returns the recently received bids along with their outcome,
+// optionally filtered by builder and block number.
+func (m *MevAPI) BidHistory(_ context.Context, builder *common.Address, blockNumber *hexutil.Uint64) []*types.BidRecord {
+	var number *uint64
+	if blockNumber != nil {
+		n := uint64(*blockNumber)
+		number = &n
+	}
+	return m.b.BidHistory(builder, number)
+}
+
+// BuilderStats returns how many bids each builder sent, and how they fared
+// against the other bids and the local block.
+func (m *MevAPI) BuilderStats(_ context.Context) []*types.BuilderStats {
+	return m.b.BuilderStats()
+}
+
 func (m *MevAPI) Params() *types.MevParams {
 	return m.b.MevParams()
 }
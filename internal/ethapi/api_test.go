@@ -47,6 +47,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -612,6 +613,9 @@ func (b testBackend) SubscribeNewVoteEvent(ch chan<- core.NewVoteEvent) event.Su
 func (b testBackend) SendTx(ctx context.Context, signedTx *types.Transaction) error {
 	panic("implement me")
 }
+func (b testBackend) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, expiry uint64) error {
+	panic("implement me")
+}
 func (b testBackend) GetTransaction(ctx context.Context, txHash common.Hash) (bool, *types.Transaction, common.Hash, uint64, uint64, error) {
 	tx, blockHash, blockNumber, index := rawdb.ReadTransaction(b.db, txHash)
 	return true, tx, blockHash, blockNumber, index, nil
@@ -628,6 +632,7 @@ func (b testBackend) TxPoolContent() (map[common.Address][]*types.Transaction, m
 func (b testBackend) TxPoolContentFrom(addr common.Address) ([]*types.Transaction, []*types.Transaction) {
 	panic("implement me")
 }
+func (b testBackend) TxPoolLifecycle(hash common.Hash) *txpool.TxLifecycle { return nil }
 func (b testBackend) SubscribeNewTxsEvent(events chan<- core.NewTxsEvent) event.Subscription {
 	panic("implement me")
 }
@@ -646,7 +651,8 @@ func (b testBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscripti
 func (b testBackend) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription {
 	panic("implement me")
 }
-func (b testBackend) BloomStatus() (uint64, uint64) { panic("implement me") }
+func (b testBackend) BloomStatus() (uint64, uint64)    { panic("implement me") }
+func (b testBackend) LogIndexStatus() (uint64, uint64) { panic("implement me") }
 func (b testBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
 	panic("implement me")
 }
@@ -668,6 +674,12 @@ func (b *testBackend) BestBidGasFee(parentHash common.Hash) *big.Int {
 	//TODO implement me
 	panic("implement me")
 }
+func (b *testBackend) BidHistory(builder *common.Address, number *uint64) []*types.BidRecord {
+	panic("implement me")
+}
+func (b *testBackend) BuilderStats() []*types.BuilderStats {
+	panic("implement me")
+}
 
 func TestEstimateGas(t *testing.T) {
 	t.Parallel()
@@ -1800,7 +1812,7 @@ func TestRPCGetBlockOrHeader(t *testing.T) {
 				result, err = api.GetHeaderByNumber(context.Background(), tt.blockNumber)
 				rpc = "eth_getHeaderByNumber"
 			} else {
-				result, err = api.GetBlockByNumber(context.Background(), tt.blockNumber, tt.fullTx)
+				result, err = api.GetBlockByNumber(context.Background(), tt.blockNumber, tt.fullTx, nil)
 				rpc = "eth_getBlockByNumber"
 			}
 		}
@@ -2278,6 +2290,38 @@ func TestGetBlobSidecarByTxHash(t *testing.T) {
 	}
 }
 
+func TestBlockTxFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		yes   = true
+		no    = false
+		txs   = []interface{}{"reward", "user", "slash", "pepper8"}
+		kinds = []consensus.SystemTxKind{
+			consensus.SystemTxRewardDistribution,
+			consensus.SystemTxNone,
+			consensus.SystemTxSlash,
+			consensus.SystemTxPepper8Mint,
+		}
+	)
+	tests := []struct {
+		filter BlockTxFilter
+		kinds  []consensus.SystemTxKind
+		want   []interface{}
+	}{
+		{BlockTxFilter{}, kinds, txs},
+		{BlockTxFilter{SystemTx: &yes}, kinds, []interface{}{"reward", "slash", "pepper8"}},
+		{BlockTxFilter{SystemTx: &no}, kinds, []interface{}{"user"}},
+		{BlockTxFilter{SystemTxKinds: []consensus.SystemTxKind{consensus.SystemTxSlash, consensus.SystemTxPepper8Mint}}, kinds, []interface{}{"slash", "pepper8"}},
+		{BlockTxFilter{SystemTx: &no, SystemTxKinds: []consensus.SystemTxKind{consensus.SystemTxSlash}}, kinds, []interface{}{}},
+		// Engines without system transactions leave the block untouched
+		{BlockTxFilter{SystemTx: &yes}, nil, txs},
+	}
+	for i, tt := range tests {
+		require.Equal(t, tt.want, tt.filter.apply(txs, tt.kinds), "test %d", i)
+	}
+}
+
 func testRPCResponseWithFile(t *testing.T, testid int, result interface{}, rpc string, file string) {
 	data, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -0,0 +1,125 @@
+package ethapi
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var (
+	// keccak256("Transfer(address,address,uint256)")
+	transferTopic = common.HexToHash("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	// ERC-7528
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+)
+
+// transfer is a native value transfer recorded as a log, along with the number
+// of logs emitted by the call before it.
+type transfer struct {
+	index int
+	log   *types.Log
+}
+
+// tracer records the native value transfers of the simulated calls as ERC-20
+// like logs emitted by the ERC-7528 address, interleaved with the logs of the
+// calls. Transfers of reverted call frames are dropped.
+type tracer struct {
+	state     *state.StateDB
+	traceLogs bool
+	txHash    common.Hash
+	offset    int        // Logs of the transaction hash emitted by prior calls
+	transfers []transfer // Transfers of the current call
+	frames    []int      // Number of transfers recorded when entering every call frame
+}
+
+func newTracer(state *state.StateDB, traceLogs bool) *tracer {
+	return &tracer{state: state, traceLogs: traceLogs}
+}
+
+// reset prepares the tracer for the next call, sharing the statedb logs of all
+// calls with the same transaction hash.
+func (t *tracer) reset(txHash common.Hash) {
+	t.txHash = txHash
+	t.offset = len(t.state.GetLogs(txHash, 0, common.Hash{}))
+	t.transfers = t.transfers[:0]
+	t.frames = t.frames[:0]
+}
+
+// logs returns the logs of the current call, with the transfers interleaved.
+func (t *tracer) logs() []*types.Log {
+	callLogs := t.state.GetLogs(t.txHash, 0, common.Hash{})[t.offset:]
+	if len(t.transfers) == 0 {
+		return callLogs
+	}
+	logs := make([]*types.Log, 0, len(callLogs)+len(t.transfers))
+	next := 0
+	for i, log := range callLogs {
+		for ; next < len(t.transfers) && t.transfers[next].index <= i; next++ {
+			logs = append(logs, t.transfers[next].log)
+		}
+		logs = append(logs, log)
+	}
+	for ; next < len(t.transfers); next++ {
+		logs = append(logs, t.transfers[next].log)
+	}
+	return logs
+}
+
+func (t *tracer) enter(from, to common.Address, value *big.Int) {
+	t.frames = append(t.frames, len(t.transfers))
+	if !t.traceLogs || value == nil || value.Sign() <= 0 {
+		return
+	}
+	t.transfers = append(t.transfers, transfer{
+		index: len(t.state.GetLogs(t.txHash, 0, common.Hash{})) - t.offset,
+		log: &types.Log{
+			Address: transferAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *tracer) exit(err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	start := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if err != nil {
+		t.transfers = t.transfers[:start]
+	}
+}
+
+func (t *tracer) CaptureTxStart(gasLimit uint64)         {}
+func (t *tracer) CaptureTxEnd(restGas uint64)            {}
+func (t *tracer) CaptureSystemTxEnd(intrinsicGas uint64) {}
+
+func (t *tracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.enter(from, to, value)
+}
+
+func (t *tracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	t.exit(err)
+}
+
+func (t *tracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Delegate calls report the value of their parent, which is not transferred
+	if typ == vm.DELEGATECALL {
+		value = nil
+	}
+	t.enter(from, to, value)
+}
+
+func (t *tracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.exit(err)
+}
+
+func (t *tracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+
+func (t *tracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
@@ -0,0 +1,641 @@
+package ethapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/gopool"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks that can be simulated.
+	maxSimulateBlocks = 256
+
+	// timestampIncrement is the default increment between block timestamps,
+	// unless the chain schedules its blocks with a Parlia period.
+	timestampIncrement = 12
+)
+
+// simBlock is a batch of calls to be simulated sequentially.
+type simBlock struct {
+	BlockOverrides *BlockOverrides
+	StateOverrides *StateOverride
+	Calls          []TransactionArgs
+}
+
+// simCallResult is the result of a simulated call.
+type simCallResult struct {
+	ReturnValue hexutil.Bytes  `json:"returnData"`
+	Logs        []*types.Log   `json:"logs"`
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	Status      hexutil.Uint64 `json:"status"`
+	Error       *callError     `json:"error,omitempty"`
+}
+
+func (r *simCallResult) MarshalJSON() ([]byte, error) {
+	type callResultAlias simCallResult
+	// Marshal logs to be an empty array instead of nil when empty
+	if r.Logs == nil {
+		r.Logs = []*types.Log{}
+	}
+	return json.Marshal((*callResultAlias)(r))
+}
+
+// simOpts are the inputs to eth_simulateV1.
+type simOpts struct {
+	BlockStateCalls        []simBlock
+	TraceTransfers         bool
+	Validation             bool
+	ReturnFullTransactions bool
+
+	// SystemTransactions applies the system transactions the consensus engine
+	// injects at the end of every block, e.g. the Parlia reward distribution.
+	SystemTransactions bool
+}
+
+// simulator is a stateful object that simulates a series of blocks.
+// it is not safe for concurrent use.
+type simulator struct {
+	b              Backend
+	state          *state.StateDB
+	base           *types.Header
+	chainConfig    *params.ChainConfig
+	gp             *core.GasPool
+	traceTransfers bool
+	validate       bool
+	fullTx         bool
+	systemTxs      bool
+}
+
+// execute runs the simulation of a series of blocks.
+func (sim *simulator) execute(ctx context.Context, blocks []simBlock) ([]map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var (
+		cancel  context.CancelFunc
+		timeout = sim.b.RPCEVMTimeout()
+	)
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	// Make sure the context is cancelled when the call has completed
+	// this makes sure resources are cleaned up.
+	defer cancel()
+
+	var err error
+	blocks, err = sim.sanitizeChain(blocks)
+	if err != nil {
+		return nil, err
+	}
+	// Prepare block headers with preliminary fields for the response.
+	headers, err := sim.makeHeaders(blocks)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		results = make([]map[string]interface{}, len(blocks))
+		parent  = sim.base
+	)
+	for bi, block := range blocks {
+		result, senders, err := sim.processBlock(ctx, &block, headers[bi], parent, headers[:bi], timeout)
+		if err != nil {
+			return nil, err
+		}
+		enc := RPCMarshalBlock(result.block, true, sim.fullTx, sim.chainConfig)
+		if sim.fullTx {
+			// The simulated transactions are not signed, report their senders
+			for _, tx := range enc["transactions"].([]interface{}) {
+				if rpcTx, ok := tx.(*RPCTransaction); ok && rpcTx != nil {
+					rpcTx.From = senders[rpcTx.Hash]
+				}
+			}
+		}
+		enc["calls"] = result.calls
+		if sim.systemTxs {
+			enc["systemCalls"] = result.systemCalls
+			if result.systemErr != nil {
+				enc["systemCallsError"] = result.systemErr.Error()
+			}
+		}
+		results[bi] = enc
+
+		headers[bi] = result.block.Header()
+		parent = headers[bi]
+	}
+	return results, nil
+}
+
+// simBlockResult is the outcome of a simulated block.
+type simBlockResult struct {
+	block       *types.Block
+	calls       []simCallResult
+	systemCalls []simCallResult
+	systemErr   error // Reason the system transactions could not be applied
+}
+
+// processBlock simulates the calls of a block, followed by the system
+// transactions of the consensus engine if requested.
+func (sim *simulator) processBlock(ctx context.Context, block *simBlock, header, parent *types.Header, headers []*types.Header, timeout time.Duration) (*simBlockResult, map[common.Hash]common.Address, error) {
+	// Set header fields that depend only on parent block.
+	// Parent hash is needed for evm.GetHashFn to work.
+	header.ParentHash = parent.Hash()
+	if sim.chainConfig.IsLondon(header.Number) {
+		// In non-validation mode base fee is set to 0 if it is not overridden.
+		// This is because it creates an edge case in EVM where gasPrice < baseFee.
+		// Base fee could have been overridden.
+		if header.BaseFee == nil {
+			if sim.validate {
+				header.BaseFee = eip1559.CalcBaseFee(sim.chainConfig, parent)
+			} else {
+				header.BaseFee = big.NewInt(0)
+			}
+		}
+	}
+	if sim.chainConfig.IsCancun(header.Number, header.Time) {
+		var excess uint64
+		if sim.chainConfig.IsCancun(parent.Number, parent.Time) && parent.ExcessBlobGas != nil && parent.BlobGasUsed != nil {
+			excess = eip4844.CalcExcessBlobGas(*parent.ExcessBlobGas, *parent.BlobGasUsed)
+		} else {
+			excess = eip4844.CalcExcessBlobGas(0, 0)
+		}
+		header.ExcessBlobGas = &excess
+	}
+	// The consensus engine picks the validator sealing the block before the
+	// system transactions are simulated.
+	var (
+		chain     consensus.ChainHeaderReader
+		systemErr error
+	)
+	if sim.systemTxs {
+		if chain, systemErr = sim.chain(headers); systemErr == nil {
+			systemErr = sim.prepare(chain, header, block.BlockOverrides)
+		}
+	}
+	blockContext := core.NewEVMBlockContext(header, NewChainContext(ctx, &simBackend{b: sim.b, base: sim.base, headers: headers}), nil)
+	if block.BlockOverrides.BlobBaseFee != nil {
+		blockContext.BlobBaseFee = block.BlockOverrides.BlobBaseFee.ToInt()
+	}
+	// State overrides are applied prior to execution of a block
+	if err := block.StateOverrides.Apply(sim.state); err != nil {
+		return nil, nil, err
+	}
+	var (
+		gasUsed, blobGasUsed uint64
+		txs                  = make([]*types.Transaction, len(block.Calls))
+		receipts             = make([]*types.Receipt, len(block.Calls))
+		senders              = make(map[common.Hash]common.Address)
+		result               = &simBlockResult{calls: make([]simCallResult, len(block.Calls))}
+		tracer               = newTracer(sim.state, sim.traceTransfers)
+		vmConfig             = vm.Config{NoBaseFee: !sim.validate, Tracer: tracer}
+		evm                  = vm.NewEVM(blockContext, vm.TxContext{GasPrice: new(big.Int)}, sim.state, sim.chainConfig, vmConfig)
+	)
+	// Wait for the context to be done and cancel the evm. Even if the
+	// EVM has finished, cancelling may be done (repeatedly)
+	gopool.Submit(func() {
+		<-ctx.Done()
+		evm.Cancel()
+	})
+	for i, call := range block.Calls {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if err := sim.sanitizeCall(&call, sim.state, header, blockContext, &gasUsed); err != nil {
+			return nil, nil, err
+		}
+		tx := call.toTransaction()
+		txs[i] = tx
+		senders[tx.Hash()] = call.from()
+
+		sim.state.SetTxContext(tx.Hash(), i)
+		tracer.reset(tx.Hash())
+
+		msg, err := call.ToMessage(sim.gp.Gas(), header.BaseFee)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg.Nonce = uint64(*call.Nonce)
+		msg.SkipAccountChecks = !sim.validate
+
+		evm.Reset(core.NewEVMTxContext(msg), sim.state)
+		res, err := core.ApplyMessage(evm, msg, sim.gp)
+		if err := sim.state.Error(); err != nil {
+			return nil, nil, err
+		}
+		// If the timer caused an abort, return an appropriate error message
+		if evm.Cancelled() {
+			return nil, nil, fmt.Errorf("execution aborted (timeout = %v)", timeout)
+		}
+		if err != nil {
+			return nil, nil, txValidationError(err)
+		}
+		// Update the state with pending changes.
+		var root []byte
+		if sim.chainConfig.IsByzantium(blockContext.BlockNumber) {
+			sim.state.Finalise(true)
+		} else {
+			root = sim.state.IntermediateRoot(sim.chainConfig.IsEIP158(blockContext.BlockNumber)).Bytes()
+		}
+		gasUsed += res.UsedGas
+		receipts[i] = sim.makeReceipt(evm, msg, res, tx, header.Number, gasUsed, root)
+		blobGasUsed += receipts[i].BlobGasUsed
+
+		callRes := simCallResult{ReturnValue: res.Return(), Logs: tracer.logs(), GasUsed: hexutil.Uint64(res.UsedGas)}
+		if res.Failed() {
+			callRes.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+			switch {
+			case errors.Is(res.Err, vm.ErrExecutionReverted):
+				// If the result contains a revert reason, try to unpack it.
+				revertErr := newRevertError(res.Revert())
+				callRes.Error = &callError{Message: revertErr.Error(), Code: errCodeReverted, Data: revertErr.ErrorData().(string)}
+			case errors.Is(res.Err, vm.ErrNotAllowed):
+				callRes.Error = &callError{Message: res.Err.Error(), Code: errCodeNotAllowed}
+			default:
+				callRes.Error = &callError{Message: res.Err.Error(), Code: errCodeVMError}
+			}
+		} else {
+			callRes.Status = hexutil.Uint64(types.ReceiptStatusSuccessful)
+		}
+		result.calls[i] = callRes
+	}
+	if sim.systemTxs {
+		if systemErr != nil {
+			result.systemErr = systemErr
+		} else {
+			txs, receipts, gasUsed = sim.finalize(chain, header, txs, receipts, gasUsed, senders, result)
+		}
+	}
+	header.Root = sim.state.IntermediateRoot(true)
+	header.GasUsed = gasUsed
+	if sim.chainConfig.IsCancun(header.Number, header.Time) {
+		header.BlobGasUsed = &blobGasUsed
+	}
+	var withdrawals types.Withdrawals
+	if sim.chainConfig.IsShanghai(header.Number, header.Time) {
+		withdrawals = make([]*types.Withdrawal, 0)
+	}
+	result.block = types.NewBlockWithWithdrawals(header, txs, nil, receipts, withdrawals, trie.NewStackTrie(nil))
+	repairLogs(result, header.Number.Uint64())
+	return result, senders, nil
+}
+
+// chain returns the chain the system transactions are simulated against, serving
+// the given simulated headers on top of the ones of the local chain.
+func (sim *simulator) chain(headers []*types.Header) (consensus.ChainHeaderReader, error) {
+	chain := sim.b.Chain()
+	if chain == nil {
+		return nil, errors.New("chain not available")
+	}
+	return &simChain{BlockChain: chain, base: sim.base, headers: headers}, nil
+}
+
+// prepare sets the header fields of the block the consensus engine derives from
+// its parent, unless they are overridden.
+func (sim *simulator) prepare(chain consensus.ChainHeaderReader, header *types.Header, overrides *BlockOverrides) error {
+	engine, ok := sim.b.Engine().(consensus.SystemTxSimulator)
+	if !ok {
+		return nil
+	}
+	prepared := types.CopyHeader(header)
+	if err := engine.PrepareSimulated(chain, prepared); err != nil {
+		return err
+	}
+	if overrides == nil || overrides.Coinbase == nil {
+		header.Coinbase = prepared.Coinbase
+	}
+	if overrides == nil || overrides.Difficulty == nil {
+		header.Difficulty = prepared.Difficulty
+	}
+	return nil
+}
+
+// finalize applies the system transactions of the consensus engine to the block,
+// leaving the state untouched if they can't be applied.
+func (sim *simulator) finalize(chain consensus.ChainHeaderReader, header *types.Header, txs []*types.Transaction, receipts []*types.Receipt, gasUsed uint64,
+	senders map[common.Hash]common.Address, result *simBlockResult) ([]*types.Transaction, []*types.Receipt, uint64) {
+	engine, ok := sim.b.Engine().(consensus.SystemTxSimulator)
+	if !ok {
+		return txs, receipts, gasUsed
+	}
+	var (
+		backup     = sim.state.Copy()
+		allTxs     = append([]*types.Transaction(nil), txs...)
+		allRcpts   = append([]*types.Receipt(nil), receipts...)
+		allGasUsed = gasUsed
+	)
+	if err := engine.FinalizeSimulated(chain, header, sim.state, &allTxs, &allRcpts, &allGasUsed); err != nil {
+		sim.state, result.systemErr = backup, err
+		return txs, receipts, gasUsed
+	}
+	for i, tx := range allTxs[len(txs):] {
+		receipt := allRcpts[len(receipts)+i]
+		senders[tx.Hash()] = header.Coinbase
+		result.systemCalls = append(result.systemCalls, simCallResult{
+			ReturnValue: []byte{},
+			Logs:        receipt.Logs,
+			GasUsed:     hexutil.Uint64(receipt.GasUsed),
+			Status:      hexutil.Uint64(receipt.Status),
+		})
+	}
+	return allTxs, allRcpts, allGasUsed
+}
+
+// makeReceipt creates the receipt of a simulated call.
+func (sim *simulator) makeReceipt(evm *vm.EVM, msg *core.Message, res *core.ExecutionResult, tx *types.Transaction, number *big.Int, cumulativeGasUsed uint64, root []byte) *types.Receipt {
+	receipt := &types.Receipt{Type: tx.Type(), PostState: root, CumulativeGasUsed: cumulativeGasUsed}
+	if res.Failed() {
+		receipt.Status = types.ReceiptStatusFailed
+	} else {
+		receipt.Status = types.ReceiptStatusSuccessful
+	}
+	receipt.TxHash = tx.Hash()
+	receipt.GasUsed = res.UsedGas
+	if tx.Type() == types.BlobTxType {
+		receipt.BlobGasUsed = uint64(len(tx.BlobHashes()) * params.BlobTxBlobGasPerBlob)
+		receipt.BlobGasPrice = evm.Context.BlobBaseFee
+	}
+	// If the transaction created a contract, store the creation address in the receipt.
+	if msg.To == nil {
+		receipt.ContractAddress = crypto.CreateAddress(evm.TxContext.Origin, tx.Nonce())
+	}
+	receipt.Logs = sim.state.GetLogs(tx.Hash(), number.Uint64(), common.Hash{})
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	receipt.BlockNumber = number
+	receipt.TransactionIndex = uint(sim.state.TxIndex())
+	return receipt
+}
+
+// repairLogs updates the block hash and the positions of the logs in the
+// results, once the block hash is known.
+func repairLogs(result *simBlockResult, number uint64) {
+	var (
+		hash  = result.block.Hash()
+		txs   = result.block.Transactions()
+		index uint
+	)
+	repair := func(calls []simCallResult, offset int) {
+		for i := range calls {
+			for _, log := range calls[i].Logs {
+				log.BlockHash = hash
+				log.BlockNumber = number
+				log.TxHash = txs[offset+i].Hash()
+				log.TxIndex = uint(offset + i)
+				log.Index = index
+				index++
+			}
+		}
+	}
+	repair(result.calls, 0)
+	repair(result.systemCalls, len(result.calls))
+}
+
+// sanitizeCall fills in the defaults of a call, checking it fits in the block.
+func (sim *simulator) sanitizeCall(call *TransactionArgs, state *state.StateDB, header *types.Header, blockContext vm.BlockContext, gasUsed *uint64) error {
+	if call.Nonce == nil {
+		nonce := state.GetNonce(call.from())
+		call.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+	// Let the call run wild unless explicitly specified.
+	if call.Gas == nil {
+		remaining := blockContext.GasLimit - *gasUsed
+		call.Gas = (*hexutil.Uint64)(&remaining)
+	}
+	if *gasUsed+uint64(*call.Gas) > blockContext.GasLimit {
+		return &blockGasLimitReachedError{fmt.Sprintf("block gas limit reached: %d >= %d", *gasUsed, blockContext.GasLimit)}
+	}
+	if err := call.callDefaults(sim.gp.Gas(), header.BaseFee, sim.chainConfig.ChainID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// sanitizeChain checks the chain integrity. Specifically it checks that
+// block numbers and timestamp are strictly increasing, setting default values
+// when necessary. Gaps in block numbers are filled with empty blocks.
+// Note: It modifies the block's override object.
+func (sim *simulator) sanitizeChain(blocks []simBlock) ([]simBlock, error) {
+	var (
+		res           = make([]simBlock, 0, len(blocks))
+		base          = sim.base
+		prevNumber    = base.Number
+		prevTimestamp = base.Time
+		increment     = uint64(timestampIncrement)
+	)
+	if sim.chainConfig.Parlia != nil && sim.chainConfig.Parlia.Period > 0 {
+		increment = sim.chainConfig.Parlia.Period
+	}
+	for _, block := range blocks {
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = new(BlockOverrides)
+		}
+		if block.BlockOverrides.Number == nil {
+			n := new(big.Int).Add(prevNumber, big.NewInt(1))
+			block.BlockOverrides.Number = (*hexutil.Big)(n)
+		}
+		diff := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), prevNumber)
+		if diff.Cmp(common.Big0) <= 0 {
+			return nil, &invalidBlockNumberError{fmt.Sprintf("block numbers must be in order: %d <= %d", block.BlockOverrides.Number.ToInt().Uint64(), prevNumber)}
+		}
+		if total := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), base.Number); total.Cmp(big.NewInt(maxSimulateBlocks)) > 0 {
+			return nil, &clientLimitExceededError{message: "too many blocks"}
+		}
+		if diff.Cmp(big.NewInt(1)) > 0 {
+			// Fill the gap with empty blocks.
+			gap := new(big.Int).Sub(diff, big.NewInt(1))
+			// Assign block number to the empty blocks.
+			for i := uint64(0); i < gap.Uint64(); i++ {
+				n := new(big.Int).Add(prevNumber, big.NewInt(int64(i+1)))
+				t := prevTimestamp + increment
+				b := simBlock{BlockOverrides: &BlockOverrides{Number: (*hexutil.Big)(n), Time: (*hexutil.Uint64)(&t)}}
+				prevTimestamp = t
+				res = append(res, b)
+			}
+		}
+		// Only append block after filling a potential gap.
+		prevNumber = block.BlockOverrides.Number.ToInt()
+		var t uint64
+		if block.BlockOverrides.Time == nil {
+			t = prevTimestamp + increment
+			block.BlockOverrides.Time = (*hexutil.Uint64)(&t)
+		} else {
+			t = uint64(*block.BlockOverrides.Time)
+			if t <= prevTimestamp {
+				return nil, &invalidBlockTimestampError{fmt.Sprintf("block timestamps must be in order: %d <= %d", t, prevTimestamp)}
+			}
+		}
+		prevTimestamp = t
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// makeHeaders makes header object with preliminary fields based on a simulated block.
+// Some fields have to be filled post-execution.
+// It assumes blocks are in order and numbers have been validated.
+func (sim *simulator) makeHeaders(blocks []simBlock) ([]*types.Header, error) {
+	var (
+		res    = make([]*types.Header, len(blocks))
+		header = sim.base
+	)
+	for bi, block := range blocks {
+		if block.BlockOverrides == nil || block.BlockOverrides.Number == nil {
+			return nil, errors.New("empty block number")
+		}
+		overrides := block.BlockOverrides
+
+		var withdrawalsHash *common.Hash
+		if sim.chainConfig.IsShanghai(overrides.Number.ToInt(), uint64(*overrides.Time)) {
+			withdrawalsHash = &types.EmptyWithdrawalsHash
+		}
+		var parentBeaconRoot *common.Hash
+		if sim.chainConfig.IsCancun(overrides.Number.ToInt(), uint64(*overrides.Time)) {
+			parentBeaconRoot = &common.Hash{}
+		}
+		// The validator, difficulty and gas limit are inherited from the base block,
+		// the consensus engine picks the validator when simulating system transactions
+		header = overrides.MakeHeader(&types.Header{
+			UncleHash:        types.EmptyUncleHash,
+			ReceiptHash:      types.EmptyReceiptsHash,
+			TxHash:           types.EmptyTxsHash,
+			Coinbase:         header.Coinbase,
+			Difficulty:       header.Difficulty,
+			GasLimit:         header.GasLimit,
+			WithdrawalsHash:  withdrawalsHash,
+			ParentBeaconRoot: parentBeaconRoot,
+		})
+		res[bi] = header
+	}
+	return res, nil
+}
+
+// simBackend serves the headers of the simulated blocks to the BLOCKHASH opcode,
+// along with the ones of the chain up to the base block.
+type simBackend struct {
+	b       ChainContextBackend
+	base    *types.Header
+	headers []*types.Header
+}
+
+func (b *simBackend) Engine() consensus.Engine {
+	return b.b.Engine()
+}
+
+func (b *simBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if uint64(number) == b.base.Number.Uint64() {
+		return b.base, nil
+	}
+	if uint64(number) < b.base.Number.Uint64() {
+		// Resolve canonical header.
+		return b.b.HeaderByNumber(ctx, number)
+	}
+	// Simulated block.
+	for _, header := range b.headers {
+		if header.Number.Uint64() == uint64(number) {
+			return header, nil
+		}
+	}
+	return nil, errors.New("header not found")
+}
+
+// simChain serves the headers of the simulated blocks to the consensus engine,
+// along with the ones of the local chain.
+type simChain struct {
+	*core.BlockChain
+	base    *types.Header
+	headers []*types.Header
+}
+
+func (c *simChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	for _, header := range c.headers {
+		if header.Number.Uint64() == number && header.Hash() == hash {
+			return header
+		}
+	}
+	return c.BlockChain.GetHeader(hash, number)
+}
+
+func (c *simChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return c.BlockChain.GetHeaderByHash(hash)
+}
+
+func (c *simChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number == c.base.Number.Uint64() {
+		return c.base
+	}
+	if number < c.base.Number.Uint64() {
+		return c.BlockChain.GetHeaderByNumber(number)
+	}
+	for _, header := range c.headers {
+		if header.Number.Uint64() == number {
+			return header
+		}
+	}
+	return nil
+}
+
+// SimulateV1 executes series of transactions on top of a base state.
+// The transactions are packed into blocks. For each block, block header
+// fields can be overridden. The state can also be overridden prior to
+// execution of each block. The system transactions of the consensus engine
+// are applied at the end of every block if requested, and the Chiliz EVM hooks
+// apply to all calls as they do on chain.
+//
+// Note, this function doesn't make any changes in the state/blockchain and is
+// useful to execute and retrieve values.
+func (s *BlockChainAPI) SimulateV1(ctx context.Context, opts simOpts, blockNrOrHash *rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, &invalidParamsError{message: "empty input"}
+	} else if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, &clientLimitExceededError{message: "too many blocks"}
+	}
+	if blockNrOrHash == nil {
+		n := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &n
+	}
+	state, base, err := s.b.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	gasCap := s.b.RPCGasCap()
+	if gasCap == 0 {
+		gasCap = math.MaxUint64
+	}
+	sim := &simulator{
+		b:           s.b,
+		state:       state,
+		base:        base,
+		chainConfig: s.b.ChainConfig(),
+		// Each tx and all the series of txes shouldn't consume more gas than cap
+		gp:             new(core.GasPool).AddGas(gasCap),
+		traceTransfers: opts.TraceTransfers,
+		validate:       opts.Validation,
+		fullTx:         opts.ReturnFullTransactions,
+		systemTxs:      opts.SystemTransactions,
+	}
+	return sim.execute(ctx, opts.BlockStateCalls)
+}
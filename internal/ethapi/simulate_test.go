@@ -0,0 +1,220 @@
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestSimulateSanitizeBlockOrder(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		number    uint64
+		timestamp uint64
+	}
+	for i, tc := range []struct {
+		baseNumber    int
+		baseTimestamp uint64
+		blocks        []simBlock
+		expected      []result
+		err           string
+	}{
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{}, {}, {}},
+			expected:      []result{{number: 11, timestamp: 62}, {number: 12, timestamp: 74}, {number: 13, timestamp: 86}},
+		},
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{BlockOverrides: &BlockOverrides{Number: newInt(13), Time: newUint64(80)}}, {}},
+			expected:      []result{{number: 11, timestamp: 62}, {number: 12, timestamp: 74}, {number: 13, timestamp: 80}, {number: 14, timestamp: 92}},
+		},
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{BlockOverrides: &BlockOverrides{Number: newInt(11)}}, {BlockOverrides: &BlockOverrides{Number: newInt(14)}}, {}},
+			expected:      []result{{number: 11, timestamp: 62}, {number: 12, timestamp: 74}, {number: 13, timestamp: 86}, {number: 14, timestamp: 98}, {number: 15, timestamp: 110}},
+		},
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{BlockOverrides: &BlockOverrides{Number: newInt(13)}}, {BlockOverrides: &BlockOverrides{Number: newInt(12)}}},
+			err:           "block numbers must be in order: 12 <= 13",
+		},
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{BlockOverrides: &BlockOverrides{Number: newInt(13), Time: newUint64(74)}}},
+			err:           "block timestamps must be in order: 74 <= 74",
+		},
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{BlockOverrides: &BlockOverrides{Number: newInt(11), Time: newUint64(60)}}, {BlockOverrides: &BlockOverrides{Number: newInt(12), Time: newUint64(55)}}},
+			err:           "block timestamps must be in order: 55 <= 60",
+		},
+		{
+			baseNumber:    10,
+			baseTimestamp: 50,
+			blocks:        []simBlock{{BlockOverrides: &BlockOverrides{Number: newInt(11), Time: newUint64(60)}}, {BlockOverrides: &BlockOverrides{Number: newInt(13), Time: newUint64(72)}}},
+			err:           "block timestamps must be in order: 72 <= 72",
+		},
+	} {
+		sim := &simulator{
+			base:        &types.Header{Number: big.NewInt(int64(tc.baseNumber)), Time: tc.baseTimestamp},
+			chainConfig: params.TestChainConfig,
+		}
+		res, err := sim.sanitizeChain(tc.blocks)
+		if err != nil {
+			if err.Error() == tc.err {
+				continue
+			} else {
+				t.Fatalf("testcase %d: error mismatch. Want '%s', have '%s'", i, tc.err, err.Error())
+			}
+		}
+		if err == nil && tc.err != "" {
+			t.Fatalf("testcase %d: expected err", i)
+		}
+		if len(res) != len(tc.expected) {
+			t.Errorf("testcase %d: mismatch number of blocks. Want %d, have %d", i, len(tc.expected), len(res))
+		}
+		for bi, b := range res {
+			if b.BlockOverrides == nil {
+				t.Fatalf("testcase %d: block overrides nil", i)
+			}
+			if b.BlockOverrides.Number == nil {
+				t.Fatalf("testcase %d: block number not set", i)
+			}
+			if b.BlockOverrides.Time == nil {
+				t.Fatalf("testcase %d: block time not set", i)
+			}
+			if uint64(*b.BlockOverrides.Time) != tc.expected[bi].timestamp {
+				t.Errorf("testcase %d: block timestamp mismatch. Want %d, have %d", i, tc.expected[bi].timestamp, uint64(*b.BlockOverrides.Time))
+			}
+			have := b.BlockOverrides.Number.ToInt().Uint64()
+			if have != tc.expected[bi].number {
+				t.Errorf("testcase %d: block number mismatch. Want %d, have %d", i, tc.expected[bi].number, have)
+			}
+		}
+	}
+}
+
+// Tests that calls are simulated over several blocks, gaps filled with empty
+// blocks, and value transfers reported as logs unless reverted.
+func TestSimulateV1(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accounts = newAccounts(3)
+		genesis  = &core.Genesis{
+			Config: params.MergedTestChainConfig,
+			Alloc: types.GenesisAlloc{
+				accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+			},
+		}
+		reverter = common.HexToAddress("0xc0ffee")
+		value    = (*hexutil.Big)(big.NewInt(1000))
+	)
+	api := NewBlockChainAPI(newTestBackend(t, 2, genesis, beacon.New(ethash.NewFaker()), func(i int, b *core.BlockGen) {
+		b.SetPoS()
+	}))
+	revertCode := hexutil.Bytes(common.FromHex("0x60006000fd")) // revert(0, 0)
+	opts := simOpts{
+		TraceTransfers: true,
+		BlockStateCalls: []simBlock{
+			{
+				StateOverrides: &StateOverride{reverter: OverrideAccount{Code: &revertCode}},
+				Calls: []TransactionArgs{
+					{From: &accounts[0].addr, To: &accounts[1].addr, Value: value},
+					{From: &accounts[0].addr, To: &reverter, Value: value},
+				},
+			},
+			{
+				BlockOverrides: &BlockOverrides{Number: newInt(5)},
+				Calls: []TransactionArgs{
+					{From: &accounts[1].addr, To: &accounts[2].addr, Value: value},
+				},
+			},
+		},
+	}
+	latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	results, err := api.SimulateV1(context.Background(), opts, &latest)
+	if err != nil {
+		t.Fatalf("simulation failed: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("block count mismatch: have %d, want %d", len(results), 3)
+	}
+	parent := api.b.CurrentHeader().Hash()
+	for i, block := range results {
+		if number := block["number"].(*hexutil.Big).ToInt().Uint64(); number != uint64(3+i) {
+			t.Errorf("block %d: number mismatch: have %d, want %d", i, number, 3+i)
+		}
+		if block["parentHash"].(common.Hash) != parent {
+			t.Errorf("block %d: parent hash mismatch", i)
+		}
+		if _, ok := block["systemCalls"]; ok {
+			t.Errorf("block %d: unexpected system calls", i)
+		}
+		parent = block["hash"].(common.Hash)
+	}
+	// The first call transfers value, while the transfer of the second one reverts
+	calls := results[0]["calls"].([]simCallResult)
+	if len(calls) != 2 {
+		t.Fatalf("call count mismatch: have %d, want %d", len(calls), 2)
+	}
+	if calls[0].Status != hexutil.Uint64(types.ReceiptStatusSuccessful) || len(calls[0].Logs) != 1 {
+		t.Fatalf("transfer mismatch: status %d, %d logs", calls[0].Status, len(calls[0].Logs))
+	}
+	log := calls[0].Logs[0]
+	if log.Address != transferAddress || log.Topics[0] != transferTopic ||
+		log.Topics[1] != common.BytesToHash(accounts[0].addr.Bytes()) || log.Topics[2] != common.BytesToHash(accounts[1].addr.Bytes()) {
+		t.Errorf("transfer log mismatch: %+v", log)
+	}
+	if new(big.Int).SetBytes(log.Data).Cmp(value.ToInt()) != 0 || log.BlockHash != results[0]["hash"].(common.Hash) {
+		t.Errorf("transfer log mismatch: %+v", log)
+	}
+	if calls[1].Status != hexutil.Uint64(types.ReceiptStatusFailed) || len(calls[1].Logs) != 0 || calls[1].Error == nil || calls[1].Error.Code != errCodeReverted {
+		t.Errorf("reverted call mismatch: %+v", calls[1])
+	}
+	// The gap is filled with an empty block, and the state carried over
+	if calls := results[1]["calls"].([]simCallResult); len(calls) != 0 {
+		t.Errorf("gap block has %d calls", len(calls))
+	}
+	if calls := results[2]["calls"].([]simCallResult); len(calls) != 1 || calls[0].Status != hexutil.Uint64(types.ReceiptStatusSuccessful) || len(calls[0].Logs) != 1 {
+		t.Errorf("last block calls mismatch: %+v", calls)
+	}
+	// Validation rejects the transfers of unfunded accounts
+	feeCap := (*hexutil.Big)(big.NewInt(params.Ether))
+	opts = simOpts{
+		Validation: true,
+		BlockStateCalls: []simBlock{{
+			Calls: []TransactionArgs{{From: &accounts[2].addr, To: &accounts[1].addr, Value: value, MaxFeePerGas: feeCap}},
+		}},
+	}
+	var txErr *invalidTxError
+	if _, err := api.SimulateV1(context.Background(), opts, &latest); !errors.As(err, &txErr) || txErr.Code != errCodeInsufficientFunds {
+		t.Errorf("validation error mismatch: %v", err)
+	}
+}
+
+func newInt(n int64) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(n))
+}
+
+func newUint64(v uint64) *hexutil.Uint64 {
+	return (*hexutil.Uint64)(&v)
+}
@@ -0,0 +1,189 @@
+package miner
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// maxBidHistory is the number of received bids remembered by the bid history.
+const maxBidHistory = 4096
+
+// bidEntry is a recorded bid along with the statuses it went through, so that
+// the builder statistics count each status once per bid.
+type bidEntry struct {
+	record types.BidRecord
+	seen   map[string]bool
+}
+
+// bidHistory is a bounded ring of the received bids and their outcome, along
+// with the statistics of every builder.
+type bidHistory struct {
+	mu       sync.RWMutex
+	entries  map[common.Hash]*bidEntry
+	order    []common.Hash // Ring of the recorded bid hashes, in arrival order
+	next     int           // Next position of the ring to overwrite once full
+	size     int           // Maximum number of bids recorded
+	builders map[common.Address]*types.BuilderStats
+}
+
+func newBidHistory(size int) *bidHistory {
+	return &bidHistory{
+		entries:  make(map[common.Hash]*bidEntry),
+		size:     size,
+		builders: make(map[common.Address]*types.BuilderStats),
+	}
+}
+
+// received records a new bid, overwriting the oldest one if the history is full,
+// and reports whether the bid was new. Bids recommitted for simulation are
+// already known and left untouched.
+func (h *bidHistory) received(bid *types.Bid) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	hash := bid.Hash()
+	if _, ok := h.entries[hash]; ok {
+		return false
+	}
+	if len(h.order) < h.size {
+		h.order = append(h.order, hash)
+	} else {
+		delete(h.entries, h.order[h.next])
+		h.order[h.next] = hash
+		h.next = (h.next + 1) % h.size
+	}
+	entry := &bidEntry{
+		record: types.BidRecord{
+			Hash:        hash,
+			Builder:     bid.Builder,
+			BlockNumber: hexutil.Uint64(bid.BlockNumber),
+			ParentHash:  bid.ParentHash,
+			Received:    time.Now(),
+			Txs:         len(bid.Txs),
+			GasUsed:     hexutil.Uint64(bid.GasUsed),
+			GasFee:      (*hexutil.Big)(bid.GasFee),
+			BuilderFee:  (*hexutil.Big)(bid.BuilderFee),
+		},
+		seen: make(map[string]bool),
+	}
+	h.entries[hash] = entry
+	h.stats(bid.Builder).Received++
+	metrics.GetOrRegisterCounter(fmt.Sprintf("bid/received/%v", bid.Builder), nil).Inc(1)
+	return true
+}
+
+// annotate applies fn to a recorded bid, leaving its status untouched.
+func (h *bidHistory) annotate(hash common.Hash, fn func(record *types.BidRecord)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if entry, ok := h.entries[hash]; ok {
+		fn(&entry.record)
+	}
+}
+
+// update applies a status change to a recorded bid, counting it in the builder
+// statistics the first time the bid reaches the status.
+func (h *bidHistory) update(hash common.Hash, status string, fn func(record *types.BidRecord)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entry, ok := h.entries[hash]
+	if !ok {
+		return
+	}
+	entry.record.Status = status
+	if fn != nil {
+		fn(&entry.record)
+	}
+	if entry.seen[status] {
+		return
+	}
+	entry.seen[status] = true
+
+	stats := h.stats(entry.record.Builder)
+	switch status {
+	case types.BidStatusDiscarded:
+		stats.Discarded++
+	case types.BidStatusRejected:
+		stats.Rejected++
+	case types.BidStatusBest:
+		stats.Best++
+	case types.BidStatusWon:
+		stats.Won++
+		if reward := entry.record.PackedBlockReward; reward != nil {
+			stats.WonBlockReward = (*hexutil.Big)(new(big.Int).Add(stats.WonBlockReward.ToInt(), reward.ToInt()))
+		}
+		if reward := entry.record.PackedValidatorReward; reward != nil {
+			stats.WonValidatorReward = (*hexutil.Big)(new(big.Int).Add(stats.WonValidatorReward.ToInt(), reward.ToInt()))
+		}
+	case types.BidStatusLost:
+		stats.Lost++
+	default:
+		return
+	}
+	metrics.GetOrRegisterCounter(fmt.Sprintf("bid/%s/%v", status, entry.record.Builder), nil).Inc(1)
+}
+
+// stats returns the statistics of a builder, creating them if needed. The lock
+// must be held by the caller.
+func (h *bidHistory) stats(builder common.Address) *types.BuilderStats {
+	stats, ok := h.builders[builder]
+	if !ok {
+		stats = &types.BuilderStats{
+			Builder:            builder,
+			WonBlockReward:     new(hexutil.Big),
+			WonValidatorReward: new(hexutil.Big),
+		}
+		h.builders[builder] = stats
+	}
+	return stats
+}
+
+// bids returns the recorded bids, optionally filtered by builder and block
+// number, in arrival order.
+func (h *bidHistory) bids(builder *common.Address, number *uint64) []*types.BidRecord {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	records := make([]*types.BidRecord, 0, len(h.order))
+	for i := range h.order {
+		// Once full, the oldest bid is the next one to overwrite
+		entry := h.entries[h.order[(h.next+i)%len(h.order)]]
+		if builder != nil && entry.record.Builder != *builder {
+			continue
+		}
+		if number != nil && uint64(entry.record.BlockNumber) != *number {
+			continue
+		}
+		record := entry.record
+		records = append(records, &record)
+	}
+	return records
+}
+
+// builderStats returns the statistics of all the builders which sent bids,
+// sorted by address.
+func (h *bidHistory) builderStats() []*types.BuilderStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	stats := make([]*types.BuilderStats, 0, len(h.builders))
+	for _, s := range h.builders {
+		cpy := *s
+		stats = append(stats, &cpy)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return bytes.Compare(stats[i].Builder[:], stats[j].Builder[:]) < 0
+	})
+	return stats
+}
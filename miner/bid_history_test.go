@@ -0,0 +1,99 @@
+package miner
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newHistoryBid(t *testing.T, builder common.Address, number uint64, fee int64) *types.Bid {
+	args := &types.BidArgs{
+		RawBid: &types.RawBid{
+			BlockNumber: number,
+			GasUsed:     21000,
+			GasFee:      big.NewInt(fee),
+		},
+	}
+	bid, err := args.ToBid(builder, types.HomesteadSigner{})
+	if err != nil {
+		t.Fatalf("failed to create bid: %v", err)
+	}
+	return bid
+}
+
+// Tests that the bid history keeps the most recent bids, and counts every bid
+// status once in the builder statistics.
+func TestBidHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		history  = newBidHistory(3)
+		builder1 = common.HexToAddress("0x1")
+		builder2 = common.HexToAddress("0x2")
+		bids     = []*types.Bid{
+			newHistoryBid(t, builder1, 1, 1),
+			newHistoryBid(t, builder2, 1, 2),
+			newHistoryBid(t, builder1, 2, 3),
+			newHistoryBid(t, builder2, 2, 4),
+		}
+	)
+	for _, bid := range bids {
+		history.received(bid)
+	}
+	// Receiving a known bid again must not count it twice
+	history.received(bids[3])
+
+	// The oldest bid is overwritten once the history is full
+	records := history.bids(nil, nil)
+	if len(records) != 3 {
+		t.Fatalf("history size mismatch: have %d, want %d", len(records), 3)
+	}
+	for i, record := range records {
+		if want := bids[i+1].Hash(); record.Hash != want {
+			t.Errorf("record %d: hash mismatch: have %x, want %x", i, record.Hash, want)
+		}
+	}
+	number := uint64(2)
+	if records := history.bids(&builder1, &number); len(records) != 1 || records[0].Hash != bids[2].Hash() {
+		t.Errorf("filtered history mismatch: have %d records", len(records))
+	}
+	// Walk a bid through its lifecycle, reaching some statuses more than once
+	reward := (*hexutil.Big)(big.NewInt(100))
+	history.update(bids[2].Hash(), types.BidStatusPending, nil)
+	history.update(bids[2].Hash(), types.BidStatusBest, func(record *types.BidRecord) {
+		record.PackedBlockReward, record.PackedValidatorReward = reward, reward
+	})
+	history.update(bids[2].Hash(), types.BidStatusPending, nil)
+	history.update(bids[2].Hash(), types.BidStatusBest, nil)
+	history.update(bids[2].Hash(), types.BidStatusWon, func(record *types.BidRecord) {
+		record.LocalBlockReward = (*hexutil.Big)(big.NewInt(50))
+	})
+	history.update(bids[3].Hash(), types.BidStatusRejected, func(record *types.BidRecord) {
+		record.Reason = "invalid tx in bid"
+	})
+	// Updating a bid dropped from the history is a noop
+	history.update(bids[0].Hash(), types.BidStatusLost, nil)
+
+	if records := history.bids(&builder2, &number); records[0].Status != types.BidStatusRejected || records[0].Reason != "invalid tx in bid" {
+		t.Errorf("rejected bid mismatch: status %s, reason %q", records[0].Status, records[0].Reason)
+	}
+	stats := history.builderStats()
+	if len(stats) != 2 {
+		t.Fatalf("builder count mismatch: have %d, want %d", len(stats), 2)
+	}
+	want := []types.BuilderStats{
+		{Builder: builder1, Received: 2, Best: 1, Won: 1, WonBlockReward: reward, WonValidatorReward: reward},
+		{Builder: builder2, Received: 2, Rejected: 1, WonBlockReward: new(hexutil.Big), WonValidatorReward: new(hexutil.Big)},
+	}
+	for i, have := range stats {
+		if have.Builder != want[i].Builder || have.Received != want[i].Received || have.Rejected != want[i].Rejected ||
+			have.Best != want[i].Best || have.Won != want[i].Won || have.Lost != want[i].Lost ||
+			have.WonBlockReward.ToInt().Cmp(want[i].WonBlockReward.ToInt()) != 0 ||
+			have.WonValidatorReward.ToInt().Cmp(want[i].WonValidatorReward.ToInt()) != 0 {
+			t.Errorf("builder %d: stats mismatch: have %+v, want %+v", i, *have, want[i])
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/bidutil"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
@@ -34,7 +35,10 @@ const (
 )
 
 var (
-	bidSimTimer = metrics.NewRegisteredTimer("bid/sim/duration", nil)
+	bidSimTimer            = metrics.NewRegisteredTimer("bid/sim/duration", nil)
+	bidUnregisteredCounter = metrics.NewRegisteredCounter("bid/unregistered", nil)
+
+	errBetterBidArrived = errors.New("simulation abort due to better bid arrived")
 )
 
 var (
@@ -114,6 +118,8 @@ type bidSimulator struct {
 
 	simBidMu      sync.RWMutex
 	simulatingBid map[common.Hash]*BidRuntime // prevBlockHash -> bidRuntime, in the process of simulation
+
+	history *bidHistory // received bids and their outcome, along with the builder statistics
 }
 
 func newBidSimulator(
@@ -142,6 +148,7 @@ func newBidSimulator(
 		pending:       make(map[uint64]map[common.Address]map[common.Hash]struct{}),
 		bestBid:       make(map[common.Hash]*BidRuntime),
 		simulatingBid: make(map[common.Hash]*BidRuntime),
+		history:       newBidHistory(maxBidHistory),
 	}
 
 	b.chainHeadSub = b.chain.SubscribeChainHeadEvent(b.chainHeadCh)
@@ -262,6 +269,9 @@ func (b *bidSimulator) SetBestBid(prevBlockHash common.Hash, bid *BidRuntime) {
 	if last != nil && last.env != nil {
 		last.env.discard()
 	}
+	if last != nil && last.bid.Hash() != bid.bid.Hash() {
+		b.history.update(last.bid.Hash(), types.BidStatusOutbid, nil)
+	}
 
 	b.bestBid[prevBlockHash] = bid
 }
@@ -331,6 +341,7 @@ func (b *bidSimulator) newBidLoop() {
 		interruptCh = make(chan int32, 1)
 		select {
 		case b.simBidCh <- &simBidReq{interruptCh: interruptCh, bid: bidRuntime}:
+			b.recordBid(bidRuntime, types.BidStatusPending, nil)
 			log.Debug("BidSimulator: commit", "builder", bidRuntime.bid.Builder, "bidHash", bidRuntime.bid.Hash().Hex())
 		case <-b.exitCh:
 			return
@@ -348,9 +359,16 @@ func (b *bidSimulator) newBidLoop() {
 				continue
 			}
 
+			// Bids without feedback are recommitted for simulation, and already recorded
+			if newBid.feedback != nil {
+				b.history.received(newBid.bid)
+			}
 			bidRuntime, err := newBidRuntime(newBid.bid, b.config.ValidatorCommission)
 			if err != nil {
 				if newBid.feedback != nil {
+					b.history.update(newBid.bid.Hash(), types.BidStatusRejected, func(record *types.BidRecord) {
+						record.Reason = err.Error()
+					})
 					newBid.feedback <- err
 				}
 				continue
@@ -376,6 +394,11 @@ func (b *bidSimulator) newBidLoop() {
 			}
 
 			if newBid.feedback != nil {
+				if replyErr != nil {
+					b.history.update(newBid.bid.Hash(), types.BidStatusDiscarded, func(record *types.BidRecord) {
+						record.Reason = replyErr.Error()
+					})
+				}
 				newBid.feedback <- replyErr
 
 				log.Info("[BID ARRIVED]",
@@ -485,6 +508,18 @@ func (b *bidSimulator) CheckPending(blockNumber uint64, builder common.Address,
 	return nil
 }
 
+// rejectBid records a bid refused before its simulation and returns the reason.
+// Resent bids are already recorded, and their outcome is left untouched.
+func (b *bidSimulator) rejectBid(bid *types.Bid, err error) error {
+	if !b.history.received(bid) {
+		return err
+	}
+	b.history.update(bid.Hash(), types.BidStatusRejected, func(record *types.BidRecord) {
+		record.Reason = err.Error()
+	})
+	return err
+}
+
 func (b *bidSimulator) AddPending(blockNumber uint64, builder common.Address, bidHash common.Hash) {
 	b.pendingMu.Lock()
 	defer b.pendingMu.Unlock()
@@ -538,8 +573,16 @@ func (b *bidSimulator) simBid(interruptCh chan int32, bidRuntime *BidRuntime) {
 			logCtx = append(logCtx, "err", err)
 			log.Info("BidSimulator: simulation failed", logCtx...)
 
+			if errors.Is(err, errBetterBidArrived) {
+				b.recordSimulation(bidRuntime, types.BidStatusOutbid, err.Error(), time.Since(simStart))
+			} else {
+				b.recordSimulation(bidRuntime, types.BidStatusRejected, err.Error(), time.Since(simStart))
+			}
 			go b.reportIssue(bidRuntime, err)
 		}
+		if success {
+			b.recordSimulation(bidRuntime, types.BidStatusBest, "", time.Since(simStart))
+		}
 
 		b.RemoveSimulatingBid(parentHash)
 		close(bidRuntime.finished)
@@ -575,6 +618,7 @@ func (b *bidSimulator) simBid(interruptCh chan int32, bidRuntime *BidRuntime) {
 	if delay == nil || *delay <= 0 {
 		log.Info("BidSimulator: abort commit, not enough time to simulate",
 			"builder", bidRuntime.bid.Builder, "bidHash", bidRuntime.bid.Hash().Hex())
+		b.recordSimulation(bidRuntime, types.BidStatusRejected, "not enough time to simulate", time.Since(startTS))
 		return
 	}
 
@@ -594,7 +638,7 @@ func (b *bidSimulator) simBid(interruptCh chan int32, bidRuntime *BidRuntime) {
 	for _, tx := range bidRuntime.bid.Txs {
 		select {
 		case <-interruptCh:
-			err = errors.New("simulation abort due to better bid arrived")
+			err = errBetterBidArrived
 			return
 
 		case <-b.exitCh:
@@ -724,6 +768,13 @@ func (b *bidSimulator) simBid(interruptCh chan int32, bidRuntime *BidRuntime) {
 		return
 	}
 
+	// a recommitted best bid keeps its place even if merging mempool txs paid less
+	if bidRuntime.bid.Hash() == bestBid.bid.Hash() {
+		b.recordSimulation(bidRuntime, types.BidStatusBest, "", time.Since(startTS))
+	} else {
+		b.recordSimulation(bidRuntime, types.BidStatusOutbid, "", time.Since(startTS))
+	}
+
 	// only recommit last best bid when newBidCh is empty
 	if len(b.newBidCh) > 0 {
 		return
@@ -751,8 +802,58 @@ func (b *bidSimulator) reportIssue(bidRuntime *BidRuntime, err error) {
 
 		if err != nil {
 			log.Warn("BidSimulator: failed to report issue", "builder", bidRuntime.bid.Builder, "err", err)
+		} else {
+			b.history.annotate(bidRuntime.bid.Hash(), func(record *types.BidRecord) {
+				record.IssueReported = true
+			})
+		}
+	}
+}
+
+// recordBid records a status change of a bid in the bid history, unless the bid
+// is the best one being simulated again, which stays the best whatever happens.
+func (b *bidSimulator) recordBid(bidRuntime *BidRuntime, status string, fn func(record *types.BidRecord)) {
+	if status != types.BidStatusBest {
+		if best := b.GetBestBid(bidRuntime.bid.ParentHash); best != nil && best.bid.Hash() == bidRuntime.bid.Hash() {
+			return
+		}
+	}
+	b.history.update(bidRuntime.bid.Hash(), status, fn)
+}
+
+// recordSimulation records the outcome of a bid simulation in the bid history.
+func (b *bidSimulator) recordSimulation(bidRuntime *BidRuntime, status string, reason string, elapsed time.Duration) {
+	b.recordBid(bidRuntime, status, func(record *types.BidRecord) {
+		record.Reason = reason
+		record.SimulationTime = elapsed
+		if status != types.BidStatusRejected {
+			record.PackedBlockReward = (*hexutil.Big)(new(big.Int).Set(bidRuntime.packedBlockReward))
+			record.PackedValidatorReward = (*hexutil.Big)(new(big.Int).Set(bidRuntime.packedValidatorReward))
 		}
+	})
+}
+
+// SettleBid records whether the best bid was chosen over the local block when
+// sealing, along with the reward of the local block.
+func (b *bidSimulator) SettleBid(bidRuntime *BidRuntime, won bool, localReward *big.Int) {
+	status := types.BidStatusLost
+	if won {
+		status = types.BidStatusWon
 	}
+	b.history.update(bidRuntime.bid.Hash(), status, func(record *types.BidRecord) {
+		record.LocalBlockReward = (*hexutil.Big)(localReward)
+	})
+}
+
+// BidHistory returns the recorded bids, optionally filtered by builder and block
+// number, in arrival order.
+func (b *bidSimulator) BidHistory(builder *common.Address, number *uint64) []*types.BidRecord {
+	return b.history.bids(builder, number)
+}
+
+// BuilderStats returns the statistics of all the builders which sent bids.
+func (b *bidSimulator) BuilderStats() []*types.BuilderStats {
+	return b.history.builderStats()
 }
 
 type BidRuntime struct {
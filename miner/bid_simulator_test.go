@@ -0,0 +1,323 @@
+package miner
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/miner/mockbuilder"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	testBuilderKey, _  = crypto.GenerateKey()
+	testBuilderAddress = crypto.PubkeyToAddress(testBuilderKey.PublicKey)
+)
+
+// mevTestEngine is an ethash engine scheduling blocks like Parlia does, with the
+// local validator always in turn, so that bids can be received and simulated.
+type mevTestEngine struct {
+	consensus.Engine
+	validator common.Address
+	period    uint64
+}
+
+// Prepare schedules the block one period after its parent, sealed in turn.
+func (e *mevTestEngine) Prepare(chain consensus.ChainHeaderReader, header *types.Header) error {
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	header.Time = parent.Time + e.period
+	header.Difficulty = new(big.Int).Set(diffInTurn)
+	return nil
+}
+
+// Seal seals the block right away, the worker having waited for its time.
+func (e *mevTestEngine) Seal(chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
+	go func() {
+		select {
+		case results <- block:
+		case <-stop:
+		}
+	}()
+	return nil
+}
+
+func (e *mevTestEngine) Delay(chain consensus.ChainReader, header *types.Header, leftOver *time.Duration) *time.Duration {
+	delay := time.Until(time.Unix(int64(header.Time), 0)) - *leftOver
+	return &delay
+}
+
+func (e *mevTestEngine) NextInTurnValidator(chain consensus.ChainHeaderReader, header *types.Header) (common.Address, error) {
+	return e.validator, nil
+}
+
+// mevTestBackend serves the mev API of a validator out of its miner.
+type mevTestBackend struct {
+	ethapi.Backend
+	miner *Miner
+}
+
+func (b *mevTestBackend) MevRunning() bool             { return b.miner.MevRunning() }
+func (b *mevTestBackend) MinerInTurn() bool            { return b.miner.InTurn() }
+func (b *mevTestBackend) CurrentHeader() *types.Header { return b.miner.worker.chain.CurrentHeader() }
+func (b *mevTestBackend) SendBid(ctx context.Context, bid *types.BidArgs) (common.Hash, error) {
+	return b.miner.SendBid(ctx, bid)
+}
+
+// newMevTestValidator creates a validator receiving bids on its mev API from a
+// mock builder sharing its chain and transaction pool.
+func newMevTestValidator(t *testing.T, config mockbuilder.Config) (*bidSimulator, *mockbuilder.Builder, *worker, *testWorkerBackend) {
+	var (
+		db          = rawdb.NewMemoryDatabase()
+		chainConfig = params.TestChainConfig
+		engine      = &mevTestEngine{Engine: ethash.NewFullFaker(), validator: testBankAddress, period: 1}
+		funds       = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
+		gspec       = &core.Genesis{
+			Config:    chainConfig,
+			GasLimit:  30_000_000,
+			Timestamp: uint64(time.Now().Unix()) + 1,
+			Alloc: types.GenesisAlloc{
+				testBankAddress:    {Balance: funds},
+				testBuilderAddress: {Balance: funds},
+			},
+		}
+	)
+	chain, err := core.NewBlockChain(db, &core.CacheConfig{TrieDirtyDisabled: true}, gspec, nil, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("core.NewBlockChain failed: %v", err)
+	}
+	t.Cleanup(chain.Stop)
+
+	pool, _ := txpool.New(testTxPoolConfig.PriceLimit, chain, []txpool.SubPool{legacypool.New(testTxPoolConfig, chain)})
+	t.Cleanup(func() { pool.Close() })
+	backend := &testWorkerBackend{db: db, chain: chain, txPool: pool, genesis: gspec}
+
+	workerConfig := *testConfig
+	workerConfig.GasCeil = gspec.GasLimit
+	w := newWorker(&workerConfig, chainConfig, engine, backend, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	t.Cleanup(w.close)
+
+	// Serve the builder API, so that the validator can report issues
+	builderServer := rpc.NewServer()
+	builderHTTP := httptest.NewServer(builderServer)
+	t.Cleanup(builderHTTP.Close)
+
+	// The simulator sees the fees accrue to the system address as on Parlia
+	simConfig := *chainConfig
+	simConfig.Parlia = &params.ParliaConfig{Period: 3}
+	mevConfig := &MevConfig{
+		Enabled:               true,
+		Builders:              []BuilderConfig{{Address: testBuilderAddress, URL: builderHTTP.URL}},
+		ValidatorCommission:   100,
+		BidSimulationLeftOver: 50 * time.Millisecond,
+	}
+	b := newBidSimulator(mevConfig, 50*time.Millisecond, common.Big0, backend, &simConfig, engine, w)
+	b.start()
+	t.Cleanup(b.close)
+	w.setBestBidFetcher(b)
+
+	validatorServer := rpc.NewServer()
+	if err := validatorServer.RegisterName("mev", ethapi.NewMevAPI(&mevTestBackend{miner: &Miner{worker: w, bidSimulator: b}})); err != nil {
+		t.Fatalf("failed to register mev API: %v", err)
+	}
+	t.Cleanup(validatorServer.Stop)
+
+	config.Key = testBuilderKey
+	builder := mockbuilder.New(backend, rpc.DialInProc(validatorServer), config)
+	for _, api := range builder.APIs() {
+		if err := builderServer.RegisterName(api.Namespace, api.Service); err != nil {
+			t.Fatalf("failed to register builder API: %v", err)
+		}
+	}
+	// Fill the pool with transactions for the builder to bid with
+	signer := types.LatestSigner(chainConfig)
+	for i := 0; i < 3; i++ {
+		tx := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{
+			Nonce:    uint64(i),
+			To:       &testUserAddress,
+			Value:    big.NewInt(1000),
+			Gas:      params.TxGas,
+			GasPrice: big.NewInt(2 * params.InitialBaseFee),
+		})
+		if err := pool.Add([]*types.Transaction{tx}, true, true)[0]; err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+	return b, builder, w, backend
+}
+
+// waitBid waits until the bid reaches the given status in the bid history.
+func waitBid(t *testing.T, b *bidSimulator, hash common.Hash, status string) *types.BidRecord {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		for _, record := range b.BidHistory(nil, nil) {
+			if record.Hash == hash && record.Status == status {
+				return record
+			}
+		}
+	}
+	t.Fatalf("bid %x never reached status %s: %+v", hash, status, b.BidHistory(nil, nil))
+	return nil
+}
+
+// Tests that bids of a mock builder go through the mev API of a validator and
+// get simulated, the first one becoming the best bid.
+func TestMockBuilderBid(t *testing.T) {
+	b, builder, _, backend := newMevTestValidator(t, mockbuilder.Config{})
+
+	parent := backend.chain.CurrentHeader()
+	hash, err := builder.Bid(parent)
+	if err != nil {
+		t.Fatalf("failed to send bid: %v", err)
+	}
+	record := waitBid(t, b, hash, types.BidStatusBest)
+	if record.Txs != 4 {
+		t.Errorf("bid transaction count mismatch: have %d, want %d", record.Txs, 4)
+	}
+	if record.PackedBlockReward.ToInt().Cmp(record.GasFee.ToInt()) != 0 {
+		t.Errorf("packed reward mismatch: have %v, want %v", record.PackedBlockReward, record.GasFee)
+	}
+	if best := b.GetBestBid(parent.Hash()); best == nil || best.bid.Hash() != hash {
+		t.Fatalf("bid not the best one")
+	}
+	stats := b.BuilderStats()
+	if len(stats) != 1 || stats[0].Builder != builder.Address() || stats[0].Received != 1 || stats[0].Best != 1 {
+		t.Errorf("builder stats mismatch: %+v", stats)
+	}
+	if issues := builder.Issues(); len(issues) != 0 {
+		t.Errorf("unexpected issues reported: %+v", issues)
+	}
+}
+
+// Tests that bids overstating their value are rejected by the validator, and
+// the issue reported back to the mock builder.
+func TestMockBuilderRejectedBid(t *testing.T) {
+	b, builder, _, backend := newMevTestValidator(t, mockbuilder.Config{FeeBoost: big.NewInt(params.GWei)})
+
+	hash, err := builder.Bid(backend.chain.CurrentHeader())
+	if err != nil {
+		t.Fatalf("failed to send bid: %v", err)
+	}
+	record := waitBid(t, b, hash, types.BidStatusRejected)
+	if record.Reason != "reward does not achieve the expectation" {
+		t.Errorf("rejection reason mismatch: have %q", record.Reason)
+	}
+	for start := time.Now(); len(builder.Issues()) == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("issue never reported to the builder")
+		}
+	}
+	if issue := builder.Issues()[0]; issue.BidHash != hash || issue.Builder != builder.Address() || issue.Validator != testBankAddress {
+		t.Errorf("reported issue mismatch: %+v", issue)
+	}
+}
+
+// Tests that the best bid of a mock builder is sealed by the worker instead of
+// the local block, once the engine schedules the block.
+func TestMockBuilderBidSealed(t *testing.T) {
+	b, builder, w, backend := newMevTestValidator(t, mockbuilder.Config{})
+
+	hash, err := builder.Bid(backend.chain.CurrentHeader())
+	if err != nil {
+		t.Fatalf("failed to send bid: %v", err)
+	}
+	waitBid(t, b, hash, types.BidStatusBest)
+	bid := b.GetBestBid(backend.chain.CurrentHeader().Hash())
+
+	heads := make(chan core.ChainHeadEvent, 1)
+	sub := backend.chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	w.start()
+	defer w.stop()
+
+	var block *types.Block
+	select {
+	case head := <-heads:
+		block = head.Block
+	case <-time.After(10 * time.Second):
+		t.Fatalf("block never sealed")
+	}
+	if block.NumberU64() != 1 || block.Difficulty().Cmp(diffInTurn) != 0 {
+		t.Fatalf("sealed block mismatch: number %d, difficulty %v", block.NumberU64(), block.Difficulty())
+	}
+	if block.Time() != backend.genesis.Timestamp+1 {
+		t.Errorf("sealed block time mismatch: have %d, want %d", block.Time(), backend.genesis.Timestamp+1)
+	}
+	txs := bid.bid.Txs
+	if len(block.Transactions()) != len(txs) {
+		t.Fatalf("sealed transaction count mismatch: have %d, want %d", len(block.Transactions()), len(txs))
+	}
+	for i, tx := range txs {
+		if block.Transactions()[i].Hash() != tx.Hash() {
+			t.Errorf("transaction %d: hash mismatch: have %x, want %x", i, block.Transactions()[i].Hash(), tx.Hash())
+		}
+	}
+	// The builder pays for its bid with the last transaction
+	signer := types.LatestSigner(backend.chain.Config())
+	if payBid := txs[len(txs)-1]; *payBid.To() != builder.Address() {
+		t.Errorf("bid payment recipient mismatch: have %x, want %x", payBid.To(), builder.Address())
+	} else if from, _ := types.Sender(signer, payBid); from != builder.Address() {
+		t.Errorf("bid payment sender mismatch: have %x, want %x", from, builder.Address())
+	}
+	waitBid(t, b, hash, types.BidStatusWon)
+}
+
+// Tests that bids refused before their simulation are recorded as rejected,
+// while resent bids keep their recorded outcome.
+func TestMockBuilderBidRefused(t *testing.T) {
+	b, builder, w, backend := newMevTestValidator(t, mockbuilder.Config{})
+	miner := &Miner{worker: w, bidSimulator: b}
+	parent := backend.chain.CurrentHeader()
+
+	// Bids of unregistered builders are refused without being recorded
+	key, _ := crypto.GenerateKey()
+	unknown := mockbuilder.New(backend, nil, mockbuilder.Config{Key: key})
+	args, err := unknown.BuildBid(parent)
+	if err != nil {
+		t.Fatalf("failed to build bid: %v", err)
+	}
+	if _, err := miner.SendBid(context.Background(), args); err == nil {
+		t.Fatalf("bid of an unregistered builder accepted")
+	}
+	address := unknown.Address()
+	if records := b.BidHistory(&address, nil); len(records) != 0 {
+		t.Fatalf("unregistered builder bid recorded: %+v", records)
+	}
+	// Resending a bid is refused, without changing its outcome
+	args, err = builder.BuildBid(parent)
+	if err != nil {
+		t.Fatalf("failed to build bid: %v", err)
+	}
+	hash, err := miner.SendBid(context.Background(), args)
+	if err != nil {
+		t.Fatalf("failed to send bid: %v", err)
+	}
+	waitBid(t, b, hash, types.BidStatusBest)
+	if _, err := miner.SendBid(context.Background(), args); err == nil {
+		t.Fatalf("resent bid accepted")
+	}
+	waitBid(t, b, hash, types.BidStatusBest)
+
+	stats := b.BuilderStats()
+	if len(stats) != 1 || stats[0].Builder != builder.Address() || stats[0].Received != 1 || stats[0].Rejected != 0 {
+		t.Fatalf("builder stats mismatch: %+v", stats)
+	}
+}
@@ -57,6 +57,9 @@ type Config struct {
 	NewPayloadTimeout      time.Duration // The maximum time allowance for creating a new payload
 	DisableVoteAttestation bool          // Whether to skip assembling vote attestation
 
+	Ordering          string // Transaction ordering policy (price, fcfs or fair)
+	OrderingSenderCap int    // Transactions of a sender included per round by the fair ordering
+
 	Mev MevConfig // Mev configuration
 }
 
@@ -73,6 +76,9 @@ var DefaultConfig = Config{
 	NewPayloadTimeout: 2 * time.Second,
 	DelayLeftOver:     50 * time.Millisecond,
 
+	Ordering:          OrderingPrice,
+	OrderingSenderCap: DefaultOrderingSenderCap,
+
 	Mev: DefaultMevConfig,
 }
 
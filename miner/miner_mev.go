@@ -71,27 +71,31 @@ func (miner *Miner) SendBid(ctx context.Context, bidArgs *types.BidArgs) (common
 		return common.Hash{}, types.NewInvalidBidError(fmt.Sprintf("invalid signature:%v", err))
 	}
 
+	// Any key recovers a builder, the bids of unregistered builders are refused
+	// before being recorded and only counted altogether
 	if !miner.bidSimulator.ExistBuilder(builder) {
+		bidUnregisteredCounter.Inc(1)
 		return common.Hash{}, types.NewInvalidBidError("builder is not registered")
 	}
 
-	err = miner.bidSimulator.CheckPending(bidArgs.RawBid.BlockNumber, builder, bidArgs.RawBid.Hash())
-	if err != nil {
-		return common.Hash{}, err
-	}
-
 	signer := types.MakeSigner(miner.worker.chainConfig, big.NewInt(int64(bidArgs.RawBid.BlockNumber)), uint64(time.Now().Unix()))
 	bid, err := bidArgs.ToBid(builder, signer)
 	if err != nil {
 		return common.Hash{}, types.NewInvalidBidError(fmt.Sprintf("fail to convert bidArgs to bid, %v", err))
 	}
 
+	// Bids refused before their simulation are recorded as rejected as well
+	err = miner.bidSimulator.CheckPending(bidArgs.RawBid.BlockNumber, builder, bidArgs.RawBid.Hash())
+	if err != nil {
+		return common.Hash{}, miner.bidSimulator.rejectBid(bid, err)
+	}
+
 	bidBetterBefore := miner.bidSimulator.bidBetterBefore(bidArgs.RawBid.ParentHash)
 	timeout := time.Until(bidBetterBefore)
 
 	if timeout <= 0 {
-		return common.Hash{}, fmt.Errorf("too late, expected befor %s, appeared %s later", bidBetterBefore,
-			common.PrettyDuration(timeout))
+		return common.Hash{}, miner.bidSimulator.rejectBid(bid, fmt.Errorf("too late, expected befor %s, appeared %s later", bidBetterBefore,
+			common.PrettyDuration(timeout)))
 	}
 
 	err = miner.bidSimulator.sendBid(ctx, bid)
@@ -112,6 +116,17 @@ func (miner *Miner) BestPackedBlockReward(parentHash common.Hash) *big.Int {
 	return bidRuntime.packedBlockReward
 }
 
+// BidHistory returns the recorded bids, optionally filtered by builder and block
+// number, in arrival order.
+func (miner *Miner) BidHistory(builder *common.Address, number *uint64) []*types.BidRecord {
+	return miner.bidSimulator.BidHistory(builder, number)
+}
+
+// BuilderStats returns the statistics of all the builders which sent bids.
+func (miner *Miner) BuilderStats() []*types.BuilderStats {
+	return miner.bidSimulator.BuilderStats()
+}
+
 func (miner *Miner) MevParams() *types.MevParams {
 	builderFeeCeil, ok := big.NewInt(0).SetString(miner.worker.config.Mev.BuilderFeeCeil, 10)
 	if !ok {
@@ -0,0 +1,296 @@
+// Package mockbuilder implements a minimal MEV builder, building bids out of
+// the pending transactions of a local transaction pool and sending them to a
+// validator, so that the MEV pipeline can be exercised without external
+// builders.
+package mockbuilder
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+)
+
+// sendTimeout is the time allowed to the validator to judge a bid.
+const sendTimeout = 3 * time.Second
+
+// errNoTransactions is returned if there are no pending transactions to bid with.
+var errNoTransactions = errors.New("no pending transactions")
+
+// Backend wraps the chain and the transaction pool the bids are built from.
+type Backend interface {
+	BlockChain() *core.BlockChain
+	TxPool() *txpool.TxPool
+}
+
+// Config are the configuration parameters of the mock builder.
+type Config struct {
+	Key        *ecdsa.PrivateKey // Key signing the bids and the bid payment transactions
+	BuilderFee *big.Int          // Fee asked to the validator for every bid
+	MaxTxs     int               // Maximum number of pool transactions in a bid, unlimited if zero
+
+	// FeeBoost is added to the gas fee claimed by the bids, overstating their
+	// value, to check how validators handle misbehaving builders.
+	FeeBoost *big.Int
+}
+
+// Builder builds a bid for every new head out of the pending transactions of
+// the pool, and sends it to the validator with mev_sendBid. Issues reported
+// back by the validator are recorded.
+type Builder struct {
+	config    Config
+	address   common.Address
+	backend   Backend
+	validator *rpc.Client
+
+	mu     sync.Mutex
+	issues []types.BidIssue
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a mock builder sending its bids to the given validator.
+func New(backend Backend, validator *rpc.Client, config Config) *Builder {
+	return &Builder{
+		config:    config,
+		address:   crypto.PubkeyToAddress(config.Key.PublicKey),
+		backend:   backend,
+		validator: validator,
+		quit:      make(chan struct{}),
+	}
+}
+
+// Address returns the address of the builder, which must be registered at the
+// validator for its bids to be accepted.
+func (b *Builder) Address() common.Address {
+	return b.address
+}
+
+// APIs returns the RPC API of the builder, receiving the issues reported by the
+// validator. It must be served at the url the builder is registered with.
+func (b *Builder) APIs() []rpc.API {
+	return []rpc.API{{
+		Namespace: "mev",
+		Service:   &API{b},
+	}}
+}
+
+// Start sends a bid on top of every new head, until stopped.
+func (b *Builder) Start() {
+	b.wg.Add(1)
+	go b.loop()
+}
+
+// Stop terminates the bidding loop.
+func (b *Builder) Stop() {
+	close(b.quit)
+	b.wg.Wait()
+}
+
+func (b *Builder) loop() {
+	defer b.wg.Done()
+
+	heads := make(chan core.ChainHeadEvent, 10)
+	sub := b.backend.BlockChain().SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-heads:
+			hash, err := b.Bid(head.Block.Header())
+			if err != nil {
+				if !errors.Is(err, errNoTransactions) {
+					log.Warn("Mock builder failed to bid", "number", head.Block.NumberU64()+1, "err", err)
+				}
+				continue
+			}
+			log.Info("Mock builder sent bid", "number", head.Block.NumberU64()+1, "hash", hash)
+
+		case <-sub.Err():
+			return
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+// Bid builds a bid on top of the given parent and sends it to the validator,
+// returning the hash of the accepted bid.
+func (b *Builder) Bid(parent *types.Header) (common.Hash, error) {
+	args, err := b.BuildBid(parent)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	var hash common.Hash
+	if err := b.validator.CallContext(ctx, &hash, "mev_sendBid", args); err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// BuildBid assembles the pending transactions of the pool executable on top of
+// the given parent into a signed bid, along with the payment transaction.
+func (b *Builder) BuildBid(parent *types.Header) (*types.BidArgs, error) {
+	var (
+		chain  = b.backend.BlockChain()
+		config = chain.Config()
+		period = uint64(1)
+	)
+	// The validator seals its block one period after the parent on Parlia
+	if config.Parlia != nil {
+		period = config.Parlia.Period
+	}
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + period,
+		Coinbase:   b.address,
+		Difficulty: parent.Difficulty,
+	}
+	if config.IsLondon(header.Number) {
+		header.BaseFee = eip1559.CalcBaseFee(config, parent)
+	}
+	reserved := params.SystemTxsGas + params.PayBidTxGasLimit
+	if header.GasLimit <= reserved {
+		return nil, fmt.Errorf("gas limit %d too low, %d reserved for the system and payment transactions", header.GasLimit, reserved)
+	}
+	statedb, err := chain.StateAt(parent.Root)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		signer = types.MakeSigner(config, header.Number, header.Time)
+		gp     = new(core.GasPool).AddGas(header.GasLimit - reserved)
+		txs    []hexutil.Bytes
+		used   uint64
+		fee    = new(big.Int)
+	)
+	filter := txpool.PendingFilter{OnlyPlainTxs: true}
+	if header.BaseFee != nil {
+		filter.BaseFee = uint256.MustFromBig(header.BaseFee)
+	}
+	pending := b.backend.TxPool().Pending(filter)
+	senders := make([]common.Address, 0, len(pending))
+	for addr := range pending {
+		senders = append(senders, addr)
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i][:], senders[j][:]) < 0
+	})
+	for _, addr := range senders {
+		for _, ltx := range pending[addr] {
+			if b.config.MaxTxs > 0 && len(txs) >= b.config.MaxTxs {
+				break
+			}
+			tx := ltx.Resolve()
+			if tx == nil {
+				break
+			}
+			snap := statedb.Snapshot()
+			statedb.SetTxContext(tx.Hash(), len(txs))
+			receipt, err := core.ApplyTransaction(config, chain, &header.Coinbase, gp, statedb, header, tx, &used, vm.Config{})
+			if err != nil {
+				// Skip the remaining transactions of the sender, they depend on this one
+				statedb.RevertToSnapshot(snap)
+				break
+			}
+			tip, _ := tx.EffectiveGasTip(header.BaseFee)
+			fee.Add(fee, new(big.Int).Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)))
+
+			blob, err := tx.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			txs = append(txs, blob)
+		}
+	}
+	if len(txs) == 0 || fee.Sign() == 0 {
+		return nil, errNoTransactions
+	}
+	if b.config.FeeBoost != nil {
+		fee.Add(fee, b.config.FeeBoost)
+	}
+	// Pay the bid from the builder account, so it must be funded for the base fee
+	gasPrice := new(big.Int)
+	if header.BaseFee != nil {
+		gasPrice.Set(header.BaseFee)
+	}
+	payBidTx, err := types.SignNewTx(b.config.Key, signer, &types.LegacyTx{
+		Nonce:    statedb.GetNonce(b.address),
+		To:       &b.address,
+		Value:    new(big.Int),
+		Gas:      params.TxGas,
+		GasPrice: gasPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	payBidTxBlob, err := payBidTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	rawBid := &types.RawBid{
+		BlockNumber: header.Number.Uint64(),
+		ParentHash:  header.ParentHash,
+		Txs:         txs,
+		GasUsed:     used,
+		GasFee:      fee,
+		BuilderFee:  b.config.BuilderFee,
+	}
+	signature, err := crypto.Sign(rawBid.Hash().Bytes(), b.config.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &types.BidArgs{
+		RawBid:          rawBid,
+		Signature:       signature,
+		PayBidTx:        payBidTxBlob,
+		PayBidTxGasUsed: params.TxGas,
+	}, nil
+}
+
+// Issues returns the issues reported by the validator so far.
+func (b *Builder) Issues() []types.BidIssue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]types.BidIssue(nil), b.issues...)
+}
+
+// API is the RPC API of the mock builder, called by the validator.
+type API struct {
+	b *Builder
+}
+
+// ReportIssue records an issue reported by the validator about a bid.
+func (api *API) ReportIssue(_ context.Context, issue types.BidIssue) error {
+	api.b.mu.Lock()
+	defer api.b.mu.Unlock()
+
+	log.Info("Mock builder received issue", "bid", issue.BidHash, "validator", issue.Validator, "message", issue.Message)
+	api.b.issues = append(api.b.issues, issue)
+	return nil
+}
@@ -62,11 +62,7 @@ func (s txByPriceAndTime) Len() int { return len(s) }
 func (s txByPriceAndTime) Less(i, j int) bool {
 	// If the prices are equal, use the time the transaction was first seen for
 	// deterministic sorting
-	cmp := s[i].fees.Cmp(s[j].fees)
-	if cmp == 0 {
-		return s[i].tx.Time.Before(s[j].tx.Time)
-	}
-	return cmp > 0
+	return pricePolicy{}.less(s[i], s[j])
 }
 func (s txByPriceAndTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
@@ -83,12 +79,25 @@ func (s *txByPriceAndTime) Pop() interface{} {
 	return x
 }
 
+// txHeap is a heap of transactions sorted by an ordering policy instead of the
+// default price and time order.
+type txHeap struct {
+	list   txByPriceAndTime
+	policy orderingPolicy
+}
+
+func (h *txHeap) Len() int           { return h.list.Len() }
+func (h *txHeap) Less(i, j int) bool { return h.policy.less(h.list[i], h.list[j]) }
+func (h *txHeap) Swap(i, j int)      { h.list.Swap(i, j) }
+func (h *txHeap) Push(x interface{}) { h.list.Push(x) }
+func (h *txHeap) Pop() interface{}   { return h.list.Pop() }
+
 // transactionsByPriceAndNonce represents a set of transactions that can return
 // transactions in a profit-maximizing sorted order, while supporting removing
 // entire batches of transactions for non-executable accounts.
 type transactionsByPriceAndNonce struct {
 	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
-	heads   txByPriceAndTime                             // Next transaction for each unique account (price heap)
+	heads   txHeap                                       // Next transaction for each unique account (policy heap)
 	signer  types.Signer                                 // Signer for the set of transactions
 	baseFee *uint256.Int                                 // Current base fee
 }
@@ -99,20 +108,29 @@ type transactionsByPriceAndNonce struct {
 // Note, the input map is reowned so the caller should not interact any more with
 // if after providing it to the constructor.
 func newTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int) *transactionsByPriceAndNonce {
+	return newTransactionsByPolicy(signer, txs, baseFee, pricePolicy{})
+}
+
+// newTransactionsByPolicy creates a transaction set that can retrieve transactions
+// sorted by the given ordering policy in a nonce-honouring way.
+//
+// Note, the input map is reowned so the caller should not interact any more with
+// if after providing it to the constructor.
+func newTransactionsByPolicy(signer types.Signer, txs map[common.Address][]*txpool.LazyTransaction, baseFee *big.Int, policy orderingPolicy) *transactionsByPriceAndNonce {
 	// Convert the basefee from header format to uint256 format
 	var baseFeeUint *uint256.Int
 	if baseFee != nil {
 		baseFeeUint = uint256.MustFromBig(baseFee)
 	}
-	// Initialize a price and received time based heap with the head transactions
-	heads := make(txByPriceAndTime, 0, len(txs))
+	// Initialize a policy sorted heap with the head transactions
+	heads := txHeap{list: make(txByPriceAndTime, 0, len(txs)), policy: policy}
 	for from, accTxs := range txs {
 		wrapped, err := newTxWithMinerFee(accTxs[0], from, baseFeeUint)
 		if err != nil {
 			delete(txs, from)
 			continue
 		}
-		heads = append(heads, wrapped)
+		heads.list = append(heads.list, wrapped)
 		txs[from] = accTxs[1:]
 	}
 	heap.Init(&heads)
@@ -128,8 +146,8 @@ func newTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address]
 
 // Copy copys a new TransactionsPriceAndNonce with the same *transaction
 func (t *transactionsByPriceAndNonce) Copy() *transactionsByPriceAndNonce {
-	heads := make([]*txWithMinerFee, len(t.heads))
-	copy(heads, t.heads)
+	heads := txHeap{list: make(txByPriceAndTime, len(t.heads.list)), policy: t.heads.policy.copy()}
+	copy(heads.list, t.heads.list)
 	txs := make(map[common.Address][]*txpool.LazyTransaction, len(t.txs))
 	for acc, txsTmp := range t.txs {
 		txs[acc] = txsTmp
@@ -148,26 +166,33 @@ func (t *transactionsByPriceAndNonce) Copy() *transactionsByPriceAndNonce {
 
 // Peek returns the next transaction by price.
 func (t *transactionsByPriceAndNonce) Peek() (*txpool.LazyTransaction, *uint256.Int) {
-	if len(t.heads) == 0 {
+	if len(t.heads.list) == 0 {
 		return nil, nil
 	}
-	return t.heads[0].tx, t.heads[0].fees
+	return t.heads.list[0].tx, t.heads.list[0].fees
+}
+
+// Before reports whether the next transaction of the set should be included
+// before the next one of another set, according to the ordering policy.
+func (t *transactionsByPriceAndNonce) Before(other *transactionsByPriceAndNonce) bool {
+	return t.heads.policy.less(t.heads.list[0], other.heads.list[0])
 }
 
 // Peek returns the next transaction by price.
 func (t *transactionsByPriceAndNonce) PeekWithUnwrap() *types.Transaction {
-	if len(t.heads) > 0 && t.heads[0].tx != nil && t.heads[0].tx.Resolve() != nil {
-		return t.heads[0].tx.Tx
+	if len(t.heads.list) > 0 && t.heads.list[0].tx != nil && t.heads.list[0].tx.Resolve() != nil {
+		return t.heads.list[0].tx.Tx
 	}
 	return nil
 }
 
 // Shift replaces the current best head with the next one from the same account.
 func (t *transactionsByPriceAndNonce) Shift() {
-	acc := t.heads[0].from
+	acc := t.heads.list[0].from
 	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
 		if wrapped, err := newTxWithMinerFee(txs[0], acc, t.baseFee); err == nil {
-			t.heads[0], t.txs[acc] = wrapped, txs[1:]
+			t.heads.policy.included(acc)
+			t.heads.list[0], t.txs[acc] = wrapped, txs[1:]
 			heap.Fix(&t.heads, 0)
 			return
 		}
@@ -185,28 +210,28 @@ func (t *transactionsByPriceAndNonce) Pop() {
 // Empty returns if the price heap is empty. It can be used to check it simpler
 // than calling peek and checking for nil return.
 func (t *transactionsByPriceAndNonce) Empty() bool {
-	return len(t.heads) == 0
+	return len(t.heads.list) == 0
 }
 
 // Clear removes the entire content of the heap.
 func (t *transactionsByPriceAndNonce) Clear() {
-	t.heads, t.txs = nil, nil
+	t.heads.list, t.txs = nil, nil
 }
 
 func (t *transactionsByPriceAndNonce) CurrentSize() int {
-	return len(t.heads)
+	return len(t.heads.list)
 }
 
 // Forward moves current transaction to be the one which is one index after tx
 func (t *transactionsByPriceAndNonce) Forward(tx *types.Transaction) {
 	if tx == nil {
-		if len(t.heads) > 0 {
-			t.heads = t.heads[0:0]
+		if len(t.heads.list) > 0 {
+			t.heads.list = t.heads.list[0:0]
 		}
 		return
 	}
 	//check whether target tx exists in t.heads
-	for _, head := range t.heads {
+	for _, head := range t.heads.list {
 		if head.tx != nil && head.tx.Resolve() != nil {
 			if tx == head.tx.Tx {
 				//shift t to the position one after tx
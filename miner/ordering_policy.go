@@ -0,0 +1,116 @@
+package miner
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Transaction ordering policies selectable via the miner configuration.
+const (
+	OrderingPrice = "price" // Highest effective tip first, ties broken by arrival time
+	OrderingFCFS  = "fcfs"  // Earliest arrival first, regardless of the tip
+	OrderingFair  = "fair"  // Highest tip first, senders taking turns in rounds of capped inclusions
+)
+
+// DefaultOrderingSenderCap is the default number of transactions a sender can
+// have included per round under the fair policy.
+const DefaultOrderingSenderCap = 4
+
+// orderingPolicy decides in which order the head transactions of the accounts
+// are included in a block. The nonce order within an account is always kept.
+type orderingPolicy interface {
+	// less reports whether transaction a should be included before b.
+	less(a, b *txWithMinerFee) bool
+
+	// included is called whenever a transaction of the account was included
+	// and replaced by the next one of the same account.
+	included(acc common.Address)
+
+	// copy returns an independent copy of the policy and its state.
+	copy() orderingPolicy
+}
+
+// newOrderingPolicy returns a constructor for the named ordering policy, every
+// set of transactions being sorted with its own policy instance.
+func newOrderingPolicy(name string, senderCap int) (func() orderingPolicy, error) {
+	switch name {
+	case "", OrderingPrice:
+		return func() orderingPolicy { return pricePolicy{} }, nil
+	case OrderingFCFS:
+		return func() orderingPolicy { return fcfsPolicy{} }, nil
+	case OrderingFair:
+		if senderCap <= 0 {
+			return nil, fmt.Errorf("invalid sender cap %d for %s ordering", senderCap, OrderingFair)
+		}
+		return func() orderingPolicy { return newFairPolicy(senderCap) }, nil
+	}
+	return nil, fmt.Errorf("unknown transaction ordering %q", name)
+}
+
+// pricePolicy orders transactions by effective miner tip, then by the time they
+// were first seen for deterministic sorting.
+type pricePolicy struct{}
+
+func (pricePolicy) less(a, b *txWithMinerFee) bool {
+	cmp := a.fees.Cmp(b.fees)
+	if cmp == 0 {
+		return a.tx.Time.Before(b.tx.Time)
+	}
+	return cmp > 0
+}
+
+func (pricePolicy) included(common.Address) {}
+
+func (p pricePolicy) copy() orderingPolicy { return p }
+
+// fcfsPolicy orders transactions by the time they were first seen, falling back
+// to the tip only for transactions seen at the same time.
+type fcfsPolicy struct{}
+
+func (fcfsPolicy) less(a, b *txWithMinerFee) bool {
+	if !a.tx.Time.Equal(b.tx.Time) {
+		return a.tx.Time.Before(b.tx.Time)
+	}
+	return a.fees.Gt(b.fees)
+}
+
+func (fcfsPolicy) included(common.Address) {}
+
+func (p fcfsPolicy) copy() orderingPolicy { return p }
+
+// fairPolicy orders transactions by price within rounds, each sender having at
+// most cap transactions included per round. A sender reaching the cap yields to
+// all the senders still in an earlier round, and only continues once they caught
+// up or ran out of transactions. Inclusions of a sender are thus not bounded in a
+// row: once the other senders ran out, the remaining one fills the block.
+type fairPolicy struct {
+	cap    int
+	counts map[common.Address]int // Number of transactions included per sender
+}
+
+func newFairPolicy(cap int) *fairPolicy {
+	return &fairPolicy{
+		cap:    cap,
+		counts: make(map[common.Address]int),
+	}
+}
+
+func (p *fairPolicy) less(a, b *txWithMinerFee) bool {
+	if ra, rb := p.counts[a.from]/p.cap, p.counts[b.from]/p.cap; ra != rb {
+		return ra < rb
+	}
+	return pricePolicy{}.less(a, b)
+}
+
+func (p *fairPolicy) included(acc common.Address) {
+	p.counts[acc]++
+}
+
+func (p *fairPolicy) copy() orderingPolicy {
+	cpy := newFairPolicy(p.cap)
+	for acc, n := range p.counts {
+		cpy.counts[acc] = n
+	}
+	return cpy
+}
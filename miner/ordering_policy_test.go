@@ -0,0 +1,132 @@
+package miner
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// orderingTx creates a lazy transaction of the given sender, seen at the given time.
+func orderingTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, price int64, seen int64) *txpool.LazyTransaction {
+	tx, err := types.SignTx(types.NewTransaction(nonce, common.Address{}, big.NewInt(100), 21000, big.NewInt(price), nil), types.HomesteadSigner{}, key)
+	if err != nil {
+		t.Fatalf("failed to sign tx: %v", err)
+	}
+	tx.SetTime(time.Unix(seen, 0))
+	return &txpool.LazyTransaction{
+		Hash:      tx.Hash(),
+		Tx:        tx,
+		Time:      tx.Time(),
+		GasFeeCap: uint256.MustFromBig(tx.GasFeeCap()),
+		GasTipCap: uint256.MustFromBig(tx.GasTipCap()),
+		Gas:       tx.Gas(),
+	}
+}
+
+// orderedSenders drains the transaction set, returning the sender of each
+// transaction in inclusion order.
+func orderedSenders(txset *transactionsByPriceAndNonce, names map[common.Address]string) string {
+	var order string
+	for tx, _ := txset.Peek(); tx != nil; tx, _ = txset.Peek() {
+		from, _ := types.Sender(types.HomesteadSigner{}, tx.Tx)
+		order += names[from]
+		txset.Shift()
+	}
+	return order
+}
+
+// Tests that the ordering policies sort the account head transactions as
+// configured, always honouring the nonce order within an account.
+func TestOrderingPolicies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		keyA, _ = crypto.GenerateKey()
+		keyB, _ = crypto.GenerateKey()
+		keyC, _ = crypto.GenerateKey()
+		addrA   = crypto.PubkeyToAddress(keyA.PublicKey)
+		addrB   = crypto.PubkeyToAddress(keyB.PublicKey)
+		addrC   = crypto.PubkeyToAddress(keyC.PublicKey)
+		names   = map[common.Address]string{addrA: "A", addrB: "B", addrC: "C"}
+	)
+	// A pays the most but arrived last, C pays the least but arrived first
+	groups := func() map[common.Address][]*txpool.LazyTransaction {
+		groups := make(map[common.Address][]*txpool.LazyTransaction)
+		for i := 0; i < 6; i++ {
+			groups[addrA] = append(groups[addrA], orderingTx(t, keyA, uint64(i), 30, int64(30+i)))
+		}
+		for i := 0; i < 2; i++ {
+			groups[addrB] = append(groups[addrB], orderingTx(t, keyB, uint64(i), 20, int64(20+i)))
+			groups[addrC] = append(groups[addrC], orderingTx(t, keyC, uint64(i), 10, int64(10+i)))
+		}
+		return groups
+	}
+	tests := []struct {
+		ordering string
+		cap      int
+		want     string
+	}{
+		{OrderingPrice, 0, "AAAAAABBCC"},
+		{OrderingFCFS, 0, "CCBBAAAAAA"},
+		{OrderingFair, 2, "AABBCCAAAA"},
+		{OrderingFair, 1, "ABCABCAAAA"},
+	}
+	for _, tt := range tests {
+		newPolicy, err := newOrderingPolicy(tt.ordering, tt.cap)
+		if err != nil {
+			t.Fatalf("%s ordering: failed to create policy: %v", tt.ordering, err)
+		}
+		txset := newTransactionsByPolicy(types.HomesteadSigner{}, groups(), nil, newPolicy())
+		if have := orderedSenders(txset, names); have != tt.want {
+			t.Errorf("%s ordering (cap %d): order mismatch: have %s, want %s", tt.ordering, tt.cap, have, tt.want)
+		}
+	}
+}
+
+// Tests that copies of a fair transaction set do not share the inclusion counts.
+func TestFairOrderingCopy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		keyA, _ = crypto.GenerateKey()
+		keyB, _ = crypto.GenerateKey()
+		addrA   = crypto.PubkeyToAddress(keyA.PublicKey)
+		addrB   = crypto.PubkeyToAddress(keyB.PublicKey)
+		names   = map[common.Address]string{addrA: "A", addrB: "B"}
+		groups  = map[common.Address][]*txpool.LazyTransaction{
+			addrA: {orderingTx(t, keyA, 0, 20, 0), orderingTx(t, keyA, 1, 20, 1), orderingTx(t, keyA, 2, 20, 2)},
+			addrB: {orderingTx(t, keyB, 0, 10, 0), orderingTx(t, keyB, 1, 10, 1)},
+		}
+	)
+	txset := newTransactionsByPolicy(types.HomesteadSigner{}, groups, nil, newFairPolicy(1))
+	cpy := txset.Copy()
+
+	if have, want := orderedSenders(txset, names), "ABABA"; have != want {
+		t.Errorf("original order mismatch: have %s, want %s", have, want)
+	}
+	if have, want := orderedSenders(cpy, names), "ABABA"; have != want {
+		t.Errorf("copied order mismatch: have %s, want %s", have, want)
+	}
+}
+
+// Tests that unknown ordering policies and invalid sender caps are rejected.
+func TestOrderingPolicyConfig(t *testing.T) {
+	t.Parallel()
+
+	if _, err := newOrderingPolicy("", 0); err != nil {
+		t.Errorf("default ordering rejected: %v", err)
+	}
+	if _, err := newOrderingPolicy("random", 0); err == nil {
+		t.Error("unknown ordering accepted")
+	}
+	if _, err := newOrderingPolicy(OrderingFair, 0); err == nil {
+		t.Error("fair ordering without sender cap accepted")
+	}
+}
@@ -0,0 +1,180 @@
+package miner
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// errSimulationNoPending is returned if there is no pending state to simulate on.
+	errSimulationNoPending = errors.New("pending state not available")
+
+	// errSimulationBlobTx is returned for blob transactions, which carry no
+	// sidecar once pooled and cannot be simulated.
+	errSimulationBlobTx = errors.New("blob transactions cannot be simulated")
+
+	// errSenderSkipped is returned for the transactions following a failed one
+	// from the same sender, as the worker drops the whole account in that case.
+	errSenderSkipped = errors.New("sender skipped after failed transaction")
+)
+
+// SimulatedTx is the outcome of executing a transaction of a simulated sequence
+// on top of the pending block.
+type SimulatedTx struct {
+	Tx      *types.Transaction
+	Receipt *types.Receipt        // Receipt of the transaction, nil if it would not be included
+	Result  *core.ExecutionResult // Execution result, nil if it would not be included
+	Err     error                 // Reason the worker would not include the transaction
+}
+
+// Simulate executes an ordered sequence of transactions on top of the pending
+// block, applying the same inclusion rules as the worker does when committing
+// pool transactions, without keeping any of the resulting changes. An optional
+// tracer can be attached to the execution of each transaction, identified by its
+// position in the sequence.
+func (miner *Miner) Simulate(txs []*types.Transaction, tracer func(i int, tx *types.Transaction) vm.EVMLogger) ([]*SimulatedTx, error) {
+	block, statedb := miner.Pending()
+	if block == nil || statedb == nil {
+		return nil, errSimulationNoPending
+	}
+	block, statedb, err := miner.worker.simulationBase(block, statedb, txs)
+	if err != nil {
+		return nil, err
+	}
+	return miner.worker.simulate(block, statedb, txs, tracer)
+}
+
+// simulationBase returns the block and the state to simulate the given
+// transactions on. The pending block of a validator may already include some of
+// them, which would then be rejected with a too low nonce, so they are simulated
+// on top of the parent of the pending block instead.
+func (w *worker) simulationBase(pending *types.Block, statedb *state.StateDB, txs []*types.Transaction) (*types.Block, *state.StateDB, error) {
+	if w.chain.GetHeaderByHash(pending.Hash()) != nil {
+		return pending, statedb, nil
+	}
+	for _, tx := range txs {
+		if pending.Transaction(tx.Hash()) == nil {
+			continue
+		}
+		parent := w.chain.GetBlock(pending.ParentHash(), pending.NumberU64()-1)
+		if parent == nil {
+			return nil, nil, consensus.ErrUnknownAncestor
+		}
+		statedb, err := w.chain.StateAt(parent.Root())
+		if err != nil {
+			return nil, nil, err
+		}
+		return parent, statedb, nil
+	}
+	return pending, statedb, nil
+}
+
+// simulate runs the given transactions on top of a pending block and its state.
+// If the block is already part of the chain, the transactions are executed in
+// a child block instead.
+func (w *worker) simulate(pending *types.Block, statedb *state.StateDB, txs []*types.Transaction, tracer func(i int, tx *types.Transaction) vm.EVMLogger) ([]*SimulatedTx, error) {
+	env := &environment{
+		state:  statedb,
+		header: types.CopyHeader(pending.Header()),
+		tcount: len(pending.Transactions()),
+	}
+	if w.chain.GetHeaderByHash(pending.Hash()) != nil {
+		env.header, env.tcount = w.simulationHeader(pending.Header()), 0
+	}
+	env.signer = types.MakeSigner(w.chainConfig, env.header.Number, env.header.Time)
+	env.coinbase = env.header.Coinbase
+
+	// Reserve the gas of the system transactions like the worker does, and the
+	// gas already used by the pending block
+	env.gasPool = new(core.GasPool).AddGas(env.header.GasLimit)
+	env.gasPool.SubGas(params.SystemTxsGas)
+	if err := env.gasPool.SubGas(env.header.GasUsed); err != nil {
+		env.gasPool.SetGas(0)
+	}
+	var (
+		skipped = make(map[common.Address]bool)
+		results = make([]*SimulatedTx, len(txs))
+	)
+	for i, tx := range txs {
+		results[i] = &SimulatedTx{Tx: tx}
+
+		from, err := types.Sender(env.signer, tx)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		// Mirror the checks of commitTransactions, skipping the account on failure
+		switch {
+		case skipped[from]:
+			results[i].Err = errSenderSkipped
+			continue
+
+		case tx.Type() == types.BlobTxType:
+			results[i].Err = errSimulationBlobTx
+			skipped[from] = true
+			continue
+
+		case env.gasPool.Gas() < tx.Gas():
+			results[i].Err = fmt.Errorf("%w: have %d, want %d", core.ErrGasLimitReached, env.gasPool.Gas(), tx.Gas())
+			skipped[from] = true
+			continue
+
+		case tx.Protected() && !w.chainConfig.IsEIP155(env.header.Number):
+			results[i].Err = types.ErrInvalidChainId
+			skipped[from] = true
+			continue
+		}
+		env.state.SetTxContext(tx.Hash(), env.tcount)
+
+		config := *w.chain.GetVMConfig()
+		if tracer != nil {
+			config.Tracer = tracer(i, tx)
+		}
+		receipt, result, err := w.applyTransactionWithConfig(env, tx, config)
+		switch {
+		case errors.Is(err, core.ErrNonceTooLow):
+			results[i].Err = err
+
+		case err != nil:
+			results[i].Err = err
+			skipped[from] = true
+
+		default:
+			results[i].Receipt, results[i].Result = receipt, result
+			env.tcount++
+		}
+	}
+	return results, nil
+}
+
+// simulationHeader assembles the header of the block following the given one,
+// used when no pending block is being built on top of the chain head.
+func (w *worker) simulationHeader(parent *types.Header) *types.Header {
+	timestamp := uint64(time.Now().Unix())
+	if parent.Time >= timestamp {
+		timestamp = parent.Time + 1
+	}
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   core.CalcGasLimit(parent.GasLimit, w.getGasCeil()),
+		Time:       timestamp,
+		Coinbase:   w.etherbase(),
+		Difficulty: new(big.Int).Set(parent.Difficulty),
+	}
+	if w.chainConfig.IsLondon(header.Number) {
+		header.BaseFee = eip1559.CalcBaseFee(w.chainConfig, parent)
+	}
+	return header
+}
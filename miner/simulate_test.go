@@ -0,0 +1,124 @@
+package miner
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that simulated sequences follow the inclusion rules of the worker and
+// report the execution outcome of every transaction.
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	w, b := newTestWorker(t, ethashChainConfig, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	var (
+		signer   = types.LatestSigner(ethashChainConfig)
+		gasPrice = big.NewInt(params.InitialBaseFee)
+		contract = crypto.CreateAddress(testBankAddress, 1)
+	)
+	sign := func(key []byte, tx types.TxData) *types.Transaction {
+		k, _ := crypto.ToECDSA(key)
+		return types.MustSignNewTx(k, signer, tx)
+	}
+	bank, user := crypto.FromECDSA(testBankKey), crypto.FromECDSA(testUserKey)
+	txs := []*types.Transaction{
+		sign(bank, &types.LegacyTx{Nonce: 0, To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: gasPrice}),
+		sign(bank, &types.LegacyTx{Nonce: 1, Value: big.NewInt(0), Gas: testGas, GasPrice: gasPrice, Data: common.FromHex(testCode)}),
+		sign(bank, &types.LegacyTx{Nonce: 1, To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: gasPrice}),
+		sign(bank, &types.LegacyTx{Nonce: 2, To: &contract, Value: big.NewInt(0), Gas: 100000, GasPrice: gasPrice, Data: []byte{0xde, 0xad, 0xbe, 0xef}}),
+		sign(user, &types.LegacyTx{Nonce: 0, To: &testBankAddress, Value: big.NewInt(1), Gas: params.TxGas, GasPrice: gasPrice}),
+		sign(user, &types.LegacyTx{Nonce: 1, To: &testBankAddress, Value: big.NewInt(1), Gas: params.TxGas, GasPrice: gasPrice}),
+	}
+	head := b.chain.GetBlockByHash(b.chain.CurrentBlock().Hash())
+	statedb, _ := b.chain.StateAt(head.Root())
+
+	var traced []int
+	results, err := w.simulate(head, statedb, txs, func(i int, tx *types.Transaction) vm.EVMLogger {
+		if tx != txs[i] {
+			t.Errorf("transaction %d: traced transaction mismatch", i)
+		}
+		traced = append(traced, i)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	// The user can't pay for its first transaction, skipping the following one
+	wantErrs := []error{nil, nil, core.ErrNonceTooLow, nil, core.ErrInsufficientFunds, errSenderSkipped}
+	for i, res := range results {
+		if !errors.Is(res.Err, wantErrs[i]) {
+			t.Fatalf("transaction %d: error mismatch: have %v, want %v", i, res.Err, wantErrs[i])
+		}
+		if (res.Receipt != nil) != (wantErrs[i] == nil) {
+			t.Fatalf("transaction %d: inclusion mismatch: have %v", i, res.Receipt != nil)
+		}
+	}
+	if results[1].Receipt.ContractAddress != contract || results[1].Receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("contract creation mismatch: have %x (status %d)", results[1].Receipt.ContractAddress, results[1].Receipt.Status)
+	}
+	if results[3].Receipt.Status != types.ReceiptStatusFailed || !errors.Is(results[3].Result.Err, vm.ErrExecutionReverted) {
+		t.Fatalf("reverted call mismatch: status %d, err %v", results[3].Receipt.Status, results[3].Result.Err)
+	}
+	if results[3].Receipt.TransactionIndex != 2 {
+		t.Fatalf("transaction index mismatch: have %d, want %d", results[3].Receipt.TransactionIndex, 2)
+	}
+	// All but the skipped transaction reach the EVM
+	if want := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(traced, want) {
+		t.Fatalf("traced transactions mismatch: have %v, want %v", traced, want)
+	}
+	// The simulation must not leak into the chain state
+	if state, _ := b.chain.StateAt(head.Root()); state.GetNonce(testBankAddress) != 0 {
+		t.Fatalf("simulation modified the chain state")
+	}
+}
+
+// Tests that the transactions already included in the pending block are
+// simulated on top of its parent, instead of failing with a too low nonce.
+func TestSimulateIncludedInPending(t *testing.T) {
+	t.Parallel()
+
+	w, b := newTestWorker(t, ethashChainConfig, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	var (
+		signer = types.LatestSigner(ethashChainConfig)
+		tx     = types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 0, To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: big.NewInt(params.InitialBaseFee)})
+	)
+	head := b.chain.GetBlockByHash(b.chain.CurrentBlock().Hash())
+	statedb, _ := b.chain.StateAt(head.Root())
+	statedb.SetNonce(testBankAddress, 1)
+	pending := types.NewBlockWithHeader(w.simulationHeader(head.Header())).WithBody([]*types.Transaction{tx}, nil)
+
+	// Transactions the pending block doesn't include are simulated on top of it
+	block, _, err := w.simulationBase(pending, statedb, nil)
+	if err != nil || block != pending {
+		t.Fatalf("simulation base mismatch: have %v, want pending block (err %v)", block, err)
+	}
+	block, state, err := w.simulationBase(pending, statedb, []*types.Transaction{tx})
+	if err != nil {
+		t.Fatalf("failed to retrieve the simulation base: %v", err)
+	}
+	if block.Hash() != head.Hash() {
+		t.Fatalf("simulation base mismatch: have %x, want %x", block.Hash(), head.Hash())
+	}
+	results, err := w.simulate(block, state, []*types.Transaction{tx}, nil)
+	if err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	if results[0].Err != nil || results[0].Receipt == nil || results[0].Receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("included transaction not simulated: %v", results[0].Err)
+	}
+}
@@ -0,0 +1,261 @@
+package miner
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/consensus/parlia"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+)
+
+// Reasons for a pending transaction to be left out of a block template.
+const (
+	ExcludedUnderpriced     = "underpriced"     // Tip below the miner gas price, or fee cap below the base fee
+	ExcludedGasLimit        = "gasLimit"        // Not enough gas left in the block
+	ExcludedBlobGasLimit    = "blobGasLimit"    // Not enough blob gas left in the block
+	ExcludedEvicted         = "evicted"         // Dropped from the pool while the block was built
+	ExcludedReplayProtected = "replayProtected" // Replay protected before EIP-155 activation
+	ExcludedNonceTooLow     = "nonceTooLow"     // Nonce already used on top of the head state
+	ExcludedInvalid         = "invalid"         // Failed to apply on top of the block state
+	ExcludedSenderSkipped   = "senderSkipped"   // Follows a left out transaction of the same sender
+	ExcludedNotReached      = "notReached"      // Block building stopped before reaching it
+)
+
+// BlockTemplate is the block the worker would seal on top of the current head,
+// along with the pending transactions it would leave out.
+//
+// Transactions refused by the Chiliz EVM hooks are not left out: they are still
+// included as failed transactions consuming gas, and flagged as hook rejected.
+type BlockTemplate struct {
+	Header       *types.Header
+	Transactions []*TemplateTx   // Pool transactions in inclusion order
+	SystemTxs    []*TemplateTx   // Transactions appended by the consensus engine
+	SystemTxsErr error           // Reason the system transactions could not be projected
+	Excluded     []*ExcludedTx   // Pending transactions left out of the block
+	Reward       *big.Int        // Fees collected for the validator, its deposited share on Parlia
+	Rewards      *parlia.Rewards // Breakdown of the rewards paid out by the system transactions, nil if not projected
+}
+
+// TemplateTx is a transaction of a block template along with its receipt.
+type TemplateTx struct {
+	Tx           *types.Transaction
+	Receipt      *types.Receipt
+	Fee          *big.Int // Tip paid by the transaction to the block producer
+	Err          error    // Execution error of a failed transaction
+	HookRejected bool     // Whether the execution was refused by the Chiliz EVM hooks
+}
+
+// ExcludedTx is a pending transaction left out of a block template.
+type ExcludedTx struct {
+	Tx     *types.Transaction
+	Reason string
+	Err    error // Error returned when applying the transaction, if any
+}
+
+// exclusion is the recorded reason for a transaction to be left out.
+type exclusion struct {
+	reason string
+	err    error
+}
+
+// templateRecorder collects the outcome of the transactions considered while
+// building a block template.
+type templateRecorder struct {
+	excluded map[common.Hash]exclusion // Transactions left out by the worker
+	failures map[common.Hash]error     // Execution errors of the included transactions
+
+	header   *types.Header        // Header of the block before finalization
+	txs      []*types.Transaction // Pool transactions included in the block
+	receipts []*types.Receipt     // Receipts of the included pool transactions
+	reward   *big.Int             // Fees collected by the pool transactions
+
+	block       *types.Block     // Finalized block, nil if the engine failed
+	allReceipts []*types.Receipt // Receipts of all the transactions of the finalized block
+}
+
+func newTemplateRecorder() *templateRecorder {
+	return &templateRecorder{
+		excluded: make(map[common.Hash]exclusion),
+		failures: make(map[common.Hash]error),
+	}
+}
+
+// exclude records that a transaction was left out of the block.
+func (r *templateRecorder) exclude(hash common.Hash, reason string, err error) {
+	if r == nil {
+		return
+	}
+	r.excluded[hash] = exclusion{reason: reason, err: err}
+}
+
+// failed records the execution error of an included transaction.
+func (r *templateRecorder) failed(hash common.Hash, err error) {
+	if r == nil {
+		return
+	}
+	r.failures[hash] = err
+}
+
+// filled records the block content once filled with the pool transactions.
+func (r *templateRecorder) filled(env *environment, reward *uint256.Int) {
+	if r == nil {
+		return
+	}
+	r.header = types.CopyHeader(env.header)
+	r.txs = append([]*types.Transaction(nil), env.txs...)
+	r.receipts = copyReceipts(env.receipts)
+	r.reward = reward.ToBig()
+}
+
+// assembled records the block finalized by the consensus engine.
+func (r *templateRecorder) assembled(block *types.Block, receipts []*types.Receipt) {
+	if r == nil {
+		return
+	}
+	r.block, r.allReceipts = block, receipts
+}
+
+// PendingBlockTemplate builds the block the worker would seal right now on top
+// of the current head, without sealing it.
+func (miner *Miner) PendingBlockTemplate() (*BlockTemplate, error) {
+	return miner.worker.pendingBlockTemplate()
+}
+
+// pendingBlockTemplate builds a block template with the pending transactions
+// of the pool, reporting why the ones left out were not included.
+func (w *worker) pendingBlockTemplate() (*BlockTemplate, error) {
+	recorder := newTemplateRecorder()
+	res := w.getSealingBlock(&generateParams{
+		timestamp: uint64(time.Now().Unix()),
+		coinbase:  w.etherbase(),
+		template:  recorder,
+	})
+	if recorder.header == nil {
+		if res.err == nil {
+			res.err = errors.New("block template not built")
+		}
+		return nil, res.err
+	}
+	template := &BlockTemplate{
+		Header: recorder.header,
+		Reward: recorder.reward,
+	}
+	for i, tx := range recorder.txs {
+		template.Transactions = append(template.Transactions, newTemplateTx(tx, recorder.receipts[i], recorder.header.BaseFee, recorder.failures[tx.Hash()]))
+	}
+	// Project the system transactions if the engine managed to finalize the block
+	if recorder.block == nil {
+		template.SystemTxsErr = res.err
+	} else {
+		template.Header = recorder.block.Header()
+		for i, tx := range recorder.block.Transactions()[len(recorder.txs):] {
+			var receipt *types.Receipt
+			if n := len(recorder.txs) + i; n < len(recorder.allReceipts) {
+				receipt = recorder.allReceipts[n]
+			}
+			template.SystemTxs = append(template.SystemTxs, newTemplateTx(tx, receipt, recorder.header.BaseFee, nil))
+		}
+		// On Parlia, the validator is paid the fees deposited by the system transactions
+		if p, ok := w.engine.(*parlia.Parlia); ok {
+			rewards, err := p.BlockRewards(recorder.block, recorder.allReceipts)
+			if err != nil {
+				template.SystemTxsErr = err
+			} else {
+				template.Rewards = &rewards.Rewards
+				template.Reward = rewards.ValidatorDeposit.ToInt()
+			}
+		}
+	}
+	template.Excluded = w.excludedTransactions(recorder)
+	return template, nil
+}
+
+// newTemplateTx wraps an included transaction of a block template.
+func newTemplateTx(tx *types.Transaction, receipt *types.Receipt, baseFee *big.Int, err error) *TemplateTx {
+	fee := new(big.Int)
+	if tip, err := tx.EffectiveGasTip(baseFee); err == nil && receipt != nil {
+		fee.Mul(tip, new(big.Int).SetUint64(receipt.GasUsed))
+	}
+	return &TemplateTx{
+		Tx:           tx,
+		Receipt:      receipt,
+		Fee:          fee,
+		Err:          err,
+		HookRejected: errors.Is(err, vm.ErrNotAllowed),
+	}
+}
+
+// excludedTransactions lists the pending transactions of the pool left out of
+// a block template, sorted by sender and nonce.
+func (w *worker) excludedTransactions(recorder *templateRecorder) []*ExcludedTx {
+	w.mu.RLock()
+	minTip := w.tip
+	w.mu.RUnlock()
+
+	var (
+		baseFee = recorder.header.BaseFee
+		blobFee *big.Int
+		locals  = make(map[common.Address]bool)
+	)
+	if excess := recorder.header.ExcessBlobGas; excess != nil {
+		blobFee = eip4844.CalcBlobFee(*excess)
+	}
+	for _, account := range w.eth.TxPool().Locals() {
+		locals[account] = true
+	}
+	included := make(map[common.Hash]bool, len(recorder.txs))
+	for _, tx := range recorder.txs {
+		included[tx.Hash()] = true
+	}
+	pending := w.eth.TxPool().Pending(txpool.PendingFilter{OnlyPlainTxs: true})
+	for addr, txs := range w.eth.TxPool().Pending(txpool.PendingFilter{OnlyBlobTxs: true}) {
+		pending[addr] = append(pending[addr], txs...)
+	}
+	senders := make([]common.Address, 0, len(pending))
+	for addr := range pending {
+		senders = append(senders, addr)
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i][:], senders[j][:]) < 0
+	})
+	var excluded []*ExcludedTx
+	for _, addr := range senders {
+		skipped := false
+		for _, ltx := range pending[addr] {
+			if included[ltx.Hash] {
+				continue
+			}
+			tx := ltx.Resolve()
+			if tx == nil {
+				continue
+			}
+			entry := &ExcludedTx{Tx: tx}
+			switch ex, ok := recorder.excluded[ltx.Hash]; {
+			case skipped:
+				entry.Reason = ExcludedSenderSkipped
+
+			case ok:
+				entry.Reason, entry.Err = ex.reason, ex.err
+				skipped = ex.reason != ExcludedNonceTooLow
+
+			case baseFee != nil && tx.GasFeeCap().Cmp(baseFee) < 0,
+				minTip != nil && !locals[addr] && tx.EffectiveGasTipIntCmp(minTip.ToBig(), baseFee) < 0,
+				blobFee != nil && tx.Type() == types.BlobTxType && tx.BlobGasFeeCap().Cmp(blobFee) < 0:
+				entry.Reason, skipped = ExcludedUnderpriced, true
+
+			default:
+				entry.Reason, skipped = ExcludedNotReached, true
+			}
+			excluded = append(excluded, entry)
+		}
+	}
+	return excluded
+}
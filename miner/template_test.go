@@ -0,0 +1,124 @@
+package miner
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/parlia"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that block templates report the included transactions along with the
+// pending ones left out and the reason.
+func TestPendingBlockTemplate(t *testing.T) {
+	t.Parallel()
+
+	w, b := newTestWorker(t, ethashChainConfig, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 0)
+	defer w.close()
+
+	var (
+		signer   = types.LatestSigner(ethashChainConfig)
+		gasPrice = big.NewInt(params.InitialBaseFee)
+	)
+	// The first pending transaction of the bank is included, the contract creation
+	// too, but the next one can't pay the base fee, skipping the last one
+	txs := []*types.Transaction{
+		types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 1, Value: big.NewInt(0), Gas: testGas, GasPrice: gasPrice, Data: common.FromHex(testCode)}),
+		types.MustSignNewTx(testBankKey, signer, &types.DynamicFeeTx{ChainID: ethashChainConfig.ChainID, Nonce: 2, To: &testUserAddress, Value: big.NewInt(1), Gas: params.TxGas, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1)}),
+		types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 3, To: &testUserAddress, Value: big.NewInt(1), Gas: params.TxGas, GasPrice: gasPrice}),
+	}
+	for i, err := range b.txPool.Add(txs, true, true) {
+		if err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+	template, err := w.pendingBlockTemplate()
+	if err != nil {
+		t.Fatalf("failed to build template: %v", err)
+	}
+	if template.Header.Number.Uint64() != 1 {
+		t.Fatalf("template number mismatch: have %d, want %d", template.Header.Number, 1)
+	}
+	want := []common.Hash{pendingTxs[0].Hash(), txs[0].Hash()}
+	if len(template.Transactions) != len(want) {
+		t.Fatalf("included transactions mismatch: have %d, want %d", len(template.Transactions), len(want))
+	}
+	for i, tx := range template.Transactions {
+		if tx.Tx.Hash() != want[i] {
+			t.Errorf("transaction %d: hash mismatch: have %x, want %x", i, tx.Tx.Hash(), want[i])
+		}
+		if tx.Receipt == nil || tx.Receipt.Status != types.ReceiptStatusSuccessful {
+			t.Errorf("transaction %d: missing successful receipt", i)
+			continue
+		}
+		tip, _ := tx.Tx.EffectiveGasTip(template.Header.BaseFee)
+		if fee := new(big.Int).Mul(tip, new(big.Int).SetUint64(tx.Receipt.GasUsed)); tx.Fee.Cmp(fee) != 0 {
+			t.Errorf("transaction %d: fee mismatch: have %v, want %v", i, tx.Fee, fee)
+		}
+	}
+	if len(template.SystemTxs) != 0 || template.SystemTxsErr != nil {
+		t.Errorf("unexpected system transactions: %d, err %v", len(template.SystemTxs), template.SystemTxsErr)
+	}
+	wantExcluded := map[common.Hash]string{
+		txs[1].Hash(): ExcludedUnderpriced,
+		txs[2].Hash(): ExcludedSenderSkipped,
+	}
+	if len(template.Excluded) != len(wantExcluded) {
+		t.Fatalf("excluded transactions mismatch: have %d, want %d", len(template.Excluded), len(wantExcluded))
+	}
+	for _, ex := range template.Excluded {
+		if reason := wantExcluded[ex.Tx.Hash()]; ex.Reason != reason {
+			t.Errorf("transaction %x: exclusion reason mismatch: have %s, want %s", ex.Tx.Hash(), ex.Reason, reason)
+		}
+	}
+	// Building a template must not seal anything
+	if head := b.chain.CurrentBlock(); head.Number.Uint64() != 0 {
+		t.Fatalf("template sealed a block: head %d", head.Number)
+	}
+}
+
+// Tests that on Parlia, block templates report the rewards paid out by the system
+// transactions, the validator being paid its deposited share of the fees.
+func TestPendingBlockTemplateParlia(t *testing.T) {
+	t.Parallel()
+
+	var (
+		config = *params.ParliaTestChainConfig
+		db     = rawdb.NewMemoryDatabase()
+		engine = parlia.New(&config, db, nil, common.Hash{})
+	)
+	w, b := newTestWorker(t, &config, engine, db, 0)
+	defer w.close()
+
+	signer := types.LatestSigner(&config)
+	tx := types.MustSignNewTx(testBankKey, signer, &types.LegacyTx{Nonce: 0, To: &testUserAddress, Value: big.NewInt(1), Gas: params.TxGas, GasPrice: big.NewInt(10 * params.InitialBaseFee)})
+	if err := b.txPool.Add([]*types.Transaction{tx}, true, true)[0]; err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	template, err := w.pendingBlockTemplate()
+	if err != nil {
+		t.Fatalf("failed to build template: %v", err)
+	}
+	if template.SystemTxsErr != nil || len(template.SystemTxs) == 0 {
+		t.Fatalf("system transactions not projected: %d, err %v", len(template.SystemTxs), template.SystemTxsErr)
+	}
+	if len(template.Transactions) != 1 || template.Transactions[0].Tx.Hash() != tx.Hash() {
+		t.Fatalf("included transactions mismatch: have %d", len(template.Transactions))
+	}
+	fees := template.Transactions[0].Fee
+	if fees.Sign() <= 0 {
+		t.Fatalf("transaction paid no fees")
+	}
+	if template.Rewards == nil || template.Rewards.Fees.ToInt().Cmp(fees) != 0 {
+		t.Fatalf("rewards fees mismatch: have %v, want %v", template.Rewards, fees)
+	}
+	// A fifth of the fees goes to the system reward contract, the rest to the validator
+	deposit := new(big.Int).Sub(fees, new(big.Int).Div(fees, big.NewInt(5)))
+	if template.Reward.Cmp(deposit) != 0 || template.Rewards.ValidatorDeposit.ToInt().Cmp(deposit) != 0 {
+		t.Fatalf("validator reward mismatch: have %v, want %v", template.Reward, deposit)
+	}
+}
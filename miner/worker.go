@@ -97,6 +97,8 @@ type environment struct {
 	receipts []*types.Receipt
 	sidecars types.BlobSidecars
 	blobs    int
+
+	template *templateRecorder // Recorder of the left out transactions, nil unless inspecting a template
 }
 
 // copy creates a deep copy of environment.
@@ -175,6 +177,7 @@ type getWorkReq struct {
 type bidFetcher interface {
 	GetBestBid(parentHash common.Hash) *BidRuntime
 	GetSimulatingBid(prevBlockHash common.Hash) *BidRuntime
+	SettleBid(bid *BidRuntime, won bool, localReward *big.Int)
 }
 
 // worker is the main object which takes care of submitting new work to consensus engine
@@ -237,6 +240,9 @@ type worker struct {
 	// payload in proof-of-stake stage.
 	recommit time.Duration
 
+	// ordering creates the policy sorting the pending transactions of a block.
+	ordering func() orderingPolicy
+
 	// External functions
 	isLocalBlock func(header *types.Header) bool // Function used to determine whether the specified block is mined by local miner.
 
@@ -295,6 +301,14 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 	}
 	worker.newpayloadTimeout = newpayloadTimeout
 
+	// Sanitize the transaction ordering policy.
+	ordering, err := newOrderingPolicy(worker.config.Ordering, worker.config.OrderingSenderCap)
+	if err != nil {
+		log.Warn("Sanitizing miner transaction ordering", "err", err, "updated", OrderingPrice)
+		ordering, _ = newOrderingPolicy(OrderingPrice, 0)
+	}
+	worker.ordering = ordering
+
 	worker.wg.Add(4)
 	go worker.mainLoop()
 	go worker.newWorkLoop(recommit)
@@ -770,17 +784,28 @@ func (w *worker) commitBlobTransaction(env *environment, tx *types.Transaction,
 
 // applyTransaction runs the transaction. If execution fails, state and gas pool are reverted.
 func (w *worker) applyTransaction(env *environment, tx *types.Transaction, receiptProcessors ...core.ReceiptProcessor) (*types.Receipt, error) {
+	receipt, _, err := w.applyTransactionWithConfig(env, tx, *w.chain.GetVMConfig(), receiptProcessors...)
+	return receipt, err
+}
+
+// applyTransactionWithConfig is like applyTransaction, but it runs the transaction
+// with the given EVM config and also returns its execution result.
+func (w *worker) applyTransactionWithConfig(env *environment, tx *types.Transaction, config vm.Config, receiptProcessors ...core.ReceiptProcessor) (*types.Receipt, *core.ExecutionResult, error) {
 	var (
 		snap = env.state.Snapshot()
 		gp   = env.gasPool.Gas()
 	)
 
-	receipt, err := core.ApplyTransaction(w.chainConfig, w.chain, &env.coinbase, env.gasPool, env.state, env.header, tx, &env.header.GasUsed, *w.chain.GetVMConfig(), receiptProcessors...)
+	receipt, result, err := core.ApplyTransactionWithResult(w.chainConfig, w.chain, &env.coinbase, env.gasPool, env.state, env.header, tx, &env.header.GasUsed, config, receiptProcessors...)
 	if err != nil {
 		env.state.RevertToSnapshot(snap)
 		env.gasPool.SetGas(gp)
+		return receipt, nil, err
 	}
-	return receipt, err
+	if env.template != nil && result.Err != nil {
+		env.template.failed(tx.Hash(), result.Err)
+	}
+	return receipt, result, err
 }
 
 func (w *worker) commitTransactions(env *environment, plainTxs, blobTxs *transactionsByPriceAndNonce,
@@ -858,8 +883,8 @@ LOOP:
 			ltx *txpool.LazyTransaction
 			txs *transactionsByPriceAndNonce
 		)
-		pltx, ptip := plainTxs.Peek()
-		bltx, btip := blobTxs.Peek()
+		pltx, _ := plainTxs.Peek()
+		bltx, _ := blobTxs.Peek()
 
 		switch {
 		case pltx == nil:
@@ -867,7 +892,7 @@ LOOP:
 		case bltx == nil:
 			txs, ltx = plainTxs, pltx
 		default:
-			if ptip.Lt(btip) {
+			if blobTxs.Before(plainTxs) {
 				txs, ltx = blobTxs, bltx
 			} else {
 				txs, ltx = plainTxs, pltx
@@ -880,11 +905,13 @@ LOOP:
 		// If we don't have enough space for the next transaction, skip the account.
 		if env.gasPool.Gas() < ltx.Gas {
 			log.Trace("Not enough gas left for transaction", "hash", ltx.Hash, "left", env.gasPool.Gas(), "needed", ltx.Gas)
+			env.template.exclude(ltx.Hash, ExcludedGasLimit, nil)
 			txs.Pop()
 			continue
 		}
 		if left := uint64(params.MaxBlobGasPerBlock - env.blobs*params.BlobTxBlobGasPerBlob); left < ltx.BlobGas {
 			log.Trace("Not enough blob gas left for transaction", "hash", ltx.Hash, "left", left, "needed", ltx.BlobGas)
+			env.template.exclude(ltx.Hash, ExcludedBlobGasLimit, nil)
 			txs.Pop()
 			continue
 		}
@@ -892,6 +919,7 @@ LOOP:
 		tx := ltx.Resolve()
 		if tx == nil {
 			log.Trace("Ignoring evicted transaction", "hash", ltx.Hash)
+			env.template.exclude(ltx.Hash, ExcludedEvicted, nil)
 			txs.Pop()
 			continue
 		}
@@ -903,6 +931,7 @@ LOOP:
 		// phase, start ignoring the sender until we do.
 		if tx.Protected() && !w.chainConfig.IsEIP155(env.header.Number) {
 			log.Trace("Ignoring replay protected transaction", "hash", ltx.Hash, "eip155", w.chainConfig.EIP155Block)
+			env.template.exclude(ltx.Hash, ExcludedReplayProtected, nil)
 			txs.Pop()
 			continue
 		}
@@ -914,6 +943,7 @@ LOOP:
 		case errors.Is(err, core.ErrNonceTooLow):
 			// New head notification data race between the transaction pool and miner, shift
 			log.Trace("Skipping transaction with low nonce", "hash", ltx.Hash, "sender", from, "nonce", tx.Nonce())
+			env.template.exclude(ltx.Hash, ExcludedNonceTooLow, err)
 			txs.Shift()
 
 		case errors.Is(err, nil):
@@ -926,6 +956,7 @@ LOOP:
 			// Transaction is regarded as invalid, drop all consecutive transactions from
 			// the same sender because of `nonce-too-high` clause.
 			log.Debug("Transaction failed, account skipped", "hash", ltx.Hash, "err", err)
+			env.template.exclude(ltx.Hash, ExcludedInvalid, err)
 			txs.Pop()
 		}
 	}
@@ -957,8 +988,9 @@ type generateParams struct {
 	random      common.Hash       // The randomness generated by beacon chain, empty before the merge
 	withdrawals types.Withdrawals // List of withdrawals to include in block.
 	prevWork    *environment
-	beaconRoot  *common.Hash // The beacon root (cancun field).
-	noTxs       bool         // Flag whether an empty block without any transaction is expected
+	beaconRoot  *common.Hash      // The beacon root (cancun field).
+	noTxs       bool              // Flag whether an empty block without any transaction is expected
+	template    *templateRecorder // Recorder of the block template, nil unless inspecting it
 }
 
 // prepareWork constructs the sealing task according to the given parameters,
@@ -1125,16 +1157,16 @@ func (w *worker) fillTransactions(interruptCh chan int32, env *environment, stop
 	//   4.interrupted resubmit timer, which is by default 10s.
 	//     resubmit is for PoW only, can be deleted for PoS consensus later
 	if len(localPlainTxs) > 0 || len(localBlobTxs) > 0 {
-		plainTxs := newTransactionsByPriceAndNonce(env.signer, localPlainTxs, env.header.BaseFee)
-		blobTxs := newTransactionsByPriceAndNonce(env.signer, localBlobTxs, env.header.BaseFee)
+		plainTxs := newTransactionsByPolicy(env.signer, localPlainTxs, env.header.BaseFee, w.ordering())
+		blobTxs := newTransactionsByPolicy(env.signer, localBlobTxs, env.header.BaseFee, w.ordering())
 
 		if err := w.commitTransactions(env, plainTxs, blobTxs, interruptCh, stopTimer); err != nil {
 			return err
 		}
 	}
 	if len(remotePlainTxs) > 0 || len(remoteBlobTxs) > 0 {
-		plainTxs := newTransactionsByPriceAndNonce(env.signer, remotePlainTxs, env.header.BaseFee)
-		blobTxs := newTransactionsByPriceAndNonce(env.signer, remoteBlobTxs, env.header.BaseFee)
+		plainTxs := newTransactionsByPolicy(env.signer, remotePlainTxs, env.header.BaseFee, w.ordering())
+		blobTxs := newTransactionsByPolicy(env.signer, remoteBlobTxs, env.header.BaseFee, w.ordering())
 
 		if err := w.commitTransactions(env, plainTxs, blobTxs, interruptCh, stopTimer); err != nil {
 			return err
@@ -1151,6 +1183,7 @@ func (w *worker) generateWork(params *generateParams) *newPayloadResult {
 		return &newPayloadResult{err: err}
 	}
 	defer work.discard()
+	work.template = params.template
 
 	if !params.noTxs {
 		err := w.fillTransactions(nil, work, nil, nil)
@@ -1159,10 +1192,13 @@ func (w *worker) generateWork(params *generateParams) *newPayloadResult {
 		}
 	}
 	fees := work.state.GetBalance(consensus.SystemAddress)
-	block, _, err := w.engine.FinalizeAndAssemble(w.chain, work.header, work.state, work.txs, nil, work.receipts, params.withdrawals)
+	work.template.filled(work, fees)
+
+	block, receipts, err := w.engine.FinalizeAndAssemble(w.chain, work.header, work.state, work.txs, nil, work.receipts, params.withdrawals)
 	if err != nil {
 		return &newPayloadResult{err: err}
 	}
+	work.template.assembled(block, receipts)
 
 	return &newPayloadResult{
 		block:    block,
@@ -1359,6 +1395,7 @@ LOOP:
 				"bidBlockReward", bestBid.packedBlockReward.String())
 		}
 
+		localReward := bestReward.ToBig()
 		if bestBid != nil && bestReward.CmpBig(bestBid.packedBlockReward) < 0 {
 			// localValidatorReward is the reward for the validator self by the local block.
 			localValidatorReward := new(uint256.Int).Mul(bestReward, uint256.NewInt(w.config.Mev.ValidatorCommission))
@@ -1382,6 +1419,9 @@ LOOP:
 				)
 			}
 		}
+		if bestBid != nil {
+			w.bidFetcher.SettleBid(bestBid, bestWork == bestBid.env, localReward)
+		}
 	}
 
 	metrics.GetOrRegisterCounter(fmt.Sprintf("block/from/%v", from), nil).Inc(1)
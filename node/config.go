@@ -235,6 +235,11 @@ type Config struct {
 	// JWTSecret is the path to the hex-encoded jwt secret.
 	JWTSecret string `toml:",omitempty"`
 
+	// RPCAccessRules restricts the methods the clients of the HTTP and WebSocket
+	// RPC endpoints may call, along with their rate and batch limits. Once set,
+	// the clients matching no rule are rejected.
+	RPCAccessRules []RPCAccessRule `toml:",omitempty"`
+
 	// EnablePersonal enables the deprecated personal namespace.
 	EnablePersonal bool `toml:"-"`
 
@@ -386,6 +386,22 @@ func (n *Node) closeDataDir() {
 	}
 }
 
+// rpcAccessControl creates the access control of the HTTP and WebSocket RPC
+// clients, nil if no access rule is configured.
+func (n *Node) rpcAccessControl() (*rpcAccessControl, error) {
+	var secret []byte
+	for _, rule := range n.config.RPCAccessRules {
+		if len(rule.JWTClaims) > 0 {
+			var err error
+			if secret, err = n.obtainJWTSecret(n.config.JWTSecret); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return newRPCAccessControl(n.config.RPCAccessRules, secret)
+}
+
 // obtainJWTSecret loads the jwt-secret, either from the provided config,
 // or from the default location. If neither of those are present, it generates
 // a new secret and stores to the default location.
@@ -451,9 +467,14 @@ func (n *Node) startRPC() error {
 		openAPIs, allAPIs = n.getAPIs()
 	)
 
+	access, err := n.rpcAccessControl()
+	if err != nil {
+		return err
+	}
 	rpcConfig := rpcEndpointConfig{
 		batchItemLimit:         n.config.BatchRequestLimit,
 		batchResponseSizeLimit: n.config.BatchResponseMaxSize,
+		access:                 access,
 	}
 
 	initHttp := func(server *httpServer, port int) error {
@@ -0,0 +1,275 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// apiKeyHeader is the HTTP header carrying the API key of a client. Clients
+	// unable to set headers, e.g. browser websockets, can use the apiKeyParam
+	// query parameter instead.
+	apiKeyHeader = "X-API-Key"
+	apiKeyParam  = "apikey"
+
+	errcodeMethodDenied = -32004
+	errcodeRateLimited  = -32005
+)
+
+// RPCAccessRule grants the RPC clients it matches access to a subset of the
+// methods exposed over HTTP and WebSocket, with their own limits.
+//
+// Clients are matched by API key or JWT claims, a rule without any of them nor
+// origins being the default for all the other clients. The first matching rule
+// applies, clients matching no rule are rejected. Rules listing the origin of a
+// browser request apply on top of the rule of the client, only narrowing its
+// access as origins are trivially forged by other clients.
+type RPCAccessRule struct {
+	Name      string            // Name of the rule, used in logs
+	APIKeys   []string          `toml:",omitempty"` // API keys sent in the X-API-Key header or the apikey query parameter
+	JWTClaims map[string]string `toml:",omitempty"` // Claims of a JWT token signed with the node secret
+	Origins   []string          `toml:",omitempty"` // Origins sent by browsers
+
+	Allow []string `toml:",omitempty"` // Allowed methods, "eth_*" allows a namespace, none allows all
+	Deny  []string `toml:",omitempty"` // Denied methods, taking precedence over the allowed ones
+
+	RateLimit            float64 `toml:",omitempty"` // Calls per second per API key or origin, zero means unlimited
+	RateBurst            int     `toml:",omitempty"` // Calls allowed in a burst, defaults to the rate limit
+	BatchRequestLimit    int     `toml:",omitempty"` // Maximum number of requests in a batch, on top of the server limit
+	BatchResponseMaxSize int     `toml:",omitempty"` // Maximum number of bytes returned from a batch, on top of the server limit
+}
+
+// rpcAccessError is returned to the clients calling a method they are not
+// allowed to.
+type rpcAccessError struct {
+	code int
+	msg  string
+}
+
+func (e *rpcAccessError) Error() string  { return e.msg }
+func (e *rpcAccessError) ErrorCode() int { return e.code }
+
+// rpcAccessControl resolves the access policy of the RPC clients, tracking the
+// rate limits of every client.
+type rpcAccessControl struct {
+	rules     []RPCAccessRule
+	keys      map[string]int // API key -> index of the rule
+	jwtSecret []byte         // secret verifying the tokens matched by claims
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter // rule and client identity -> rate limiter
+}
+
+// newRPCAccessControl validates the rules and creates their access control,
+// nil if there are no rules.
+func newRPCAccessControl(rules []RPCAccessRule, jwtSecret []byte) (*rpcAccessControl, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	ac := &rpcAccessControl{
+		rules:     rules,
+		keys:      make(map[string]int),
+		jwtSecret: jwtSecret,
+		limiters:  make(map[string]*rate.Limiter),
+	}
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("RPC access rule %d has no name", i)
+		}
+		if rule.RateLimit < 0 || rule.RateBurst < 0 || rule.BatchRequestLimit < 0 || rule.BatchResponseMaxSize < 0 {
+			return nil, fmt.Errorf("RPC access rule %q has negative limits", rule.Name)
+		}
+		if len(rule.JWTClaims) > 0 && len(jwtSecret) == 0 {
+			return nil, fmt.Errorf("RPC access rule %q matches JWT claims without JWT secret", rule.Name)
+		}
+		for _, key := range rule.APIKeys {
+			if key == "" {
+				return nil, fmt.Errorf("RPC access rule %q has an empty API key", rule.Name)
+			}
+			if j, ok := ac.keys[key]; ok {
+				return nil, fmt.Errorf("API key of RPC access rule %q already used by rule %q", rule.Name, rules[j].Name)
+			}
+			ac.keys[key] = i
+		}
+	}
+	return ac, nil
+}
+
+// policy returns the access policy of the client making the request. Clients
+// are identified by API key or JWT claims, the others falling back to the
+// default rules matching all clients; a client matching no rule is rejected.
+// Origins are easily forged by non-browser clients, so the rule matching the
+// origin of the request can only narrow the access granted to the client.
+func (ac *rpcAccessControl) policy(r *http.Request) (*rpcAccessPolicy, error) {
+	base, err := ac.clientPolicy(r)
+	if err != nil {
+		return nil, err
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		for i, rule := range ac.rules {
+			if &ac.rules[i] != base.rule && slices.Contains(rule.Origins, origin) {
+				base.origin = ac.newPolicy(i, "origin:"+origin)
+				break
+			}
+		}
+	}
+	return base, nil
+}
+
+// clientPolicy returns the access policy of the client identified by API key or
+// JWT claims, or the one of the first default rule.
+func (ac *rpcAccessControl) clientPolicy(r *http.Request) (*rpcAccessPolicy, error) {
+	key := r.Header.Get(apiKeyHeader)
+	if key == "" {
+		key = r.URL.Query().Get(apiKeyParam)
+	}
+	if key != "" {
+		i, ok := ac.keys[key]
+		if !ok {
+			return nil, errors.New("unknown API key")
+		}
+		return ac.newPolicy(i, "key:"+key), nil
+	}
+	claims, err := ac.claims(r)
+	if err != nil {
+		return nil, err
+	}
+	for i, rule := range ac.rules {
+		switch {
+		case len(rule.JWTClaims) > 0 && claims != nil && matchClaims(rule.JWTClaims, claims):
+			return ac.newPolicy(i, "jwt"), nil
+		case len(rule.APIKeys) == 0 && len(rule.JWTClaims) == 0 && len(rule.Origins) == 0:
+			return ac.newPolicy(i, ""), nil
+		}
+	}
+	return nil, errors.New("no RPC access rule matches the client")
+}
+
+// claims returns the claims of the JWT token sent by the client, nil if there
+// is none or if no rule matches claims.
+func (ac *rpcAccessControl) claims(r *http.Request) (jwt.MapClaims, error) {
+	auth := r.Header.Get("Authorization")
+	if len(ac.jwtSecret) == 0 || !strings.HasPrefix(auth, "Bearer ") {
+		return nil, nil
+	}
+	var claims jwt.MapClaims
+	_, err := jwt.ParseWithClaims(strings.TrimPrefix(auth, "Bearer "), &claims, func(token *jwt.Token) (interface{}, error) {
+		return ac.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// newPolicy returns the access policy of the rule for the client with the given
+// identity, sharing the rate limit with the previous requests of the client.
+func (ac *rpcAccessControl) newPolicy(i int, identity string) *rpcAccessPolicy {
+	rule := &ac.rules[i]
+	policy := &rpcAccessPolicy{rule: rule}
+	if rule.RateLimit > 0 {
+		ac.lock.Lock()
+		defer ac.lock.Unlock()
+
+		id := rule.Name + "/" + identity
+		if policy.limiter = ac.limiters[id]; policy.limiter == nil {
+			burst := rule.RateBurst
+			if burst == 0 {
+				burst = max(int(rule.RateLimit), 1)
+			}
+			policy.limiter = rate.NewLimiter(rate.Limit(rule.RateLimit), burst)
+			ac.limiters[id] = policy.limiter
+		}
+	}
+	return policy
+}
+
+// rpcAccessPolicy is the access policy of an RPC client, implementing the
+// rpc.AccessPolicy interface.
+type rpcAccessPolicy struct {
+	rule    *RPCAccessRule
+	limiter *rate.Limiter    // nil if unlimited
+	origin  *rpcAccessPolicy // policy of the request origin narrowing this one, if any
+}
+
+// Authorize implements rpc.AccessPolicy, returning an error if the method isn't
+// allowed by the rules or if the client exceeded the rate limits.
+func (p *rpcAccessPolicy) Authorize(method string) error {
+	for policy := p; policy != nil; policy = policy.origin {
+		if matchMethod(policy.rule.Deny, method) || (len(policy.rule.Allow) > 0 && !matchMethod(policy.rule.Allow, method)) {
+			return &rpcAccessError{errcodeMethodDenied, fmt.Sprintf("method %s is not allowed", method)}
+		}
+	}
+	for policy := p; policy != nil; policy = policy.origin {
+		if policy.limiter != nil && !policy.limiter.Allow() {
+			return &rpcAccessError{errcodeRateLimited, "rate limit exceeded"}
+		}
+	}
+	return nil
+}
+
+// BatchLimits implements rpc.AccessPolicy, returning the tightest limits of the
+// rules.
+func (p *rpcAccessPolicy) BatchLimits() (int, int) {
+	requests, size := p.rule.BatchRequestLimit, p.rule.BatchResponseMaxSize
+	if p.origin != nil {
+		requests = rpc.TighterLimit(requests, p.origin.rule.BatchRequestLimit)
+		size = rpc.TighterLimit(size, p.origin.rule.BatchResponseMaxSize)
+	}
+	return requests, size
+}
+
+// matchMethod reports whether the method matches any of the patterns, either
+// exactly or by namespace if the pattern ends with a '*'.
+func matchMethod(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		} else if pattern == method {
+			return true
+		}
+	}
+	return false
+}
+
+// matchClaims reports whether the token carries all the claims of a rule.
+func matchClaims(want map[string]string, claims jwt.MapClaims) bool {
+	for name, value := range want {
+		if claim, ok := claims[name]; !ok || fmt.Sprint(claim) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// newAccessHandler returns a handler attaching the access policy of the client
+// to the request context, rejecting the requests of unknown clients.
+func newAccessHandler(ac *rpcAccessControl, next http.Handler) http.Handler {
+	if ac == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		policy, err := ac.policy(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if policy.origin != nil {
+			log.Trace("Resolved RPC access rules", "rule", policy.rule.Name, "origin", policy.origin.rule.Name, "remote", r.RemoteAddr)
+		} else {
+			log.Trace("Resolved RPC access rule", "rule", policy.rule.Name, "remote", r.RemoteAddr)
+		}
+		next.ServeHTTP(w, r.WithContext(rpc.WithAccessPolicy(r.Context(), policy)))
+	})
+}
@@ -18,6 +18,8 @@ package node
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -596,6 +598,132 @@ func TestHTTPWriteTimeout(t *testing.T) {
 	})
 }
 
+func TestRPCAccessRules(t *testing.T) {
+	secret := []byte("secret")
+	access, err := newRPCAccessControl([]RPCAccessRule{
+		{Name: "partner", APIKeys: []string{"key1"}, Allow: []string{"test_*"}, Deny: []string{"test_sleep"}, RateLimit: 1, RateBurst: 2},
+		{Name: "socket", APIKeys: []string{"key2"}, Deny: []string{"rpc_modules"}},
+		{Name: "dapp", Origins: []string{"https://dapp.example"}, Allow: []string{"rpc_modules"}, BatchRequestLimit: 1},
+		{Name: "admin", JWTClaims: map[string]string{"role": "admin"}},
+		{Name: "public", Deny: []string{"test_*"}},
+	}, secret)
+	if err != nil {
+		t.Fatalf("failed to create access control: %v", err)
+	}
+	cfg := rpcEndpointConfig{access: access}
+	srv := createAndStartServer(t, &httpConfig{rpcEndpointConfig: cfg}, true, &wsConfig{Origins: []string{"*"}, rpcEndpointConfig: cfg}, nil)
+	defer srv.stop()
+	url := "http://" + srv.listenAddr()
+
+	// call performs the request and returns the error code of the first response,
+	// zero if it succeeded, or the HTTP status if the request was rejected.
+	call := func(body string, headers ...string) int {
+		t.Helper()
+		resp := baseRpcRequest(t, url, body, headers...)
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode
+		}
+		var msgs []struct {
+			Error *struct{ Code int }
+		}
+		data, _ := io.ReadAll(resp.Body)
+		if !bytes.HasPrefix(data, []byte("[")) {
+			data = append(append([]byte("["), data...), ']')
+		}
+		if err := json.Unmarshal(data, &msgs); err != nil {
+			t.Fatalf("invalid response %s: %v", data, err)
+		}
+		if msgs[0].Error != nil {
+			return msgs[0].Error.Code
+		}
+		return 0
+	}
+	request := func(method string) string {
+		return fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"%s","params":[]}`, method)
+	}
+	// Methods are allowed and denied per rule, denied calls not counting in the
+	// rate limit
+	if code := call(request("test_greet"), "X-API-Key", "key1"); code != 0 {
+		t.Fatalf("allowed method failed: %d", code)
+	}
+	if code := call(request("test_sleep"), "X-API-Key", "key1"); code != errcodeMethodDenied {
+		t.Fatalf("denied method code mismatch: have %d, want %d", code, errcodeMethodDenied)
+	}
+	if code := call(request("rpc_modules"), "X-API-Key", "key1"); code != errcodeMethodDenied {
+		t.Fatalf("unlisted method code mismatch: have %d, want %d", code, errcodeMethodDenied)
+	}
+	if code := call(request("test_greet"), "X-API-Key", "key1"); code != 0 {
+		t.Fatalf("allowed method failed: %d", code)
+	}
+	if code := call(request("test_greet"), "X-API-Key", "key1"); code != errcodeRateLimited {
+		t.Fatalf("rate limited code mismatch: have %d, want %d", code, errcodeRateLimited)
+	}
+	if code := call(request("test_greet"), "X-API-Key", "unknown"); code != http.StatusUnauthorized {
+		t.Fatalf("unknown key status mismatch: have %d, want %d", code, http.StatusUnauthorized)
+	}
+	// Clients are matched by origin and claims, the others by the default rule
+	if code := call(request("rpc_modules"), "Origin", "https://dapp.example"); code != 0 {
+		t.Fatalf("allowed method failed: %d", code)
+	}
+	if code := call("["+request("rpc_modules")+","+request("rpc_modules")+"]", "Origin", "https://dapp.example"); code != -32600 {
+		t.Fatalf("batch limit code mismatch: have %d, want %d", code, -32600)
+	}
+	// Origins only narrow the access of the client they are sent by
+	if code := call(request("rpc_modules"), "Origin", "https://dapp.example", "X-API-Key", "key1"); code != errcodeMethodDenied {
+		t.Fatalf("origin widened access: have %d, want %d", code, errcodeMethodDenied)
+	}
+	if code := call(request("test_greet"), "Origin", "https://dapp.example", "X-API-Key", "key2"); code != errcodeMethodDenied {
+		t.Fatalf("origin didn't narrow access: have %d, want %d", code, errcodeMethodDenied)
+	}
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaim{"role": "admin"}).SignedString(secret)
+	if code := call(request("test_greet"), "Authorization", "Bearer "+token); code != 0 {
+		t.Fatalf("allowed method failed: %d", code)
+	}
+	token, _ = jwt.NewWithClaims(jwt.SigningMethodHS256, testClaim{"role": "admin"}).SignedString([]byte("other"))
+	if code := call(request("test_greet"), "Authorization", "Bearer "+token); code != http.StatusUnauthorized {
+		t.Fatalf("invalid token status mismatch: have %d, want %d", code, http.StatusUnauthorized)
+	}
+	if code := call(request("test_greet")); code != errcodeMethodDenied {
+		t.Fatalf("denied method code mismatch: have %d, want %d", code, errcodeMethodDenied)
+	}
+	// Without a default rule, the clients matching no rule are rejected
+	restricted, err := newRPCAccessControl([]RPCAccessRule{
+		{Name: "partner", APIKeys: []string{"key1"}},
+		{Name: "dapp", Origins: []string{"https://dapp.example"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to create access control: %v", err)
+	}
+	for _, headers := range [][]string{nil, {"Origin", "https://dapp.example"}} {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		for i := 0; i < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		if _, err := restricted.policy(req); err == nil {
+			t.Fatalf("unmatched client %v accepted", headers)
+		}
+	}
+	// Websocket clients pass the key as query parameter
+	var modules map[string]string
+	client, err := rpc.Dial("ws://" + srv.listenAddr())
+	if err != nil {
+		t.Fatalf("failed to dial websocket: %v", err)
+	}
+	defer client.Close()
+	if err := client.Call(&modules, "rpc_modules"); err != nil {
+		t.Fatalf("allowed method failed: %v", err)
+	}
+	client, err = rpc.Dial("ws://" + srv.listenAddr() + "?" + apiKeyParam + "=key2")
+	if err != nil {
+		t.Fatalf("failed to dial websocket: %v", err)
+	}
+	defer client.Close()
+	var rpcErr rpc.Error
+	if err := client.Call(&modules, "rpc_modules"); !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != errcodeMethodDenied {
+		t.Fatalf("denied method error mismatch: %v", err)
+	}
+}
+
 func apis() []rpc.API {
 	return []rpc.API{
 		{
@@ -520,6 +520,7 @@ type ChainConfig struct {
 	Dragon8FixTime         *uint64  `json:"dragon8FixTime,omitempty"`
 	Snake8Time             *uint64  `json:"snake8Time,omitempty"`
 	Pepper8Time            *uint64  `json:"pepper8Time,omitempty"`
+	ChainConfigParamsTime  *uint64  `json:"chainConfigParamsTime,omitempty"`
 
 	ShanghaiTime   *uint64 `json:"shanghaiTime,omitempty"`   // Shanghai switch time (nil = no fork, 0 = already on shanghai)
 	KeplerTime     *uint64 `json:"keplerTime,omitempty"`     // Kepler switch time (nil = no fork, 0 = already activated)
@@ -639,6 +640,11 @@ func (c *ChainConfig) String() string {
 		Pepper8Time = big.NewInt(0).SetUint64(*c.Pepper8Time)
 	}
 
+	var ChainConfigParamsTime *big.Int
+	if c.ChainConfigParamsTime != nil {
+		ChainConfigParamsTime = big.NewInt(0).SetUint64(*c.ChainConfigParamsTime)
+	}
+
 	var FeynmanTime *big.Int
 	if c.FeynmanTime != nil {
 		FeynmanTime = big.NewInt(0).SetUint64(*c.FeynmanTime)
@@ -669,7 +675,7 @@ func (c *ChainConfig) String() string {
 		BohrTime = big.NewInt(0).SetUint64(*c.BohrTime)
 	}
 
-	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Ramanujan: %v, Niels: %v, MirrorSync: %v, Bruno: %v, Berlin: %v, YOLO v3: %v, CatalystBlock: %v, London: %v, ArrowGlacier: %v, MergeFork:%v, Euler: %v, Gibbs: %v, Nano: %v, Moran: %v, Planck: %v,Luban: %v, Plato: %v, Hertz: %v, Hertzfix: %v, Dragon8Time: %v, Dragon8FixTime: %v, Snake8Time: %v, Pepper8Time: %v, ShanghaiTime: %v, KeplerTime: %v, FeynmanTime: %v, FeynmanFixTime: %v, CancunTime: %v, HaberTime: %v, HaberFixTime: %v, BohrTime: %v, Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v Constantinople: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Ramanujan: %v, Niels: %v, MirrorSync: %v, Bruno: %v, Berlin: %v, YOLO v3: %v, CatalystBlock: %v, London: %v, ArrowGlacier: %v, MergeFork:%v, Euler: %v, Gibbs: %v, Nano: %v, Moran: %v, Planck: %v,Luban: %v, Plato: %v, Hertz: %v, Hertzfix: %v, Dragon8Time: %v, Dragon8FixTime: %v, Snake8Time: %v, Pepper8Time: %v, ChainConfigParamsTime: %v, ShanghaiTime: %v, KeplerTime: %v, FeynmanTime: %v, FeynmanFixTime: %v, CancunTime: %v, HaberTime: %v, HaberFixTime: %v, BohrTime: %v, Engine: %v}",
 		c.ChainID,
 		c.HomesteadBlock,
 		c.DAOForkBlock,
@@ -705,6 +711,7 @@ func (c *ChainConfig) String() string {
 		Dragon8FixTime,
 		Snake8Time,
 		Pepper8Time,
+		ChainConfigParamsTime,
 		ShanghaiTime,
 		KeplerTime,
 		FeynmanTime,
@@ -737,6 +744,11 @@ func (c *ChainConfig) IsPepper8Time(time uint64) bool {
 	return isTimestampForked(c.Pepper8Time, time)
 }
 
+// IsChainConfigParams returns whether time is either equal to the fork time capturing the ChainConfig contract parameters in the epoch blocks or greater.
+func (c *ChainConfig) IsChainConfigParams(time uint64) bool {
+	return isTimestampForked(c.ChainConfigParamsTime, time)
+}
+
 // IsHomestead returns whether num is either equal to the homestead block or greater.
 func (c *ChainConfig) IsHomestead(num *big.Int) bool {
 	return isBlockForked(c.HomesteadBlock, num)
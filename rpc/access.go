@@ -0,0 +1,39 @@
+package rpc
+
+import "context"
+
+// AccessPolicy restricts the calls a client is allowed to make. A policy is
+// attached to the context of an HTTP request or websocket upgrade request with
+// WithAccessPolicy, and applies to all the calls made over it.
+type AccessPolicy interface {
+	// Authorize returns an error if the client may not call the method, either
+	// because the method is denied or because the client exceeded its rate limit.
+	Authorize(method string) error
+
+	// BatchLimits returns the batch limits of the client, applied on top of the
+	// limits of the server. Zero means no additional limit.
+	BatchLimits() (itemLimit, responseSizeLimit int)
+}
+
+type accessPolicyContextKey struct{}
+
+// WithAccessPolicy returns a copy of the context carrying the access policy of
+// the client.
+func WithAccessPolicy(ctx context.Context, policy AccessPolicy) context.Context {
+	return context.WithValue(ctx, accessPolicyContextKey{}, policy)
+}
+
+// accessPolicyFromContext returns the access policy of the client, nil if the
+// client is not restricted.
+func accessPolicyFromContext(ctx context.Context) AccessPolicy {
+	policy, _ := ctx.Value(accessPolicyContextKey{}).(AccessPolicy)
+	return policy
+}
+
+// TighterLimit returns the tighter of two limits, zero meaning no limit.
+func TighterLimit(a, b int) int {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
@@ -69,6 +69,7 @@ type handler struct {
 	allowSubscribe       bool
 	batchRequestLimit    int
 	batchResponseMaxSize int
+	policy               AccessPolicy // access policy of the remote client, nil if unrestricted
 
 	subLock    sync.Mutex
 	serverSubs map[ID]*Subscription
@@ -81,6 +82,12 @@ type callProc struct {
 
 func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry, batchRequestLimit, batchResponseMaxSize int) *handler {
 	rootCtx, cancelRoot := context.WithCancel(connCtx)
+	policy := accessPolicyFromContext(connCtx)
+	if policy != nil {
+		itemLimit, responseSizeLimit := policy.BatchLimits()
+		batchRequestLimit = TighterLimit(batchRequestLimit, itemLimit)
+		batchResponseMaxSize = TighterLimit(batchResponseMaxSize, responseSizeLimit)
+	}
 	h := &handler{
 		reg:                  reg,
 		idgen:                idgen,
@@ -94,6 +101,7 @@ func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *
 		log:                  log.Root(),
 		batchRequestLimit:    batchRequestLimit,
 		batchResponseMaxSize: batchResponseMaxSize,
+		policy:               policy,
 	}
 	if conn.remoteAddr() != "" {
 		h.log = h.log.New("conn", conn.remoteAddr())
@@ -506,6 +514,12 @@ func (h *handler) handleCallMsg(ctx *callProc, reqCtx context.Context, msg *json
 
 // handleCall processes method calls.
 func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
+	// Unsubscribing is always allowed, the subscription was authorized already.
+	if h.policy != nil && !msg.isUnsubscribe() {
+		if err := h.policy.Authorize(msg.Method); err != nil {
+			return msg.errorResponse(err)
+		}
+	}
 	if msg.isSubscribe() {
 		return h.handleSubscribe(cp, msg)
 	}
@@ -0,0 +1,297 @@
+package rpc
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// discoverMethod is the method name reserved by the OpenRPC specification for
+	// service discovery, served by RPCService.Discover.
+	discoverMethod = "rpc.discover"
+
+	openRPCVersion = "1.2.6"
+)
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// OpenRPCDocument is an OpenRPC document describing the methods offered by a
+// server, see https://spec.open-rpc.org.
+type OpenRPCDocument struct {
+	OpenRPC    string            `json:"openrpc"`
+	Info       OpenRPCInfo       `json:"info"`
+	Methods    []OpenRPCMethod   `json:"methods"`
+	Components OpenRPCComponents `json:"components"`
+}
+
+// OpenRPCInfo is the metadata of an OpenRPC document.
+type OpenRPCInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenRPCMethod describes a method, named after its namespace.
+type OpenRPCMethod struct {
+	Name          string                     `json:"name"`
+	Params        []OpenRPCContentDescriptor `json:"params"`
+	Result        *OpenRPCContentDescriptor  `json:"result"`
+	Subscriptions map[string]*OpenRPCSchema  `json:"x-subscriptions,omitempty"` // Parameters of every subscription of a *_subscribe method
+}
+
+// OpenRPCContentDescriptor describes a parameter or a result of a method.
+type OpenRPCContentDescriptor struct {
+	Name     string         `json:"name"`
+	Required bool           `json:"required,omitempty"`
+	Schema   *OpenRPCSchema `json:"schema"`
+}
+
+// OpenRPCComponents holds the schemas of the named types, referenced by the
+// methods.
+type OpenRPCComponents struct {
+	Schemas map[string]*OpenRPCSchema `json:"schemas"`
+}
+
+// OpenRPCSchema is the subset of JSON schema describing the Go types.
+type OpenRPCSchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Title                string                    `json:"title,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *OpenRPCSchema            `json:"items,omitempty"`
+	PrefixItems          []*OpenRPCSchema          `json:"prefixItems,omitempty"`
+	Properties           map[string]*OpenRPCSchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenRPCSchema            `json:"additionalProperties,omitempty"`
+}
+
+// Discover returns the OpenRPC document describing the methods and
+// subscriptions offered by the server. It is served as rpc.discover.
+func (s *RPCService) Discover() *OpenRPCDocument {
+	return s.server.services.openRPC()
+}
+
+// openRPC generates the OpenRPC document of the registered services by
+// reflecting over their callbacks.
+func (r *serviceRegistry) openRPC() *OpenRPCDocument {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	gen := &schemaGenerator{schemas: make(map[string]*OpenRPCSchema), names: make(map[reflect.Type]string)}
+	doc := &OpenRPCDocument{
+		OpenRPC: openRPCVersion,
+		Info:    OpenRPCInfo{Title: "JSON-RPC API", Version: "1.0.0"},
+		Methods: []OpenRPCMethod{},
+	}
+	namespaces := make([]string, 0, len(r.services))
+	for name := range r.services {
+		namespaces = append(namespaces, name)
+	}
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		svc := r.services[namespace]
+		for _, name := range sortedCallbacks(svc.callbacks) {
+			if namespace == MetadataApi && name == "discover" {
+				continue // described by the specification
+			}
+			cb := svc.callbacks[name]
+			doc.Methods = append(doc.Methods, OpenRPCMethod{
+				Name:   namespace + serviceMethodSeparator + name,
+				Params: gen.params(cb.argTypes),
+				Result: &OpenRPCContentDescriptor{Name: "result", Schema: gen.result(cb)},
+			})
+		}
+		if len(svc.subscriptions) == 0 {
+			continue
+		}
+		// Subscriptions are created through the *_subscribe method of the namespace,
+		// the parameters of every subscription are listed as an extension.
+		names := sortedCallbacks(svc.subscriptions)
+		subscribe := OpenRPCMethod{
+			Name: namespace + subscribeMethodSuffix,
+			Params: []OpenRPCContentDescriptor{
+				{Name: "subscription", Required: true, Schema: &OpenRPCSchema{Type: "string", Enum: names}},
+			},
+			Result:        &OpenRPCContentDescriptor{Name: "subscriptionId", Schema: &OpenRPCSchema{Type: "string"}},
+			Subscriptions: make(map[string]*OpenRPCSchema),
+		}
+		for _, name := range names {
+			params := &OpenRPCSchema{Type: "array"}
+			for _, param := range gen.params(svc.subscriptions[name].argTypes) {
+				params.PrefixItems = append(params.PrefixItems, param.Schema)
+			}
+			subscribe.Subscriptions[name] = params
+		}
+		unsubscribe := OpenRPCMethod{
+			Name: namespace + unsubscribeMethodSuffix,
+			Params: []OpenRPCContentDescriptor{
+				{Name: "subscriptionId", Required: true, Schema: &OpenRPCSchema{Type: "string"}},
+			},
+			Result: &OpenRPCContentDescriptor{Name: "result", Schema: &OpenRPCSchema{Type: "boolean"}},
+		}
+		doc.Methods = append(doc.Methods, subscribe, unsubscribe)
+	}
+	doc.Components.Schemas = gen.schemas
+	return doc
+}
+
+// sortedCallbacks returns the names of the callbacks in alphabetical order.
+func sortedCallbacks(callbacks map[string]*callback) []string {
+	names := make([]string, 0, len(callbacks))
+	for name := range callbacks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// schemaGenerator derives the JSON schemas of Go types, collecting the schemas
+// of named struct types as components.
+type schemaGenerator struct {
+	schemas map[string]*OpenRPCSchema
+	names   map[reflect.Type]string
+}
+
+// params describes the arguments of a callback. Trailing pointer arguments may
+// be omitted by the caller, hence they are not required.
+func (g *schemaGenerator) params(types []reflect.Type) []OpenRPCContentDescriptor {
+	params := make([]OpenRPCContentDescriptor, len(types))
+	required := false
+	for i := len(types) - 1; i >= 0; i-- {
+		required = required || types[i].Kind() != reflect.Ptr
+		params[i] = OpenRPCContentDescriptor{
+			Name:     fmt.Sprintf("arg%d", i),
+			Required: required,
+			Schema:   g.schema(types[i]),
+		}
+	}
+	return params
+}
+
+// result describes the value returned by a callback.
+func (g *schemaGenerator) result(cb *callback) *OpenRPCSchema {
+	fntype := cb.fn.Type()
+	for i := 0; i < fntype.NumOut(); i++ {
+		if i != cb.errPos {
+			return g.schema(fntype.Out(i))
+		}
+	}
+	return &OpenRPCSchema{Type: "null"}
+}
+
+// schema returns the JSON schema of the values of a type, as encoded by
+// encoding/json.
+func (g *schemaGenerator) schema(typ reflect.Type) *OpenRPCSchema {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	// Custom encodings are opaque, except the textual ones
+	ptr := reflect.PointerTo(typ)
+	if ptr.Implements(jsonMarshalerType) {
+		return &OpenRPCSchema{Title: typ.Name()}
+	}
+	if ptr.Implements(textMarshalerType) {
+		return &OpenRPCSchema{Title: typ.Name(), Type: "string"}
+	}
+	if ptr.Implements(jsonUnmarshalerType) {
+		return &OpenRPCSchema{Title: typ.Name()}
+	}
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &OpenRPCSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &OpenRPCSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &OpenRPCSchema{Type: "number"}
+	case reflect.String:
+		return &OpenRPCSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+			return &OpenRPCSchema{Type: "string"} // base64 encoded
+		}
+		return &OpenRPCSchema{Type: "array", Items: g.schema(typ.Elem())}
+	case reflect.Map:
+		return &OpenRPCSchema{Type: "object", AdditionalProperties: g.schema(typ.Elem())}
+	case reflect.Struct:
+		if typ.Name() == "" {
+			return g.object(typ)
+		}
+		return g.component(typ)
+	default:
+		return &OpenRPCSchema{} // any value
+	}
+}
+
+// component returns a reference to the schema of a named struct type, adding it
+// to the components if not done yet.
+func (g *schemaGenerator) component(typ reflect.Type) *OpenRPCSchema {
+	name, ok := g.names[typ]
+	if !ok {
+		name = componentName(typ)
+		for i := 2; g.schemas[name] != nil; i++ {
+			name = fmt.Sprintf("%s%d", componentName(typ), i)
+		}
+		g.names[typ] = name
+		g.schemas[name] = &OpenRPCSchema{} // placeholder for recursive types
+		*g.schemas[name] = *g.object(typ)
+		g.schemas[name].Title = typ.Name()
+	}
+	return &OpenRPCSchema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName returns the name of a named type qualified with its package,
+// stripped of the characters not allowed by the specification.
+func componentName(typ reflect.Type) string {
+	name := path.Base(typ.PkgPath()) + "." + typ.Name()
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// object returns the schema of a struct type, following the field naming rules
+// of encoding/json.
+func (g *schemaGenerator) object(typ reflect.Type) *OpenRPCSchema {
+	schema := &OpenRPCSchema{Type: "object", Properties: make(map[string]*OpenRPCSchema)}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for name, prop := range g.object(embedded).Properties {
+					if _, ok := schema.Properties[name]; !ok {
+						schema.Properties[name] = prop
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = g.schema(field.Type)
+	}
+	return schema
+}
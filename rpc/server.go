@@ -101,6 +101,12 @@ func (s *Server) RegisterName(name string, receiver interface{}) error {
 //
 // Note that codec options are no longer supported.
 func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
+	s.serveCodec(codec, nil)
+}
+
+// serveCodec serves the requests of the codec, restricting the calls made over it
+// with the given access policy if non-nil.
+func (s *Server) serveCodec(codec ServerCodec, policy AccessPolicy) {
 	defer codec.close()
 
 	if !s.trackCodec(codec) {
@@ -112,6 +118,7 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 		idgen:              s.idgen,
 		batchItemLimit:     s.batchItemLimit,
 		batchResponseLimit: s.batchResponseLimit,
+		accessPolicy:       policy,
 	}
 	c := initClient(codec, &s.services, cfg)
 	<-codec.closed()
@@ -192,3 +192,49 @@ func TestServerBatchResponseSizeLimit(t *testing.T) {
 		}
 	}
 }
+
+func TestServerDiscover(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	var doc OpenRPCDocument
+	if err := client.Call(&doc, discoverMethod); err != nil {
+		t.Fatal("error discovering methods:", err)
+	}
+	methods := make(map[string]OpenRPCMethod)
+	for _, method := range doc.Methods {
+		methods[method.Name] = method
+	}
+	if _, ok := methods["rpc_modules"]; !ok {
+		t.Fatal("missing rpc_modules method")
+	}
+	echo, ok := methods["test_echo"]
+	if !ok {
+		t.Fatal("missing test_echo method")
+	}
+	if len(echo.Params) != 3 || !echo.Params[1].Required || echo.Params[2].Required {
+		t.Fatalf("wrong test_echo params: %+v", echo.Params)
+	}
+	if echo.Params[0].Schema.Type != "string" || echo.Params[1].Schema.Type != "integer" {
+		t.Fatalf("wrong test_echo param types: %+v, %+v", echo.Params[0].Schema, echo.Params[1].Schema)
+	}
+	result := doc.Components.Schemas["rpc.echoResult"]
+	if echo.Result.Schema.Ref != "#/components/schemas/rpc.echoResult" || result == nil {
+		t.Fatalf("wrong test_echo result: %+v", echo.Result.Schema)
+	}
+	if len(result.Properties) != 3 || result.Properties["Args"].Ref != "#/components/schemas/rpc.echoArgs" {
+		t.Fatalf("wrong echoResult schema: %+v", result)
+	}
+	if rets := methods["test_rets"]; rets.Result.Schema.Type != "string" {
+		t.Fatalf("wrong test_rets result: %+v", rets.Result.Schema)
+	}
+	subscribe, ok := methods["nftest_subscribe"]
+	if !ok || len(subscribe.Params) != 1 || len(subscribe.Subscriptions) != len(subscribe.Params[0].Schema.Enum) {
+		t.Fatalf("wrong nftest_subscribe method: %+v", subscribe)
+	}
+	if _, ok := methods["nftest_unsubscribe"]; !ok {
+		t.Fatal("missing nftest_unsubscribe method")
+	}
+}
@@ -93,6 +93,9 @@ func (r *serviceRegistry) registerName(name string, rcvr interface{}) error {
 
 // callback returns the callback corresponding to the given RPC method name.
 func (r *serviceRegistry) callback(method string) *callback {
+	if method == discoverMethod {
+		method = MetadataApi + serviceMethodSeparator + "discover"
+	}
 	before, after, found := strings.Cut(method, serviceMethodSeparator)
 	if !found {
 		return nil